@@ -264,6 +264,14 @@ func (flags GatewayFlags) openProject(ctx context.Context) (*libuplink.Project,
 	}
 	cfg.Volatile.MaxInlineSize = flags.Client.MaxInlineSize
 	cfg.Volatile.MaxMemory = flags.RS.MaxBufferMem
+	cfg.Volatile.PrefetchSegments = flags.Client.PrefetchSegments
+	cfg.Volatile.PrefetchMemory = flags.Client.PrefetchMemory
+
+	compression, err := flags.GetCompressionParameters()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Volatile.Compression = compression
 
 	apiKey, err := libuplink.ParseAPIKey(flags.Client.APIKey)
 	if err != nil {
@@ -32,7 +32,11 @@ func main() {
 	flag.BoolVar(&conf.NoSSL, "no-ssl", false, "disable ssl")
 	flag.StringVar(&conf.ConfigDir, "config-dir", "", "path of config dir to use. If empty, a config will be created.")
 
-	clientName := flag.String("client", "minio", "client to use for requests (supported: minio, aws-cli, uplink)")
+	flag.IntVar(&conf.PrefetchSegments, "prefetch-segments", 4, "number of segments to download concurrently with the libuplink client")
+	conf.PrefetchMemory = 256 * memory.MiB
+	flag.Var(&conf.PrefetchMemory, "prefetch-memory", "maximum memory for buffering prefetched segments with the libuplink client")
+
+	clientName := flag.String("client", "minio", "client to use for requests (supported: minio, aws-cli, uplink, libuplink)")
 
 	location := flag.String("location", "", "bucket location")
 	count := flag.Int("count", 50, "benchmark count")
@@ -70,6 +74,8 @@ func main() {
 		client, err = s3client.NewAWSCLI(conf)
 	case "uplink":
 		client, err = s3client.NewUplink(conf)
+	case "libuplink":
+		client, err = s3client.NewLibUplink(conf)
 	}
 	if err != nil {
 		log.Fatal(err)
@@ -307,6 +313,22 @@ func FileBenchmark(client s3client.Client, bucket string, filesize memory.Size,
 			measurement.RecordSpeed("Download", finish-start)
 		}
 
+		if prefetcher, ok := client.(s3client.PrefetchDownloader); ok { // downloading with prefetching
+			start := hrtime.Now()
+			var err error
+			result, err = prefetcher.DownloadPrefetch(bucket, "data", result)
+			if err != nil {
+				return measurement, fmt.Errorf("get object with prefetching failed: %+v", err)
+			}
+			finish := hrtime.Now()
+
+			if !bytes.Equal(data, result) {
+				return measurement, fmt.Errorf("upload/download with prefetching do not match: lengths %d and %d", len(data), len(result))
+			}
+
+			measurement.RecordSpeed("Download Prefetch", finish-start)
+		}
+
 		{ // deleting
 			start := hrtime.Now()
 			err := client.Delete(bucket, "data")
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"text/tabwriter"
 	"time"
 
@@ -194,14 +195,32 @@ func cmdQDiag(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	counts, err := database.RepairQueue().CountByHealthyPieces(context.Background())
+	if err != nil {
+		return err
+	}
+
 	// initialize the table header (fields)
 	const padding = 3
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
 	fmt.Fprintln(w, "Path\tLost Pieces\t")
 
-	// populate the row fields
+	// populate the row fields, most urgent segments first
 	for _, v := range list {
-		fmt.Fprint(w, v.GetPath(), "\t", v.GetLostPieces(), "\t")
+		fmt.Fprintf(w, "%s\t%v\t\n", v.GetPath(), v.GetLostPieces())
+	}
+
+	// display the number of segments by their number of healthy pieces
+	numHealthy := make([]int, 0, len(counts))
+	for n := range counts {
+		numHealthy = append(numHealthy, n)
+	}
+	sort.Ints(numHealthy)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Healthy Pieces\tSegments\t")
+	for _, n := range numHealthy {
+		fmt.Fprintf(w, "%d\t%d\t\n", n, counts[n])
 	}
 
 	// display the data
@@ -151,6 +151,20 @@ func printDashboard(data *pb.DashboardResponse) error {
 		color.Yellow("Loading...\n")
 	}
 
+	if payouts := data.GetPayouts(); len(payouts) > 0 {
+		w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(w, "\n\t%s\t%s\t%s\t%s\t%s\t\n", color.GreenString("Egress"), color.GreenString("Repair"), color.GreenString("Audit"), color.GreenString("Storage"), color.GreenString("Total"))
+		for _, payout := range payouts {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t\n", payout.SatelliteId.String(),
+				whiteUSD(payout.GetEgress()), whiteUSD(payout.GetRepairEgress()), whiteUSD(payout.GetAuditEgress()),
+				whiteUSD(payout.GetStorage()), whiteUSD(payout.GetTotal()))
+		}
+		fmt.Fprintf(w, "Estimated payouts (since %s 1)\n", time.Now().Format("Jan"))
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+
 	w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
 	// TODO: Get addresses from server data
 	fmt.Fprintf(w, "\nBootstrap\t%s\n", color.WhiteString(data.GetBootstrapAddress()))
@@ -174,6 +188,10 @@ func whiteInt(value int64) string {
 	return color.WhiteString(fmt.Sprintf("%+v", value))
 }
 
+func whiteUSD(value float64) string {
+	return color.WhiteString(fmt.Sprintf("$%.2f", value))
+}
+
 // clearScreen clears the screen so it can be redrawn
 func clearScreen() {
 	switch runtime.GOOS {
@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdExitSatellite(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", args[0], err)
+	}
+
+	conn, err := transport.DialAddressInsecure(ctx, exitSatelliteCfg.Address)
+	if err != nil {
+		return errs.New("unable to reach the storage node at %s: %v", exitSatelliteCfg.Address, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewPieceStoreInspectorClient(conn).StartGracefulExit(ctx, &pb.StartGracefulExitRequest{
+		SatelliteId: satelliteID,
+	})
+	if err != nil {
+		return errs.New("unable to start graceful exit: %v", err)
+	}
+
+	fmt.Printf("Graceful exit from satellite %s started, its progress is logged by the storage node\n", satelliteID)
+	return nil
+}
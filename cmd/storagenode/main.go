@@ -67,6 +67,13 @@ var (
 		RunE:        cmdDashboard,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	exitSatelliteCmd = &cobra.Command{
+		Use:         "exit-satellite <satellite-id>",
+		Short:       "Gracefully exit a satellite by moving its pieces to other nodes",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdExitSatellite,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
 	runCfg       StorageNodeFlags
 	setupCfg     StorageNodeFlags
@@ -74,6 +81,9 @@ var (
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
+	exitSatelliteCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"private address of the storage node"`
+	}
 	defaultDiagDir string
 	confDir        string
 	identityDir    string
@@ -98,11 +108,13 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(exitSatelliteCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(diagCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(exitSatelliteCmd, &exitSatelliteCfg, defaults, cfgstruct.ConfDir(confDir))
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
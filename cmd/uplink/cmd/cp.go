@@ -5,10 +5,13 @@ package cmd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -25,6 +28,7 @@ import (
 var (
 	progress *bool
 	expires  *string
+	resume   *bool
 )
 
 func init() {
@@ -35,6 +39,7 @@ func init() {
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
 	expires = cpCmd.Flags().String("expires", "", "optional expiration date of an object. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, keep a journal of the upload and continue an interrupted upload of the same file")
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -83,6 +88,10 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return fmt.Errorf("source cannot be a directory: %s", src)
 	}
 
+	if *resume && src.Base() == "-" {
+		return fmt.Errorf("cannot resume an upload from stdin")
+	}
+
 	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
 	if err != nil {
 		return err
@@ -113,6 +122,14 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
 
+	if *resume {
+		journalPath, err := uploadJournalPath(src, dst, fileInfo)
+		if err != nil {
+			return err
+		}
+		opts.Volatile.Journal = libuplink.NewFileUploadJournal(journalPath)
+	}
+
 	if err := bucket.UploadObject(ctx, dst.Path(), reader, opts); err != nil {
 		return err
 	}
@@ -126,6 +143,21 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 	return nil
 }
 
+// uploadJournalPath returns the location of the journal for uploading src to
+// dst. The size and modification time of src are part of the name, so that a
+// changed file starts a new upload instead of resuming the previous one.
+func uploadJournalPath(src fpath.FPath, dst fpath.FPath, fileInfo os.FileInfo) (string, error) {
+	srcPath, err := filepath.Abs(src.Path())
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s\n%s\n%d\n%d", srcPath, dst.String(), fileInfo.Size(), fileInfo.ModTime().UnixNano())
+
+	return filepath.Join(confDir, "uploads", hex.EncodeToString(hash.Sum(nil))+".json"), nil
+}
+
 // download transfers s3 compatible object src to dst on local machine
 func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) (err error) {
 	if src.IsLocal() {
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/setup"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "mv",
+		Short: "Moves a Storj object to another location in Storj",
+		RunE:  moveObject,
+	}, RootCmd)
+}
+
+func moveObject(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified for move")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() || dst.IsLocal() {
+		return fmt.Errorf("Both the source and the destination must be a Storj URL, use format sj://bucket/")
+	}
+
+	if src.Path() == "" {
+		return fmt.Errorf("No object specified for move")
+	}
+
+	// if destination object name not specified, default to source object name
+	if dst.Path() == "" || strings.HasSuffix(dst.Path(), "/") {
+		dst = dst.Join(src.Base())
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket(), access)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	defer closeProjectAndBucket(project, bucket)
+
+	err = bucket.MoveObject(ctx, src.Path(), dst.Bucket(), dst.Path())
+	if err != nil {
+		// the source bucket was already opened, so a missing bucket is the destination
+		if storj.ErrBucketNotFound.Has(err) {
+			return convertError(err, dst)
+		}
+		return convertError(err, src)
+	}
+
+	fmt.Printf("%s moved to %s\n", src, dst)
+
+	return nil
+}
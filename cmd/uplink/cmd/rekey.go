@@ -0,0 +1,133 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/setup"
+)
+
+var rekeyCfg struct {
+	NewEncryptionKey string `help:"the new root key for encrypting the data"`
+	NewKeyFilepath   string `help:"the path to the file which contains the new root key for encrypting the data"`
+}
+
+func init() {
+	rekeyCmd := addCmd(&cobra.Command{
+		Use:   "rekey [sj://BUCKET[/PREFIX]]",
+		Short: "Re-encrypts the paths and keys of objects with a new root key without transferring their data",
+		RunE:  rekeyMain,
+	}, RootCmd)
+
+	process.Bind(rekeyCmd, &rekeyCfg)
+}
+
+// rekeyMain is the function executed when rekeyCmd is called
+func rekeyMain(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	data := []byte(rekeyCfg.NewEncryptionKey)
+	if rekeyCfg.NewKeyFilepath != "" {
+		data, err = ioutil.ReadFile(rekeyCfg.NewKeyFilepath)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("No new encryption key specified, use --new-encryption-key or --new-key-filepath")
+	}
+
+	newKey, err := storj.NewKey(data)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	newAccess := libuplink.NewEncryptionAccessWithDefaultKey(*newKey)
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, err := cfg.GetProject(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := project.Close(); err != nil {
+			fmt.Printf("error closing project: %+v\n", err)
+		}
+	}()
+
+	var total int
+	if len(args) > 0 {
+		src, err := fpath.New(args[0])
+		if err != nil {
+			return err
+		}
+		if src.IsLocal() {
+			return fmt.Errorf("The source must be a Storj URL, use format sj://bucket/")
+		}
+
+		total, err = rekeyBucket(ctx, project, src.Bucket(), src.Path(), access, newAccess)
+		if err != nil {
+			return convertError(err, src)
+		}
+	} else {
+		// a leaked root key affects all buckets
+		listOpts := storj.BucketListOptions{Direction: storj.Forward}
+		for {
+			list, err := project.ListBuckets(ctx, &listOpts)
+			if err != nil {
+				return err
+			}
+			for _, bucket := range list.Items {
+				rekeyed, err := rekeyBucket(ctx, project, bucket.Name, "", access, newAccess)
+				total += rekeyed
+				if err != nil {
+					return err
+				}
+			}
+			if !list.More {
+				break
+			}
+			listOpts = listOpts.NextPage(list)
+		}
+	}
+
+	fmt.Printf("%d objects re-encrypted, update the encryption key in your configuration\n", total)
+
+	return nil
+}
+
+func rekeyBucket(ctx context.Context, project *libuplink.Project, bucketName string, prefix storj.Path, access, newAccess *libuplink.EncryptionAccess) (_ int, err error) {
+	bucket, err := project.OpenBucket(ctx, bucketName, access)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := bucket.Close(); err != nil {
+			fmt.Printf("error closing bucket: %+v\n", err)
+		}
+	}()
+
+	rekeyed, err := bucket.RekeyObjects(ctx, prefix, newAccess)
+	for _, path := range rekeyed {
+		fmt.Printf("sj://%s/%s re-encrypted\n", bucketName, path)
+	}
+	if err != nil {
+		// the objects, which were re-encrypted already, are skipped on the next run
+		fmt.Printf("re-encrypting sj://%s/%s failed, run the command again with the same keys to continue\n", bucketName, prefix)
+	}
+	return len(rekeyed), err
+}
@@ -92,6 +92,14 @@ func (cliCfg *UplinkFlags) NewUplink(ctx context.Context) (*libuplink.Uplink, er
 
 	libuplinkCfg.Volatile.DialTimeout = cliCfg.Client.DialTimeout
 	libuplinkCfg.Volatile.RequestTimeout = cliCfg.Client.RequestTimeout
+	libuplinkCfg.Volatile.PrefetchSegments = cliCfg.Client.PrefetchSegments
+	libuplinkCfg.Volatile.PrefetchMemory = cliCfg.Client.PrefetchMemory
+
+	compression, err := cliCfg.GetCompressionParameters()
+	if err != nil {
+		return nil, err
+	}
+	libuplinkCfg.Volatile.Compression = compression
 
 	return libuplink.NewUplink(ctx, libuplinkCfg)
 }
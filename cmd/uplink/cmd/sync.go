@@ -0,0 +1,409 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/sync2"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/setup"
+)
+
+// syncModTimeKey is the object metadata key holding the modification time
+// of the local file an object was synced from.
+const syncModTimeKey = "uplink-sync-mtime"
+
+var (
+	syncDryRun      *bool
+	syncDelete      *bool
+	syncParallelism *int
+	syncInclude     *[]string
+	syncExclude     *[]string
+)
+
+func init() {
+	syncCmd := addCmd(&cobra.Command{
+		Use:   "sync",
+		Short: "Synchronizes a local directory and a Storj prefix, in either direction",
+		RunE:  syncMain,
+	}, RootCmd)
+	syncDryRun = syncCmd.Flags().Bool("dry-run", false, "if true, only print the changes without making them")
+	syncDelete = syncCmd.Flags().Bool("delete", false, "if true, delete files in the destination that don't exist in the source")
+	syncParallelism = syncCmd.Flags().Int("parallelism", 4, "number of files to transfer in parallel")
+	syncInclude = syncCmd.Flags().StringSlice("include", nil, "only sync files with a path or name matching one of the patterns")
+	syncExclude = syncCmd.Flags().StringSlice("exclude", nil, "don't sync files with a path or name matching one of the patterns")
+}
+
+// syncEntry is a file or an object taking part in a sync.
+type syncEntry struct {
+	// path is relative to the synced directory or prefix and uses / as separator.
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// syncPlan lists the changes needed to make the destination match the source.
+type syncPlan struct {
+	transfer []syncEntry
+	remove   []syncEntry
+}
+
+// planSync compares the source and destination entries. An entry is
+// transferred when it is missing from the destination or differs in size or
+// modification time. When deleteExtraneous is set, the destination entries
+// missing from the source are removed.
+func planSync(src, dst map[string]syncEntry, deleteExtraneous bool) syncPlan {
+	var plan syncPlan
+	for p, srcEntry := range src {
+		dstEntry, ok := dst[p]
+		if !ok || dstEntry.size != srcEntry.size || !dstEntry.modTime.Equal(srcEntry.modTime) {
+			plan.transfer = append(plan.transfer, srcEntry)
+		}
+	}
+	if deleteExtraneous {
+		for p, dstEntry := range dst {
+			if _, ok := src[p]; !ok {
+				plan.remove = append(plan.remove, dstEntry)
+			}
+		}
+	}
+
+	sort.Slice(plan.transfer, func(i, k int) bool { return plan.transfer[i].path < plan.transfer[k].path })
+	sort.Slice(plan.remove, func(i, k int) bool { return plan.remove[i].path < plan.remove[k].path })
+	return plan
+}
+
+// syncFilter selects the paths taking part in a sync.
+type syncFilter struct {
+	include []string
+	exclude []string
+}
+
+// match returns true when the relative path p should be synced.
+// Patterns are matched against both the whole path and its base name.
+func (filter syncFilter) match(p string) bool {
+	matchAny := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, p); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(p)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(filter.include) > 0 && !matchAny(filter.include) {
+		return false
+	}
+	return !matchAny(filter.exclude)
+}
+
+// validate checks that all patterns are well formed.
+func (filter syncFilter) validate() error {
+	for _, pattern := range append(append([]string{}, filter.include...), filter.exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// listLocalEntries returns the regular files below root, keyed by their relative path.
+func listLocalEntries(root string, filter syncFilter) (map[string]syncEntry, error) {
+	entries := make(map[string]syncEntry)
+
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) && filePath == root {
+			// the destination directory will be created
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !filter.match(rel) {
+			return nil
+		}
+
+		entries[rel] = syncEntry{
+			path:    rel,
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		}
+		return nil
+	})
+
+	return entries, err
+}
+
+// listRemoteEntries returns the objects below prefix, keyed by their path
+// relative to prefix. The modification time is the time of the local file the
+// object was synced from, or the object modification time, if unknown.
+func listRemoteEntries(ctx context.Context, bucket *libuplink.Bucket, prefix string, filter syncFilter) (map[string]syncEntry, error) {
+	entries := make(map[string]syncEntry)
+
+	startAfter := ""
+	for {
+		list, err := bucket.ListObjects(ctx, &storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    startAfter,
+			Prefix:    prefix,
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			if object.IsPrefix || !filter.match(object.Path) {
+				continue
+			}
+
+			modTime := object.Modified
+			if value, ok := object.Metadata[syncModTimeKey]; ok {
+				if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+					modTime = parsed
+				}
+			}
+
+			entries[object.Path] = syncEntry{
+				path:    object.Path,
+				size:    object.Size,
+				modTime: modTime,
+			}
+		}
+
+		if !list.More {
+			break
+		}
+
+		startAfter = list.Items[len(list.Items)-1].Path
+	}
+
+	return entries, nil
+}
+
+// syncMain is the function executed when syncCmd is called
+func syncMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) < 2 {
+		return fmt.Errorf("Both a source and a destination must be specified")
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() == dst.IsLocal() {
+		return fmt.Errorf("Exactly one of the source or the destination must be a Storj URL")
+	}
+
+	if *syncParallelism < 1 {
+		return fmt.Errorf("Parallelism must be at least 1")
+	}
+
+	filter := syncFilter{include: *syncInclude, exclude: *syncExclude}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+
+	local, remote := src, dst
+	if !src.IsLocal() {
+		local, remote = dst, src
+	}
+
+	if info, err := os.Stat(local.Path()); err == nil && !info.IsDir() {
+		return fmt.Errorf("Local path must be a directory: %s", local)
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, remote.Bucket(), access)
+	if err != nil {
+		return convertError(err, remote)
+	}
+	defer closeProjectAndBucket(project, bucket)
+
+	prefix := remote.Path()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	localEntries, err := listLocalEntries(local.Path(), filter)
+	if err != nil {
+		return err
+	}
+
+	remoteEntries, err := listRemoteEntries(ctx, bucket, prefix, filter)
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	var plan syncPlan
+	if src.IsLocal() {
+		plan = planSync(localEntries, remoteEntries, *syncDelete)
+	} else {
+		plan = planSync(remoteEntries, localEntries, *syncDelete)
+	}
+
+	if len(plan.transfer) == 0 && len(plan.remove) == 0 {
+		fmt.Println("Nothing to sync")
+		return nil
+	}
+
+	if *syncDryRun {
+		for _, entry := range plan.transfer {
+			fmt.Printf("(dry run) Copy %s to %s\n", syncJoin(src, entry.path), syncJoin(dst, entry.path))
+		}
+		for _, entry := range plan.remove {
+			fmt.Printf("(dry run) Delete %s\n", syncJoin(dst, entry.path))
+		}
+		return nil
+	}
+
+	var mu sync.Mutex
+	var group errs.Group
+	addErr := func(err error) {
+		mu.Lock()
+		group.Add(err)
+		mu.Unlock()
+	}
+
+	limiter := sync2.NewLimiter(*syncParallelism)
+	for _, entry := range plan.transfer {
+		entry := entry
+		limiter.Go(ctx, func() {
+			var err error
+			if src.IsLocal() {
+				err = syncUpload(ctx, bucket, local.Join(filepath.FromSlash(entry.path)), prefix+entry.path)
+			} else {
+				err = syncDownload(ctx, bucket, prefix+entry.path, local.Join(filepath.FromSlash(entry.path)), entry.modTime)
+			}
+			if err != nil {
+				addErr(fmt.Errorf("failed to copy %s: %v", entry.path, err))
+				return
+			}
+			fmt.Printf("Copied %s to %s\n", syncJoin(src, entry.path), syncJoin(dst, entry.path))
+		})
+	}
+	limiter.Wait()
+
+	for _, entry := range plan.remove {
+		var err error
+		if dst.IsLocal() {
+			err = os.Remove(local.Join(filepath.FromSlash(entry.path)).Path())
+		} else {
+			err = bucket.DeleteObject(ctx, prefix+entry.path)
+		}
+		if err != nil {
+			addErr(fmt.Errorf("failed to delete %s: %v", entry.path, err))
+			continue
+		}
+		fmt.Printf("Deleted %s\n", syncJoin(dst, entry.path))
+	}
+
+	return errs.Combine(group.Err(), ctx.Err())
+}
+
+// syncJoin returns the display form of the relative path rel below root.
+func syncJoin(root fpath.FPath, rel string) string {
+	if root.IsLocal() {
+		return root.Join(filepath.FromSlash(rel)).String()
+	}
+	return strings.TrimSuffix(root.String(), "/") + "/" + rel
+}
+
+// syncUpload uploads the local file src to the object at path dst, storing
+// the file modification time in the object metadata.
+func syncUpload(ctx context.Context, bucket *libuplink.Bucket, src fpath.FPath, dst storj.Path) (err error) {
+	file, err := os.Open(src.Path())
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	opts := &libuplink.UploadOptions{
+		Metadata: map[string]string{
+			syncModTimeKey: fileInfo.ModTime().UTC().Format(time.RFC3339Nano),
+		},
+	}
+	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
+	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+
+	return bucket.UploadObject(ctx, dst, file, opts)
+}
+
+// syncDownload downloads the object at path src to the local file dst and
+// sets the file modification time to modTime.
+func syncDownload(ctx context.Context, bucket *libuplink.Bucket, src storj.Path, dst fpath.FPath, modTime time.Time) (err error) {
+	object, err := bucket.OpenObject(ctx, src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	rc, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, rc.Close()) }()
+
+	if err := os.MkdirAll(filepath.Dir(dst.Path()), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(dst.Path())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, rc)
+	err = errs.Combine(err, file.Close())
+	if err != nil {
+		return err
+	}
+
+	return os.Chtimes(dst.Path(), modTime, modTime)
+}
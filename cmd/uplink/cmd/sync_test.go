@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSync(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	src := map[string]syncEntry{
+		"same":         {path: "same", size: 10, modTime: now},
+		"resized":      {path: "resized", size: 11, modTime: now},
+		"touched":      {path: "touched", size: 10, modTime: later},
+		"dir/new-file": {path: "dir/new-file", size: 1, modTime: now},
+	}
+	dst := map[string]syncEntry{
+		"same":       {path: "same", size: 10, modTime: now.UTC()},
+		"resized":    {path: "resized", size: 10, modTime: now},
+		"touched":    {path: "touched", size: 10, modTime: now},
+		"extraneous": {path: "extraneous", size: 10, modTime: now},
+	}
+
+	plan := planSync(src, dst, false)
+	assert.Equal(t, []string{"dir/new-file", "resized", "touched"}, syncPaths(plan.transfer))
+	assert.Empty(t, plan.remove)
+
+	plan = planSync(src, dst, true)
+	assert.Equal(t, []string{"dir/new-file", "resized", "touched"}, syncPaths(plan.transfer))
+	assert.Equal(t, []string{"extraneous"}, syncPaths(plan.remove))
+
+	plan = planSync(src, src, true)
+	assert.Empty(t, plan.transfer)
+	assert.Empty(t, plan.remove)
+}
+
+func TestSyncFilter(t *testing.T) {
+	all := syncFilter{}
+	assert.True(t, all.match("a/b/c.txt"))
+
+	filter := syncFilter{
+		include: []string{"*.txt", "docs/*"},
+		exclude: []string{"secret.*", "docs/draft-*"},
+	}
+	require.NoError(t, filter.validate())
+
+	for path, expected := range map[string]bool{
+		"notes.txt":           true,
+		"deep/dir/notes.txt":  true,
+		"docs/readme.md":      true,
+		"docs/draft-plan.md":  false,
+		"image.png":           false,
+		"secret.txt":          false,
+		"deep/dir/secret.txt": false,
+	} {
+		assert.Equal(t, expected, filter.match(path), path)
+	}
+
+	invalid := syncFilter{exclude: []string{"[a-"}}
+	assert.Error(t, invalid.validate())
+}
+
+func syncPaths(entries []syncEntry) []string {
+	var paths []string
+	for _, entry := range entries {
+		paths = append(paths, entry.path)
+	}
+	return paths
+}
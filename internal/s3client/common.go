@@ -3,6 +3,8 @@
 
 package s3client
 
+import "storj.io/storj/internal/memory"
+
 // Config is the setup for a particular client
 type Config struct {
 	S3Gateway     string
@@ -13,6 +15,9 @@ type Config struct {
 	EncryptionKey string
 	NoSSL         bool
 	ConfigDir     string
+
+	PrefetchSegments int
+	PrefetchMemory   memory.Size
 }
 
 // Client is the common interface for different implementations
@@ -0,0 +1,216 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package s3client
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+// LibUplinkError is class for libuplink errors
+var LibUplinkError = errs.Class("libuplink error")
+
+// PrefetchDownloader is implemented by clients, which can download the
+// segments of an object concurrently
+type PrefetchDownloader interface {
+	DownloadPrefetch(bucket, objectName string, buffer []byte) ([]byte, error)
+}
+
+// LibUplink implements basic S3 Client with lib/uplink. Downloads fetch the
+// segments one after another, DownloadPrefetch fetches them concurrently.
+type LibUplink struct {
+	ctx     context.Context
+	access  *libuplink.EncryptionAccess
+	project *libuplink.Project
+
+	prefetchProject *libuplink.Project
+}
+
+// NewLibUplink creates new Client
+func NewLibUplink(conf Config) (Client, error) {
+	ctx := context.Background()
+
+	key, err := storj.NewKey([]byte(conf.EncryptionKey))
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+
+	client := &LibUplink{
+		ctx:    ctx,
+		access: libuplink.NewEncryptionAccessWithDefaultKey(*key),
+	}
+
+	client.project, err = openProject(ctx, conf, 0)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+
+	client.prefetchProject, err = openProject(ctx, conf, conf.PrefetchSegments)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(errs.Combine(err, client.project.Close()))
+	}
+
+	return client, nil
+}
+
+func openProject(ctx context.Context, conf Config, prefetchSegments int) (*libuplink.Project, error) {
+	cfg := &libuplink.Config{}
+	// the benchmark runs against test networks
+	cfg.Volatile.TLS.SkipPeerCAWhitelist = true
+	cfg.Volatile.PrefetchSegments = prefetchSegments
+	cfg.Volatile.PrefetchMemory = conf.PrefetchMemory
+
+	apiKey, err := libuplink.ParseAPIKey(conf.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	uplink, err := libuplink.NewUplink(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := uplink.OpenProject(ctx, conf.Satellite, apiKey)
+	if err != nil {
+		return nil, errs.Combine(err, uplink.Close())
+	}
+	return project, nil
+}
+
+// MakeBucket makes a new bucket
+func (client *LibUplink) MakeBucket(bucket, location string) error {
+	_, err := client.project.CreateBucket(client.ctx, bucket, nil)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveBucket removes a bucket
+func (client *LibUplink) RemoveBucket(bucket string) error {
+	err := client.project.DeleteBucket(client.ctx, bucket)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	return nil
+}
+
+// ListBuckets lists all buckets
+func (client *LibUplink) ListBuckets() ([]string, error) {
+	list, err := client.project.ListBuckets(client.ctx, nil)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+
+	names := []string{}
+	for _, bucket := range list.Items {
+		names = append(names, bucket.Name)
+	}
+	return names, nil
+}
+
+// Upload uploads object data to the specified path
+func (client *LibUplink) Upload(bucket, objectName string, data []byte) (err error) {
+	b, err := client.project.OpenBucket(client.ctx, bucket, client.access)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, b.Close()) }()
+
+	err = b.UploadObject(client.ctx, objectName, bytes.NewReader(data), nil)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	return nil
+}
+
+// Download downloads object data
+func (client *LibUplink) Download(bucket, objectName string, buffer []byte) ([]byte, error) {
+	return client.download(client.project, bucket, objectName, buffer)
+}
+
+// DownloadPrefetch downloads object data, fetching the segments concurrently
+func (client *LibUplink) DownloadPrefetch(bucket, objectName string, buffer []byte) ([]byte, error) {
+	return client.download(client.prefetchProject, bucket, objectName, buffer)
+}
+
+func (client *LibUplink) download(project *libuplink.Project, bucket, objectName string, buffer []byte) (_ []byte, err error) {
+	b, err := project.OpenBucket(client.ctx, bucket, client.access)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, b.Close()) }()
+
+	object, err := b.OpenObject(client.ctx, objectName)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	reader, err := object.DownloadRange(client.ctx, 0, -1)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	result := bytes.NewBuffer(buffer[:0])
+	_, err = io.Copy(result, reader)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+	return result.Bytes(), nil
+}
+
+// Delete deletes object
+func (client *LibUplink) Delete(bucket, objectName string) (err error) {
+	b, err := client.project.OpenBucket(client.ctx, bucket, client.access)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, b.Close()) }()
+
+	err = b.DeleteObject(client.ctx, objectName)
+	if err != nil {
+		return LibUplinkError.Wrap(err)
+	}
+	return nil
+}
+
+// ListObjects lists objects
+func (client *LibUplink) ListObjects(bucket, prefix string) (_ []string, err error) {
+	b, err := client.project.OpenBucket(client.ctx, bucket, client.access)
+	if err != nil {
+		return nil, LibUplinkError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, b.Close()) }()
+
+	opts := libuplink.ListOptions{
+		Direction: storj.Forward,
+		Recursive: false,
+	}
+	if prefix != "" {
+		opts.Prefix = prefix + "/"
+	}
+
+	names := []string{}
+	for {
+		list, err := b.ListObjects(client.ctx, &opts)
+		if err != nil {
+			return nil, LibUplinkError.Wrap(err)
+		}
+		for _, object := range list.Items {
+			names = append(names, object.Path)
+		}
+		if !list.More {
+			return names, nil
+		}
+		opts = opts.NextPage(list)
+	}
+}
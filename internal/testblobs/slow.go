@@ -77,6 +77,24 @@ func (slow *SlowBlobs) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Delete(ctx, ref)
 }
 
+// Trash moves the blob with the namespace and key to the trash.
+func (slow *SlowBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
+	slow.sleep()
+	return slow.blobs.Trash(ctx, ref)
+}
+
+// RestoreTrash moves all blobs in the trash of the namespace back to the permanent storage.
+func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte) error {
+	slow.sleep()
+	return slow.blobs.RestoreTrash(ctx, namespace)
+}
+
+// EmptyTrash deletes all blobs of the namespace that were trashed before trashedBefore.
+func (slow *SlowBlobs) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) ([][]byte, error) {
+	slow.sleep()
+	return slow.blobs.EmptyTrash(ctx, namespace, trashedBefore)
+}
+
 // FreeSpace return how much free space left for writing.
 func (slow *SlowBlobs) FreeSpace() (int64, error) {
 	slow.sleep()
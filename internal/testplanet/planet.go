@@ -196,7 +196,7 @@ func NewCustom(log *zap.Logger, config Config) (*Planet, error) {
 
 	// init Satellites
 	for _, satellite := range planet.Satellites {
-		if len(satellite.Kademlia.Service.GetBootstrapNodes()) == 0 {
+		if satellite.Kademlia.Service != nil && len(satellite.Kademlia.Service.GetBootstrapNodes()) == 0 {
 			satellite.Kademlia.Service.SetBootstrapNodes([]pb.Node{planet.Bootstrap.Local().Node})
 		}
 	}
@@ -236,7 +236,9 @@ func (planet *Planet) Start(ctx context.Context) {
 	}
 
 	for _, peer := range planet.Satellites {
-		peer.Kademlia.Service.WaitForBootstrap()
+		if peer.Kademlia.Service != nil {
+			peer.Kademlia.Service.WaitForBootstrap()
+		}
 	}
 
 	planet.Reconnect(ctx)
@@ -264,6 +266,10 @@ func (planet *Planet) Reconnect(ctx context.Context) {
 
 	for _, satellite := range planet.Satellites {
 		satellite := satellite
+		if satellite.Kademlia.Service == nil {
+			// the satellite learns about the nodes from their check-ins
+			continue
+		}
 		group.Go(func() error {
 			for _, storageNode := range planet.StorageNodes {
 				_, err := satellite.Kademlia.Service.Ping(ctx, storageNode.Local().Node)
@@ -4,12 +4,15 @@
 package testplanet
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
@@ -19,12 +22,18 @@ import (
 	"storj.io/storj/pkg/discovery"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/extensions"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/server"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/marketingweb"
 	"storj.io/storj/satellite/metainfo"
@@ -124,11 +133,16 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				},
 			},
 			Discovery: discovery.Config{
+				Enabled:            true,
 				DiscoveryInterval:  1 * time.Second,
 				RefreshInterval:    1 * time.Second,
 				RefreshLimit:       100,
 				RefreshConcurrency: 2,
 			},
+			Contact: contact.Config{
+				Timeout:  10 * time.Second,
+				Kademlia: true,
+			},
 			Metainfo: metainfo.Config{
 				DatabaseURL:          "bolt://" + filepath.Join(storageDir, "pointers.db"),
 				MinRemoteSegmentSize: 0, // TODO: fix tests to work with 1024
@@ -166,6 +180,21 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MinBytesPerSecond:  1 * memory.KB,
 				MinDownloadTimeout: 5 * time.Second,
 			},
+			GarbageCollection: gc.Config{
+				Interval:          1 * time.Minute,
+				Enabled:           true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
+				ConcurrentSends:   1,
+			},
+			Lifecycle: lifecycle.Config{
+				Interval: 1 * time.Minute,
+				Enabled:  true,
+			},
+			GracefulExit: gracefulexit.Config{
+				OverallMaxFailuresPercentage: 10,
+				TransferBatchSize:            2,
+			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
 			},
@@ -211,3 +240,32 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 	}
 	return xs, nil
 }
+
+// FindRemotePointer returns the first remote pointer stored on the satellite
+// whose path isn't one of the skipped paths.
+func FindRemotePointer(ctx context.Context, satellite *satellite.Peer, skip ...storj.Path) (storj.Path, *pb.Pointer, error) {
+	items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+	if err != nil {
+		return "", nil, err
+	}
+
+next:
+	for _, item := range items {
+		path := item.GetPath()
+		for _, skipped := range skip {
+			if path == skipped {
+				continue next
+			}
+		}
+
+		pointer, err := satellite.Metainfo.Service.Get(ctx, path)
+		if err != nil {
+			return "", nil, err
+		}
+		if pointer.GetType() == pb.Pointer_REMOTE {
+			return path, pointer, nil
+		}
+	}
+
+	return "", nil, errs.New("satellite doesn't have a remote pointer")
+}
@@ -20,8 +20,11 @@ import (
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/vouchers"
@@ -96,6 +99,10 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 					Wallet: "0x" + strings.Repeat("00", 20),
 				},
 			},
+			Contact: contact.Config{
+				Interval: time.Hour,
+				Timeout:  time.Minute,
+			},
 			Storage: piecestore.OldConfig{
 				Path:                   "", // TODO: this argument won't be needed with master storagenodedb
 				AllocatedDiskSpace:     1 * memory.GB,
@@ -104,7 +111,8 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				WhitelistedSatellites:  whitelistedSatellites,
 			},
 			Collector: collector.Config{
-				Interval: time.Minute,
+				Interval:       time.Minute,
+				TrashRetention: 7 * 24 * time.Hour,
 			},
 			Console: consoleserver.Config{
 				Address:   "127.0.0.1:0",
@@ -126,6 +134,17 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 			Vouchers: vouchers.Config{
 				Interval: time.Hour,
 			},
+			NodeStats: nodestats.Config{
+				Interval: time.Hour,
+				Timeout:  time.Minute,
+			},
+			Payouts: payouts.Config{
+				Interval:          time.Hour,
+				EgressPrice:       20,
+				RepairEgressPrice: 10,
+				AuditEgressPrice:  10,
+				StoragePrice:      1.5,
+			},
 			Version: planet.NewVersionConfig(),
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
@@ -255,8 +255,12 @@ func uploadStream(ctx context.Context, streams streams.Store, mutableObject stor
 	upload := stream.NewUpload(ctx, mutableStream, streams)
 
 	_, err = io.Copy(upload, reader)
+	if err != nil {
+		// the upload already failed with err, don't report it twice
+		return errs.Combine(err, upload.CloseWithError(err))
+	}
 
-	return errs.Combine(err, upload.Close())
+	return upload.Close()
 }
 
 // DownloadStream returns stream for downloading data.
@@ -405,8 +409,14 @@ func DialMetainfo(ctx context.Context, log *zap.Logger, config uplink.Config, id
 	encStore := encryption.NewStore()
 	encStore.SetDefaultKey(new(storj.Key))
 
+	compression, err := config.GetCompressionParameters()
+	if err != nil {
+		return nil, nil, cleanup, errs.New("invalid compression: %v", err)
+	}
+
 	strms, err := streams.NewStreamStore(segment, config.Client.SegmentSize.Int64(), encStore,
 		int(blockSize), storj.CipherSuite(config.Enc.DataType), config.Client.MaxInlineSize.Int(),
+		compression,
 	)
 	if err != nil {
 		return nil, nil, cleanup, errs.New("failed to create stream store: %v", err)
@@ -25,6 +25,7 @@ type Bucket struct {
 	bucket   storj.Bucket
 	metainfo *kvmetainfo.DB
 	streams  streams.Store
+	prefetch stream.PrefetchConfig
 }
 
 // TODO: move the object related OpenObject to object.go
@@ -38,6 +39,22 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 		return nil, err
 	}
 
+	return b.newObject(info), nil
+}
+
+// OpenObjectVersion returns an Object handle for a version of an object, if authorized.
+func (b *Bucket) OpenObjectVersion(ctx context.Context, path storj.Path, versionID string) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := b.metainfo.GetObjectVersion(ctx, b.Name, path, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.newObject(info), nil
+}
+
+func (b *Bucket) newObject(info storj.Object) *Object {
 	return &Object{
 		Meta: ObjectMeta{
 			Bucket:      info.Bucket.Name,
@@ -50,6 +67,7 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 			Expires:     info.Expires,
 			Size:        info.Size,
 			Checksum:    info.Checksum,
+			VersionID:   info.VersionID,
 			Volatile: struct {
 				EncryptionParameters storj.EncryptionParameters
 				RedundancyScheme     storj.RedundancyScheme
@@ -62,7 +80,8 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 		},
 		metainfoDB: b.metainfo,
 		streams:    b.streams,
-	}, nil
+		prefetch:   b.prefetch,
+	}
 }
 
 // UploadOptions controls options about uploading a new Object, if authorized.
@@ -91,21 +110,40 @@ type UploadOptions struct {
 		// Error Correction encoding parameters to be used for this
 		// Object.
 		RedundancyScheme storj.RedundancyScheme
+
+		// Journal, if set, makes the upload resumable. The progress of
+		// the upload is recorded in the journal after every segment.
+		// When the upload is interrupted, uploading the same data again
+		// with the same journal continues from the last committed
+		// segment. The journal is removed when the upload completes.
+		Journal UploadJournal
 	}
 }
 
+// UploadJournal persists the progress of a resumable upload.
+type UploadJournal = streams.UploadJournal
+
+// NewFileUploadJournal returns an UploadJournal stored in a local file at path.
+func NewFileUploadJournal(path string) UploadJournal {
+	return streams.NewFileJournal(path)
+}
+
 // UploadObject uploads a new object, if authorized.
 func (b *Bucket) UploadObject(ctx context.Context, path storj.Path, data io.Reader, opts *UploadOptions) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	upload, err := b.NewWriter(ctx, path, opts)
+	upload, err := b.newUpload(ctx, path, opts)
 	if err != nil {
 		return err
 	}
 
 	_, err = io.Copy(upload, data)
+	if err != nil {
+		// don't commit the partially read data
+		return errs.Combine(err, upload.CloseWithError(err))
+	}
 
-	return errs.Combine(err, upload.Close())
+	return upload.Close()
 }
 
 // DeleteObject removes an object, if authorized.
@@ -114,6 +152,50 @@ func (b *Bucket) DeleteObject(ctx context.Context, path storj.Path) (err error)
 	return b.metainfo.DeleteObject(ctx, b.bucket.Name, path)
 }
 
+// DeleteObjectVersion permanently removes a version of an object or a delete
+// marker, if authorized. When the latest version is removed, the previous
+// version becomes the latest one.
+func (b *Bucket) DeleteObjectVersion(ctx context.Context, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.DeleteObjectVersion(ctx, b.bucket.Name, path, versionID)
+}
+
+// CopyObject copies an object to newPath in the bucket newBucket, if authorized.
+// The data isn't transferred, both objects share the same pieces on the storage
+// nodes. The new object is encrypted with the encryption access of this bucket.
+// An existing object at the destination is replaced.
+func (b *Bucket) CopyObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.CopyObject(ctx, b.bucket.Name, path, newBucket, newPath)
+}
+
+// MoveObject moves an object to newPath in the bucket newBucket, if authorized.
+// The data isn't transferred, only the object metadata is updated. The moved
+// object is encrypted with the encryption access of this bucket. An existing
+// object at the destination is replaced.
+func (b *Bucket) MoveObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.MoveObject(ctx, b.bucket.Name, path, newBucket, newPath)
+}
+
+// RekeyObject re-encrypts the path and the content keys of all versions of an
+// object with the keys of newAccess, if authorized. The data isn't transferred,
+// only the object metadata is updated. Afterwards the object can only be read
+// with newAccess.
+func (b *Bucket) RekeyObject(ctx context.Context, path storj.Path, newAccess *EncryptionAccess) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.RekeyObject(ctx, b.bucket.Name, path, newAccess.store)
+}
+
+// RekeyObjects re-encrypts all objects below prefix and the prefixes of the
+// lifecycle rules within it with the keys of newAccess, see RekeyObject. It
+// returns the paths of the objects, which were re-encrypted, also when it
+// fails in between. Calling it again continues where it failed.
+func (b *Bucket) RekeyObjects(ctx context.Context, prefix storj.Path, newAccess *EncryptionAccess) (rekeyed []storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.RekeyObjects(ctx, b.bucket.Name, prefix, newAccess.store)
+}
+
 // ListOptions controls options for the ListObjects() call.
 type ListOptions = storj.ListOptions
 
@@ -126,9 +208,70 @@ func (b *Bucket) ListObjects(ctx context.Context, cfg *ListOptions) (list storj.
 	return b.metainfo.ListObjects(ctx, b.bucket.Name, *cfg)
 }
 
+// ListObjectVersions lists all versions of the objects a user is authorized to
+// see, including delete markers. The listing is always recursive. Limit applies
+// to the number of objects, all of their versions are listed newest first.
+func (b *Bucket) ListObjectVersions(ctx context.Context, cfg *ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if cfg == nil {
+		cfg = &storj.ListOptions{Direction: storj.Forward}
+	}
+	return b.metainfo.ListObjectVersions(ctx, b.bucket.Name, *cfg)
+}
+
+// LifecycleRule expires the objects below a prefix of a bucket
+type LifecycleRule = storj.LifecycleRule
+
+// SetLifecycle replaces the lifecycle rules of the bucket, if authorized. Prefixes
+// are encrypted with the encryption access of this bucket and only match whole
+// path components. The satellite deletes expired objects periodically.
+func (b *Bucket) SetLifecycle(ctx context.Context, rules []LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.SetBucketLifecycle(ctx, b.bucket.Name, rules)
+}
+
+// GetLifecycle returns the lifecycle rules of the bucket, if authorized
+func (b *Bucket) GetLifecycle(ctx context.Context) (_ []LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.GetBucketLifecycle(ctx, b.bucket.Name)
+}
+
+// PlacementConstraints restrict the storage nodes the pieces of a bucket are
+// stored on
+type PlacementConstraints = storj.PlacementConstraints
+
+// SetPlacement replaces the placement constraints of the bucket, if authorized.
+// A nil placement removes all constraints. Only new uploads and repairs are
+// affected, existing pieces aren't moved.
+func (b *Bucket) SetPlacement(ctx context.Context, placement *PlacementConstraints) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = b.metainfo.SetBucketPlacement(ctx, b.bucket.Name, placement)
+	if err != nil {
+		return err
+	}
+	b.bucket.Placement = placement
+	return nil
+}
+
+// GetPlacement returns the placement constraints of the bucket, nil if there
+// are none
+func (b *Bucket) GetPlacement(ctx context.Context) (_ *PlacementConstraints, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bucket, err := b.metainfo.GetBucket(ctx, b.bucket.Name)
+	if err != nil {
+		return nil, err
+	}
+	return bucket.Placement, nil
+}
+
 // NewWriter creates a writer which uploads the object.
 func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOptions) (_ io.WriteCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return b.newUpload(ctx, path, opts)
+}
+
+func (b *Bucket) newUpload(ctx context.Context, path storj.Path, opts *UploadOptions) (_ *stream.Upload, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	if opts == nil {
 		opts = &UploadOptions{}
@@ -176,6 +319,10 @@ func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOpt
 		return nil, err
 	}
 
+	if opts.Volatile.Journal != nil {
+		return stream.NewResumableUpload(ctx, mutableStream, b.streams, opts.Volatile.Journal), nil
+	}
+
 	upload := stream.NewUpload(ctx, mutableStream, b.streams)
 	return upload, nil
 }
@@ -196,7 +343,7 @@ func (b *Bucket) NewReader(ctx context.Context, path storj.Path) (_ ReadSeekClos
 		return nil, err
 	}
 
-	return stream.NewDownload(ctx, segmentStream, b.streams), nil
+	return stream.NewPrefetchDownload(ctx, segmentStream, b.streams, b.prefetch), nil
 }
 
 // Close closes the Bucket session.
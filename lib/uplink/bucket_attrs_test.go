@@ -5,6 +5,7 @@ package uplink_test
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -181,3 +182,71 @@ func TestBucketAttrsApply(t *testing.T) {
 			assert.Equal(t, string(contents), objectContents)
 		})
 }
+
+// check that objects can be uploaded, listed and downloaded with every
+// supported cipher suite for both the content and the paths.
+func TestBucketCipherSuites(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		objectPath     = "folder/object"
+		objectContents = "Hopper,Lovelace,Hamilton,Liskov"
+		testConfig     testConfig
+	)
+	testConfig.uplinkCfg.Volatile.MaxInlineSize = 1
+
+	testPlanetWithLibUplink(t, testConfig,
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			for i, cipher := range []storj.CipherSuite{
+				storj.EncAESGCM,
+				storj.EncSecretBox,
+				storj.EncXChaCha20Poly1305,
+			} {
+				bucketName := fmt.Sprintf("bucket-%d", i)
+
+				bucketConfig := uplink.BucketConfig{
+					PathCipher: cipher,
+					EncryptionParameters: storj.EncryptionParameters{
+						CipherSuite: cipher,
+						BlockSize:   3 * memory.KiB.Int32(),
+					},
+				}
+				bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+					Algorithm:      storj.ReedSolomon,
+					ShareSize:      1 * memory.KiB.Int32(),
+					RequiredShares: 3,
+					RepairShares:   4,
+					OptimalShares:  5,
+					TotalShares:    5,
+				}
+
+				_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+				require.NoError(t, err)
+
+				bucket, err := proj.OpenBucket(ctx, bucketName, access)
+				require.NoError(t, err)
+
+				err = bucket.UploadObject(ctx, objectPath, bytes.NewBufferString(objectContents), nil)
+				require.NoError(t, err)
+
+				list, err := bucket.ListObjects(ctx, &uplink.ListOptions{Direction: storj.After, Recursive: true})
+				require.NoError(t, err)
+				require.Len(t, list.Items, 1)
+				assert.Equal(t, objectPath, list.Items[0].Path)
+
+				readBack, err := bucket.OpenObject(ctx, objectPath)
+				require.NoError(t, err)
+				assert.Equal(t, cipher, readBack.Meta.Volatile.EncryptionParameters.CipherSuite)
+
+				strm, err := readBack.DownloadRange(ctx, 0, -1)
+				require.NoError(t, err)
+
+				contents, err := ioutil.ReadAll(strm)
+				require.NoError(t, err)
+				assert.Equal(t, objectContents, string(contents))
+
+				require.NoError(t, strm.Close())
+				require.NoError(t, readBack.Close())
+				require.NoError(t, bucket.Close())
+			}
+		})
+}
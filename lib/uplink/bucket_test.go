@@ -0,0 +1,704 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+var errInterrupted = errors.New("interrupted")
+
+// interruptedReader fails after reading limit bytes, simulating a crash of
+// the uploading process.
+type interruptedReader struct {
+	data  io.Reader
+	limit int64
+}
+
+func (r *interruptedReader) Read(p []byte) (int, error) {
+	if r.limit <= 0 {
+		return 0, errInterrupted
+	}
+	if int64(len(p)) > r.limit {
+		p = p[:r.limit]
+	}
+	n, err := r.data.Read(p)
+	r.limit -= int64(n)
+	return n, err
+}
+
+// smallSegmentsBucketConfig returns a bucket config, which splits objects
+// into segments of the given size.
+func smallSegmentsBucketConfig(segmentsSize memory.Size) uplink.BucketConfig {
+	const (
+		shareSize      = 1024
+		requiredShares = 2
+	)
+	return uplink.BucketConfig{
+		PathCipher: storj.EncAESGCM,
+		EncryptionParameters: storj.EncryptionParameters{
+			CipherSuite: storj.EncAESGCM,
+			BlockSize:   2 * shareSize * requiredShares,
+		},
+		Volatile: struct {
+			RedundancyScheme storj.RedundancyScheme
+			SegmentsSize     memory.Size
+		}{
+			RedundancyScheme: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      shareSize,
+				RequiredShares: requiredShares,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+			},
+			SegmentsSize: segmentsSize,
+		},
+	}
+}
+
+func TestResumableUpload(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "resumable"
+		objectPath     = "backup/archive"
+		segmentsSize   = 16 * memory.KiB
+		inBucketConfig = smallSegmentsBucketConfig(segmentsSize)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			data := testrand.BytesInt(40 * memory.KiB.Int())
+			journalPath := ctx.File("journal", "upload.json")
+			journal := uplink.NewFileUploadJournal(journalPath)
+
+			opts := &uplink.UploadOptions{}
+			opts.Volatile.Journal = journal
+
+			// interrupt the upload in the middle of the second segment
+			err = bucket.UploadObject(ctx, objectPath, &interruptedReader{
+				data:  bytes.NewReader(data),
+				limit: 20 * memory.KiB.Int64(),
+			}, opts)
+			require.Error(t, err)
+
+			state, err := journal.Load(ctx)
+			require.NoError(t, err)
+			require.NotNil(t, state)
+			assert.Equal(t, bucketName+"/"+objectPath, state.Path)
+			assert.EqualValues(t, 1, state.CommittedSegments)
+			assert.Equal(t, segmentsSize.Int64(), state.CommittedSize)
+			streamID := state.StreamID
+
+			// the object isn't complete yet
+			_, err = bucket.OpenObject(ctx, objectPath)
+			require.Error(t, err)
+
+			// a journal with a different segment size must not be resumed
+			mismatched := *state
+			mismatched.SegmentSize++
+			otherJournal := uplink.NewFileUploadJournal(ctx.File("journal", "other.json"))
+			require.NoError(t, otherJournal.Save(ctx, &mismatched))
+			otherOpts := &uplink.UploadOptions{}
+			otherOpts.Volatile.Journal = otherJournal
+			err = bucket.UploadObject(ctx, objectPath, bytes.NewReader(data), otherOpts)
+			require.Error(t, err)
+
+			state, err = journal.Load(ctx)
+			require.NoError(t, err)
+			require.NotNil(t, state)
+			assert.Equal(t, streamID, state.StreamID)
+
+			// resume the upload from the beginning of the data
+			err = bucket.UploadObject(ctx, objectPath, bytes.NewReader(data), opts)
+			require.NoError(t, err)
+
+			_, err = os.Stat(journalPath)
+			assert.True(t, os.IsNotExist(err))
+
+			object, err := bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			assert.EqualValues(t, len(data), object.Meta.Size)
+
+			rc, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+			require.NoError(t, err)
+			downloaded, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, data, downloaded)
+		})
+}
+
+func TestObjectVersioning(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "versioned"
+		objectPath     = "reports/daily"
+		inBucketConfig = smallSegmentsBucketConfig(16 * memory.KiB)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			require.NoError(t, proj.SetBucketVersioning(ctx, bucketName, storj.VersioningEnabled))
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			download := func(object *uplink.Object) []byte {
+				rc, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+				require.NoError(t, err)
+				data, err := ioutil.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+				return data
+			}
+
+			first := testrand.BytesInt(40 * memory.KiB.Int())
+			second := testrand.BytesInt(2 * memory.KiB.Int())
+
+			require.NoError(t, bucket.UploadObject(ctx, objectPath, bytes.NewReader(first), nil))
+			require.NoError(t, bucket.UploadObject(ctx, objectPath, bytes.NewReader(second), nil))
+
+			latest, err := bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			require.NotEmpty(t, latest.Meta.VersionID)
+			assert.Equal(t, second, download(latest))
+
+			list, err := bucket.ListObjectVersions(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, list.Items, 2)
+			assert.Equal(t, objectPath, list.Items[0].Path)
+			assert.Equal(t, latest.Meta.VersionID, list.Items[0].VersionID)
+			assert.True(t, list.Items[0].IsLatest)
+			assert.False(t, list.Items[1].IsLatest)
+			firstVersionID := list.Items[1].VersionID
+
+			// the overwritten object can still be read
+			previous, err := bucket.OpenObjectVersion(ctx, objectPath, firstVersionID)
+			require.NoError(t, err)
+			assert.EqualValues(t, len(first), previous.Meta.Size)
+			assert.Equal(t, first, download(previous))
+
+			// deleting adds a delete marker
+			require.NoError(t, bucket.DeleteObject(ctx, objectPath))
+
+			_, err = bucket.OpenObject(ctx, objectPath)
+			require.True(t, storj.ErrObjectNotFound.Has(err))
+
+			list, err = bucket.ListObjectVersions(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, list.Items, 3)
+			assert.True(t, list.Items[0].IsDeleteMarker)
+			assert.True(t, list.Items[0].IsLatest)
+			markerID := list.Items[0].VersionID
+
+			// removing the delete marker restores the object
+			require.NoError(t, bucket.DeleteObjectVersion(ctx, objectPath, markerID))
+
+			latest, err = bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			assert.Equal(t, second, download(latest))
+
+			// removing the latest version makes the previous version the latest
+			require.NoError(t, bucket.DeleteObjectVersion(ctx, objectPath, latest.Meta.VersionID))
+
+			latest, err = bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			assert.Equal(t, firstVersionID, latest.Meta.VersionID)
+			assert.Equal(t, first, download(latest))
+
+			err = bucket.DeleteObjectVersion(ctx, objectPath, markerID)
+			require.True(t, storj.ErrObjectNotFound.Has(err))
+
+			// versioning can't be turned off again
+			require.Error(t, proj.SetBucketVersioning(ctx, bucketName, storj.VersioningUnversioned))
+		})
+}
+
+func TestPrefetchDownload(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "prefetch"
+		objectPath     = "large/object"
+		inBucketConfig = smallSegmentsBucketConfig(16 * memory.KiB)
+	)
+
+	cfg := testConfig{}
+	cfg.uplinkCfg.Volatile.PrefetchSegments = 3
+	cfg.uplinkCfg.Volatile.PrefetchMemory = 32 * memory.KiB
+
+	testPlanetWithLibUplink(t, cfg,
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			data := testrand.BytesInt(100 * memory.KiB.Int())
+			err = bucket.UploadObject(ctx, objectPath, bytes.NewReader(data), nil)
+			require.NoError(t, err)
+
+			object, err := bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			defer ctx.Check(object.Close)
+
+			for _, tt := range []struct {
+				offset, length int64
+			}{
+				{0, -1},
+				{10 * memory.KiB.Int64(), -1},
+				{20 * memory.KiB.Int64(), 50 * memory.KiB.Int64()},
+				{int64(len(data)) - 1, 1},
+			} {
+				rc, err := object.DownloadRange(ctx, tt.offset, tt.length)
+				require.NoError(t, err)
+				downloaded, err := ioutil.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+
+				end := int64(len(data))
+				if tt.length != -1 {
+					end = tt.offset + tt.length
+				}
+				assert.Equal(t, data[tt.offset:end], downloaded, "%+v", tt)
+			}
+		})
+}
+
+func TestCompressedUpload(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "compressed"
+		objectPath     = "logs/archive"
+		inBucketConfig = smallSegmentsBucketConfig(16 * memory.KiB)
+	)
+
+	cfg := testConfig{}
+	cfg.uplinkCfg.Volatile.Compression = storj.CompressionParameters{
+		Algorithm: storj.CompressionGzip,
+		BlockSize: 4 * memory.KiB.Int32(),
+	}
+
+	testPlanetWithLibUplink(t, cfg,
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			// compressible data with an incompressible part in the middle
+			logs := bytes.Repeat([]byte("log line\n"), 5*memory.KiB.Int())
+			var data []byte
+			data = append(data, logs...)
+			data = append(data, testrand.BytesInt(10*memory.KiB.Int())...)
+			data = append(data, logs...)
+
+			err = bucket.UploadObject(ctx, objectPath, bytes.NewReader(data), nil)
+			require.NoError(t, err)
+
+			satellite := planet.Satellites[0]
+			items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+			require.NoError(t, err)
+
+			var storedSize int64
+			for _, item := range items {
+				pointer, err := satellite.Metainfo.Service.Get(ctx, item.Path)
+				require.NoError(t, err)
+				storedSize += pointer.SegmentSize
+			}
+			assert.True(t, storedSize < int64(len(data))/2, "stored %d bytes of %d", storedSize, len(data))
+
+			object, err := bucket.OpenObject(ctx, objectPath)
+			require.NoError(t, err)
+			defer ctx.Check(object.Close)
+			assert.EqualValues(t, len(data), object.Meta.Size)
+
+			for _, tt := range []struct {
+				offset, length int64
+			}{
+				{0, -1},
+				{1, 100},
+				{40 * memory.KiB.Int64(), 20 * memory.KiB.Int64()},
+				{int64(len(data)) - 5000, -1},
+			} {
+				rc, err := object.DownloadRange(ctx, tt.offset, tt.length)
+				require.NoError(t, err)
+				downloaded, err := ioutil.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+
+				end := int64(len(data))
+				if tt.length != -1 {
+					end = tt.offset + tt.length
+				}
+				assert.Equal(t, data[tt.offset:end], downloaded, "%+v", tt)
+			}
+		})
+}
+
+func TestRekeyObjects(t *testing.T) {
+	var (
+		access    = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		newAccess = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{5, 6, 7, 8, 9})
+		objects   = map[storj.Path][]byte{
+			"dir/remote":     testrand.BytesInt(40 * memory.KiB.Int()),
+			"dir/sub/inline": testrand.BytesInt(100),
+			"other/remote":   testrand.BytesInt(20 * memory.KiB.Int()),
+		}
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			download := func(access *uplink.EncryptionAccess, bucketName string, path storj.Path) ([]byte, error) {
+				bucket, err := proj.OpenBucket(ctx, bucketName, access)
+				require.NoError(t, err)
+				defer ctx.Check(bucket.Close)
+
+				object, err := bucket.OpenObject(ctx, path)
+				if err != nil {
+					return nil, err
+				}
+				defer ctx.Check(object.Close)
+
+				rc, err := object.DownloadRange(ctx, 0, -1)
+				if err != nil {
+					return nil, err
+				}
+				defer ctx.Check(rc.Close)
+				return ioutil.ReadAll(rc)
+			}
+
+			for _, pathCipher := range []storj.CipherSuite{storj.EncAESGCM, storj.EncNull} {
+				bucketName := fmt.Sprintf("rekey-%d", pathCipher)
+				bucketConfig := smallSegmentsBucketConfig(16 * memory.KiB)
+				bucketConfig.PathCipher = pathCipher
+
+				_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+				require.NoError(t, err)
+
+				bucket, err := proj.OpenBucket(ctx, bucketName, access)
+				require.NoError(t, err)
+
+				for path, data := range objects {
+					err = bucket.UploadObject(ctx, path, bytes.NewReader(data), nil)
+					require.NoError(t, err)
+				}
+
+				rekeyed, err := bucket.RekeyObjects(ctx, "dir", newAccess)
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []storj.Path{"dir/remote", "dir/sub/inline"}, rekeyed)
+				require.NoError(t, bucket.Close())
+
+				for _, path := range rekeyed {
+					downloaded, err := download(newAccess, bucketName, path)
+					require.NoError(t, err, path)
+					assert.Equal(t, objects[path], downloaded, path)
+
+					_, err = download(access, bucketName, path)
+					assert.Error(t, err, path)
+				}
+
+				// objects outside of the prefix still use the old key
+				downloaded, err := download(access, bucketName, "other/remote")
+				require.NoError(t, err)
+				assert.Equal(t, objects["other/remote"], downloaded)
+			}
+
+			// without prefix all objects of the bucket are re-encrypted
+			_, err := proj.CreateBucket(ctx, "rekey-all", nil)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, "rekey-all", access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			err = bucket.UploadObject(ctx, "file", bytes.NewReader(objects["dir/sub/inline"]), nil)
+			require.NoError(t, err)
+
+			rekeyed, err := bucket.RekeyObjects(ctx, "", newAccess)
+			require.NoError(t, err)
+			assert.Equal(t, []storj.Path{"file"}, rekeyed)
+
+			downloaded, err := download(newAccess, "rekey-all", "file")
+			require.NoError(t, err)
+			assert.Equal(t, objects["dir/sub/inline"], downloaded)
+		})
+}
+
+func TestRekeyObjectVersions(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		newAccess      = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{5, 6, 7, 8, 9})
+		bucketName     = "rekey-versions"
+		inBucketConfig = smallSegmentsBucketConfig(16 * memory.KiB)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			require.NoError(t, proj.SetBucketVersioning(ctx, bucketName, storj.VersioningEnabled))
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+
+			first := testrand.BytesInt(40 * memory.KiB.Int())
+			second := testrand.BytesInt(100)
+
+			require.NoError(t, bucket.UploadObject(ctx, "docs/report", bytes.NewReader(first), nil))
+			require.NoError(t, bucket.UploadObject(ctx, "docs/report", bytes.NewReader(second), nil))
+			require.NoError(t, bucket.UploadObject(ctx, "docs/removed", bytes.NewReader(second), nil))
+			require.NoError(t, bucket.DeleteObject(ctx, "docs/removed"))
+
+			rules := []uplink.LifecycleRule{{ID: "old", Prefix: "docs/old", ExpireAfterDays: 30}}
+			require.NoError(t, bucket.SetLifecycle(ctx, rules))
+
+			versions, err := bucket.ListObjectVersions(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, versions.Items, 4)
+
+			// an interrupted run leaves some objects re-encrypted
+			require.NoError(t, bucket.RekeyObject(ctx, "docs/report", newAccess))
+
+			rekeyed, err := bucket.RekeyObjects(ctx, "docs", newAccess)
+			require.NoError(t, err)
+			assert.Equal(t, []storj.Path{"docs/removed"}, rekeyed)
+
+			// running it again after it completed doesn't change anything
+			rekeyed, err = bucket.RekeyObjects(ctx, "docs", newAccess)
+			require.NoError(t, err)
+			assert.Empty(t, rekeyed)
+			require.NoError(t, bucket.Close())
+
+			bucket, err = proj.OpenBucket(ctx, bucketName, newAccess)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			rekeyedVersions, err := bucket.ListObjectVersions(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, rekeyedVersions.Items, len(versions.Items))
+			for i, version := range versions.Items {
+				assert.Equal(t, version.Path, rekeyedVersions.Items[i].Path)
+				assert.Equal(t, version.VersionID, rekeyedVersions.Items[i].VersionID)
+				assert.Equal(t, version.IsLatest, rekeyedVersions.Items[i].IsLatest)
+				assert.Equal(t, version.IsDeleteMarker, rekeyedVersions.Items[i].IsDeleteMarker)
+				assert.Equal(t, version.Created, rekeyedVersions.Items[i].Created)
+			}
+
+			// the archived version can be read with the new key
+			previous, err := bucket.OpenObjectVersion(ctx, "docs/report", versions.Items[1].VersionID)
+			require.NoError(t, err)
+			rc, err := previous.DownloadRange(ctx, 0, -1)
+			require.NoError(t, err)
+			downloaded, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, first, downloaded)
+
+			// the delete marker still hides the deleted object
+			_, err = bucket.OpenObject(ctx, "docs/removed")
+			assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+			// the prefixes of the lifecycle rules are encrypted with the new key
+			stored, err := bucket.GetLifecycle(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, rules, stored)
+		})
+}
+
+func TestObjectIterator(t *testing.T) {
+	var (
+		access = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		paths  = []storj.Path{"a", "b/1", "b/2", "b/3", "c", "d/1", "e"}
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			// unencrypted paths are listed in lexicographic order
+			_, err := proj.CreateBucket(ctx, "iterator", &uplink.BucketConfig{PathCipher: storj.EncNull})
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, "iterator", access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			for _, path := range paths {
+				err = bucket.UploadObject(ctx, path, bytes.NewReader(testrand.BytesInt(100)), nil)
+				require.NoError(t, err)
+			}
+
+			iterate := func(opts *uplink.ListOptions) []storj.Path {
+				it := bucket.NewObjectIterator(ctx, opts)
+				defer ctx.Check(it.Close)
+
+				var listed []storj.Path
+				for it.Next() {
+					listed = append(listed, it.Item().Path)
+				}
+				require.NoError(t, it.Err())
+				return listed
+			}
+
+			for _, limit := range []int{0, 1, 2, 3} {
+				assert.Equal(t, []storj.Path{"a", "b/", "c", "d/", "e"},
+					iterate(&uplink.ListOptions{Direction: storj.Forward, Delimiter: '/', Limit: limit}), limit)
+				assert.Equal(t, paths,
+					iterate(&uplink.ListOptions{Direction: storj.Forward, Recursive: true, Limit: limit}), limit)
+				assert.Equal(t, []storj.Path{"2", "3"},
+					iterate(&uplink.ListOptions{Direction: storj.After, Prefix: "b", Cursor: "1", Limit: limit}), limit)
+				assert.Equal(t, []storj.Path{"c", "d/1", "e"},
+					iterate(&uplink.ListOptions{Direction: storj.Forward, Cursor: "c", Recursive: true, Limit: limit}), limit)
+			}
+			assert.Equal(t, []storj.Path{"a", "b/", "c", "d/", "e"}, iterate(nil))
+
+			// stopping early doesn't leak the prefetched page
+			it := bucket.NewObjectIterator(ctx, &uplink.ListOptions{Direction: storj.Forward, Recursive: true, Limit: 1})
+			require.True(t, it.Next())
+			require.NoError(t, it.Close())
+			assert.False(t, it.Next())
+
+			it = bucket.NewObjectIterator(ctx, &uplink.ListOptions{Direction: storj.Backward})
+			assert.False(t, it.Next())
+			assert.Error(t, it.Err())
+			require.NoError(t, it.Close())
+
+			it = bucket.NewObjectIterator(ctx, &uplink.ListOptions{Direction: storj.Forward, Delimiter: ','})
+			assert.False(t, it.Next())
+			assert.Error(t, it.Err())
+			require.NoError(t, it.Close())
+		})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "lifecycle"
+		inBucketConfig = smallSegmentsBucketConfig(16 * memory.KiB)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			rules, err := bucket.GetLifecycle(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, rules)
+
+			rules = []uplink.LifecycleRule{
+				{ID: "logs", Prefix: "logs/2019", ExpireAfterDays: 30},
+				{ID: "uploads", Disabled: true, AbortIncompleteUploadAfterDays: 7},
+			}
+			require.NoError(t, bucket.SetLifecycle(ctx, rules))
+
+			// the prefixes are stored encrypted
+			info, _, err := proj.GetBucketInfo(ctx, bucketName)
+			require.NoError(t, err)
+			if assert.Len(t, info.Lifecycle, 2) {
+				assert.NotEqual(t, "logs/2019", info.Lifecycle[0].Prefix)
+				assert.Equal(t, "", info.Lifecycle[1].Prefix)
+			}
+
+			stored, err := bucket.GetLifecycle(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, rules, stored)
+		})
+}
+
+func TestProjectLimitExceeded(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "limits"
+		inBucketConfig = smallSegmentsBucketConfig(memory.MiB)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			projectsDB := planet.Satellites[0].DB.Console().Projects()
+			projects, err := projectsDB.GetAll(ctx)
+			require.NoError(t, err)
+
+			// the limits are exceeded by the first upload and download
+			project := projects[0]
+			project.UsageLimit = memory.TB.Int64()
+			project.StorageLimit = memory.KB.Int64()
+			project.BandwidthLimit = memory.KB.Int64()
+			require.NoError(t, projectsDB.Update(ctx, &project))
+
+			_, err = proj.CreateBucket(ctx, bucketName, &inBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			data := testrand.Bytes(50 * memory.KiB)
+			err = bucket.UploadObject(ctx, "first", bytes.NewReader(data), nil)
+			require.NoError(t, err)
+
+			err = bucket.UploadObject(ctx, "second", bytes.NewReader(data), nil)
+			require.Error(t, err)
+			assert.True(t, uplink.ErrLimitExceeded.Has(err), err.Error())
+
+			download := func() ([]byte, error) {
+				object, err := bucket.OpenObject(ctx, "first")
+				if err != nil {
+					return nil, err
+				}
+				defer ctx.Check(object.Close)
+
+				rc, err := object.DownloadRange(ctx, 0, -1)
+				if err != nil {
+					return nil, err
+				}
+				defer ctx.Check(rc.Close)
+
+				return ioutil.ReadAll(rc)
+			}
+
+			downloaded, err := download()
+			require.NoError(t, err)
+			assert.Equal(t, data, downloaded)
+
+			_, err = download()
+			require.Error(t, err)
+			assert.True(t, uplink.ErrLimitExceeded.Has(err), err.Error())
+		})
+}
@@ -6,6 +6,8 @@ package uplink
 import (
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/storj"
 )
 
 var (
@@ -13,4 +15,9 @@ var (
 
 	// Error is the toplevel class of errors for the uplink library.
 	Error = errs.Class("libuplink")
+
+	// ErrLimitExceeded is returned by uploads and downloads, when the storage
+	// or the bandwidth limit of the project is exceeded. It refers to the class
+	// from storj, because classes are compared by their address.
+	ErrLimitExceeded = &storj.ErrLimitExceeded
 )
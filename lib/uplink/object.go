@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
+	"storj.io/storj/uplink/metainfo"
 )
 
 // ObjectMeta contains metadata about a specific Object.
@@ -48,6 +49,10 @@ type ObjectMeta struct {
 	// Checksum gives a checksum of the contents of the Object.
 	Checksum []byte
 
+	// VersionID identifies this version of the Object in a bucket with
+	// versioning. It is empty for Objects in buckets without versioning.
+	VersionID string
+
 	// Volatile groups config values that are likely to change semantics
 	// or go away entirely between releases. Be careful when using them!
 	Volatile struct {
@@ -76,6 +81,7 @@ type Object struct {
 
 	metainfoDB *kvmetainfo.DB
 	streams    streams.Store
+	prefetch   stream.PrefetchConfig
 }
 
 // DownloadRange returns an Object's data. A length of -1 will mean
@@ -83,12 +89,17 @@ type Object struct {
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if o.Meta.VersionID != "" {
+		// keep reading the opened version, even if the object is overwritten
+		ctx = metainfo.WithVersion(ctx, o.Meta.VersionID)
+	}
+
 	readOnlyStream, err := o.metainfoDB.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
 	if err != nil {
 		return nil, err
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, o.streams)
+	download := stream.NewPrefetchDownload(ctx, readOnlyStream, o.streams, o.prefetch)
 	_, err = download.Seek(offset, io.SeekStart)
 	if err != nil {
 		return nil, err
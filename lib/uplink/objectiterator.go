@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ObjectIterator iterates over the objects of a bucket, which match the list
+// options. Pages are requested from the satellite transparently and the next
+// page is prefetched while the current one is being consumed.
+//
+// Only forward listing is supported. Listing starts at Cursor, or right after
+// it with the After direction. Item paths are relative to Prefix.
+type ObjectIterator struct {
+	ctx    context.Context
+	cancel func()
+	bucket *Bucket
+	opts   ListOptions
+
+	items []storj.Object
+	item  storj.Object
+	next  chan objectPage
+	err   error
+}
+
+type objectPage struct {
+	list storj.ObjectList
+	err  error
+}
+
+// NewObjectIterator returns an iterator over the objects a user is authorized
+// to see. A nil opts lists the whole bucket non-recursively. The iterator must
+// be closed when it isn't iterated to the end.
+func (b *Bucket) NewObjectIterator(ctx context.Context, opts *ListOptions) *ObjectIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	it := &ObjectIterator{
+		ctx:    ctx,
+		cancel: cancel,
+		bucket: b,
+		opts:   ListOptions{Direction: storj.Forward},
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+
+	switch {
+	case it.opts.Direction != storj.Forward && it.opts.Direction != storj.After:
+		it.err = Error.New("invalid direction %d, only forward listing is supported", it.opts.Direction)
+	case it.opts.Delimiter != 0 && it.opts.Delimiter != '/':
+		it.err = Error.New("invalid delimiter %q, only '/' is supported", it.opts.Delimiter)
+	default:
+		it.next = it.fetch(it.opts)
+	}
+
+	return it
+}
+
+// fetch starts requesting the page for opts in the background.
+func (it *ObjectIterator) fetch(opts ListOptions) chan objectPage {
+	next := make(chan objectPage, 1)
+	go func() {
+		list, err := it.bucket.ListObjects(it.ctx, &opts)
+		next <- objectPage{list: list, err: err}
+	}()
+	return next
+}
+
+// Next prepares the next object for reading with Item. It returns false when
+// there are no more objects or an error happened, which is returned by Err.
+func (it *ObjectIterator) Next() bool {
+	for len(it.items) == 0 {
+		if it.err != nil || it.next == nil {
+			return false
+		}
+
+		var page objectPage
+		select {
+		case page = <-it.next:
+		case <-it.ctx.Done():
+			page.err = it.ctx.Err()
+		}
+		it.next = nil
+
+		if page.err != nil {
+			it.err = page.err
+			return false
+		}
+
+		if page.list.More && len(page.list.Items) > 0 {
+			it.opts = it.opts.NextPage(page.list)
+			it.next = it.fetch(it.opts)
+		}
+		it.items = page.list.Items
+	}
+
+	it.item, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Item returns the object prepared by the last call to Next.
+func (it *ObjectIterator) Item() storj.Object {
+	return it.item
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *ObjectIterator) Err() error {
+	return it.err
+}
+
+// Close stops prefetching and releases the resources of the iterator.
+func (it *ObjectIterator) Close() error {
+	it.cancel()
+	if it.next != nil {
+		<-it.next
+		it.next = nil
+	}
+	it.items = nil
+	return nil
+}
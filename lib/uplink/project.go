@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/uplink/metainfo"
 )
@@ -117,6 +118,14 @@ func (p *Project) DeleteBucket(ctx context.Context, bucket string) (err error) {
 	return p.project.DeleteBucket(ctx, bucket)
 }
 
+// SetBucketVersioning enables or suspends versioning for a bucket, if authorized.
+// Once enabled, versioning can only be suspended. While versioning is enabled,
+// overwritten and deleted objects are kept as older versions.
+func (p *Project) SetBucketVersioning(ctx context.Context, bucket string, versioning storj.BucketVersioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return p.project.SetBucketVersioning(ctx, bucket, versioning)
+}
+
 // BucketListOptions controls options to the ListBuckets() call.
 type BucketListOptions = storj.BucketListOptions
 
@@ -141,6 +150,10 @@ func (p *Project) GetBucketInfo(ctx context.Context, bucket string) (b storj.Buc
 		EncryptionParameters: b.DefaultEncryptionParameters,
 	}
 	cfg.Volatile.RedundancyScheme = b.DefaultRedundancyScheme
+	if b.RedundancyPolicy != nil {
+		// the satellite rejects segments that don't match its policy
+		cfg.Volatile.RedundancyScheme = *b.RedundancyPolicy
+	}
 	cfg.Volatile.SegmentsSize = memory.Size(b.DefaultSegmentsSize)
 	return b, cfg, nil
 }
@@ -179,7 +192,7 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 	}
 	segmentStore := segments.NewSegmentStore(p.metainfo, ec, rs, p.maxInlineSize.Int(), maxEncryptedSegmentSize)
 
-	streamStore, err := streams.NewStreamStore(segmentStore, cfg.Volatile.SegmentsSize.Int64(), access.store, int(encryptionParameters.BlockSize), encryptionParameters.CipherSuite, p.maxInlineSize.Int())
+	streamStore, err := streams.NewStreamStore(segmentStore, cfg.Volatile.SegmentsSize.Int64(), access.store, int(encryptionParameters.BlockSize), encryptionParameters.CipherSuite, p.maxInlineSize.Int(), p.uplinkCfg.Volatile.Compression)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +204,10 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 		bucket:       bucketInfo,
 		metainfo:     kvmetainfo.New(p.project, p.metainfo, streamStore, segmentStore, access.store),
 		streams:      streamStore,
+		prefetch: stream.PrefetchConfig{
+			Segments:  p.uplinkCfg.Volatile.PrefetchSegments,
+			MaxMemory: p.uplinkCfg.Volatile.PrefetchMemory.Int64(),
+		},
 	}, nil
 }
 
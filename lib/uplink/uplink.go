@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/peertls/tlsopts"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/uplink/metainfo"
 )
@@ -74,6 +75,24 @@ type Config struct {
 		// RequestTimeout is the maximum time to wait for a request response from another node.
 		// If not set, the library default (20 seconds) will be used.
 		RequestTimeout time.Duration
+
+		// PrefetchSegments is the number of segments that downloads fetch
+		// concurrently ahead of the reader. The segments are still delivered
+		// in order. If set to zero or one, segments are downloaded one after
+		// another.
+		PrefetchSegments int
+
+		// PrefetchMemory is the maximum amount of memory for buffering the
+		// prefetched segments of a download, which may limit the number of
+		// concurrently downloaded segments further. If not set, the library
+		// default (256 MiB) will be used.
+		PrefetchMemory memory.Size
+
+		// Compression determines how the data of new objects is compressed
+		// before it's encrypted. The data isn't compressed, if the algorithm
+		// is storj.CompressionNone. If the block size isn't set, the library
+		// default (256 KiB) will be used.
+		Compression storj.CompressionParameters
 	}
 }
 
@@ -100,6 +119,12 @@ func (cfg *Config) setDefaults(ctx context.Context) error {
 	if cfg.Volatile.RequestTimeout.Seconds() == 0 {
 		cfg.Volatile.RequestTimeout = defaultUplinkRequestTimeout
 	}
+	if cfg.Volatile.PrefetchMemory.Int() == 0 {
+		cfg.Volatile.PrefetchMemory = 256 * memory.MiB
+	}
+	if cfg.Volatile.Compression.BlockSize == 0 {
+		cfg.Volatile.Compression.BlockSize = 256 * memory.KiB.Int32()
+	}
 	return nil
 }
 
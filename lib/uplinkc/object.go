@@ -173,19 +173,7 @@ func list_objects(bucketRef C.BucketRef, cListOpts *C.ListOptions, cErr **C.char
 
 	scope := bucket.scope.child()
 
-	var opts *uplink.ListOptions
-	if unsafe.Pointer(cListOpts) != nil {
-		opts = &uplink.ListOptions{
-			Prefix:    C.GoString(cListOpts.cursor),
-			Cursor:    C.GoString(cListOpts.cursor),
-			Delimiter: rune(cListOpts.delimiter),
-			Recursive: bool(cListOpts.recursive),
-			Direction: storj.ListDirection(cListOpts.direction),
-			Limit:     int(cListOpts.limit),
-		}
-	}
-
-	objectList, err := bucket.ListObjects(scope.ctx, opts)
+	objectList, err := bucket.ListObjects(scope.ctx, newListOptions(cListOpts))
 	if err != nil {
 		*cErr = C.CString(err.Error())
 		return cObjList
@@ -210,6 +198,21 @@ func list_objects(bucketRef C.BucketRef, cListOpts *C.ListOptions, cErr **C.char
 	}
 }
 
+// newListOptions converts the C list options, which may be nil.
+func newListOptions(cListOpts *C.ListOptions) *uplink.ListOptions {
+	if unsafe.Pointer(cListOpts) == nil {
+		return nil
+	}
+	return &uplink.ListOptions{
+		Prefix:    C.GoString(cListOpts.prefix),
+		Cursor:    C.GoString(cListOpts.cursor),
+		Delimiter: rune(cListOpts.delimiter),
+		Recursive: bool(cListOpts.recursive),
+		Direction: storj.ListDirection(cListOpts.direction),
+		Limit:     int(cListOpts.limit),
+	}
+}
+
 // Download stores readcloser and context scope for downloading
 type Download struct {
 	scope
@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+// #include "uplink_definitions.h"
+import "C"
+
+import (
+	"storj.io/storj/lib/uplink"
+)
+
+// ObjectIterator is a scoped uplink.ObjectIterator
+type ObjectIterator struct {
+	scope
+	*uplink.ObjectIterator
+}
+
+// new_object_iterator returns an iterator over the objects a user is authorized
+// to see, which pages through the listing transparently.
+//export new_object_iterator
+func new_object_iterator(bucketRef C.BucketRef, cListOpts *C.ListOptions, cErr **C.char) C.ObjectIteratorRef {
+	bucket, ok := universe.Get(bucketRef._handle).(*Bucket)
+	if !ok {
+		*cErr = C.CString("invalid bucket")
+		return C.ObjectIteratorRef{}
+	}
+
+	scope := bucket.scope.child()
+
+	iterator := bucket.NewObjectIterator(scope.ctx, newListOptions(cListOpts))
+
+	return C.ObjectIteratorRef{universe.Add(&ObjectIterator{scope, iterator})}
+}
+
+// object_iterator_next prepares the next object for object_iterator_item. It
+// returns false when there are no more objects or an error happened.
+//export object_iterator_next
+func object_iterator_next(iteratorRef C.ObjectIteratorRef, cErr **C.char) C.bool {
+	iterator, ok := universe.Get(iteratorRef._handle).(*ObjectIterator)
+	if !ok {
+		*cErr = C.CString("invalid object iterator")
+		return C.bool(false)
+	}
+
+	if iterator.Next() {
+		return C.bool(true)
+	}
+
+	if err := iterator.Err(); err != nil {
+		*cErr = C.CString(err.Error())
+	}
+	return C.bool(false)
+}
+
+// object_iterator_item returns the object prepared by object_iterator_next.
+//export object_iterator_item
+func object_iterator_item(iteratorRef C.ObjectIteratorRef, cErr **C.char) C.ObjectInfo {
+	iterator, ok := universe.Get(iteratorRef._handle).(*ObjectIterator)
+	if !ok {
+		*cErr = C.CString("invalid object iterator")
+		return C.ObjectInfo{}
+	}
+
+	object := iterator.Item()
+	return newObjectInfo(&object)
+}
+
+// close_object_iterator stops the iteration and releases the iterator.
+//export close_object_iterator
+func close_object_iterator(iteratorRef C.ObjectIteratorRef, cErr **C.char) {
+	iterator, ok := universe.Get(iteratorRef._handle).(*ObjectIterator)
+	if !ok {
+		*cErr = C.CString("invalid object iterator")
+		return
+	}
+
+	universe.Del(iteratorRef._handle)
+	defer iterator.cancel()
+
+	if err := iterator.Close(); err != nil {
+		*cErr = C.CString(err.Error())
+		return
+	}
+}
@@ -138,6 +138,33 @@ void handle_project(ProjectRef project) {
         free_list_objects(&objects_list);
     }
 
+    { // Iterate objects page by page
+        ListOptions list_opts = {
+            .prefix = "",
+            .cursor = "",
+            .delimiter = '/',
+            .recursive = true,
+            .direction = STORJ_FORWARD,
+            .limit = 1,
+        };
+        ObjectIteratorRef iterator = new_object_iterator(bucket, &list_opts, err);
+        require_noerror(*err);
+
+        int count = 0;
+        while (object_iterator_next(iterator, err)) {
+            ObjectInfo object = object_iterator_item(iterator, err);
+            require_noerror(*err);
+            require(true == array_contains(object.path, object_paths, num_of_objects));
+            free_object_info(&object);
+            count++;
+        }
+        require_noerror(*err);
+        require(num_of_objects == count);
+
+        close_object_iterator(iterator, err);
+        require_noerror(*err);
+    }
+
     close_bucket(bucket, err);
     require_noerror(*err);
 }
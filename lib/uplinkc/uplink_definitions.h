@@ -7,10 +7,11 @@
 #include <string.h>
 
 typedef enum CipherSuite {
-    STORJ_ENC_UNSPECIFIED = 0,
-    STORJ_ENC_NULL        = 1,
-    STORJ_ENC_AESGCM      = 2,
-    STORJ_ENC_SECRET_BOX  = 3
+    STORJ_ENC_UNSPECIFIED        = 0,
+    STORJ_ENC_NULL               = 1,
+    STORJ_ENC_AESGCM             = 2,
+    STORJ_ENC_SECRET_BOX         = 3,
+    STORJ_ENC_XCHACHA20_POLY1305 = 4
 } CipherSuite;
 
 typedef enum RedundancyAlgorithm {
@@ -18,6 +19,13 @@ typedef enum RedundancyAlgorithm {
     STORJ_REED_SOLOMON                 = 1
 } RedundancyAlgorithm;
 
+typedef enum ListDirection {
+    STORJ_BEFORE   = -2,
+    STORJ_BACKWARD = -1,
+    STORJ_FORWARD  = 1,
+    STORJ_AFTER    = 2
+} ListDirection;
+
 typedef struct APIKey     { long _handle; } APIKeyRef;
 typedef struct Uplink     { long _handle; } UplinkRef;
 typedef struct Project    { long _handle; } ProjectRef;
@@ -25,6 +33,7 @@ typedef struct Bucket     { long _handle; } BucketRef;
 typedef struct Object     { long _handle; } ObjectRef;
 typedef struct Downloader { long _handle; } DownloaderRef;
 typedef struct Uploader   { long _handle; } UploaderRef;
+typedef struct ObjectIterator { long _handle; } ObjectIteratorRef;
 
 typedef struct UplinkConfig {
     struct {
@@ -18,11 +18,14 @@ const (
 	// CipherSuiteEncNull indicates use of the NULL cipher; that is, no encryption is
 	// done. The ciphertext is equal to the plaintext.
 	CipherSuiteEncNull = byte(storj.EncNull)
-	// CipherSuiteEncAESGCM indicates use of AES128-GCM encryption.
+	// CipherSuiteEncAESGCM indicates use of AES-GCM encryption with a 12-byte nonce.
 	CipherSuiteEncAESGCM = byte(storj.EncAESGCM)
 	// CipherSuiteEncSecretBox indicates use of XSalsa20-Poly1305 encryption, as provided
 	// by the NaCl cryptography library under the name "Secretbox".
 	CipherSuiteEncSecretBox = byte(storj.EncSecretBox)
+	// CipherSuiteEncXChaCha20Poly1305 indicates use of XChaCha20-Poly1305 encryption,
+	// an AEAD with a 24-byte nonce.
+	CipherSuiteEncXChaCha20Poly1305 = byte(storj.EncXChaCha20Poly1305)
 
 	// DirectionForward lists forwards from cursor, including cursor
 	DirectionForward = int(storj.Forward)
@@ -185,6 +188,30 @@ type ListOptions struct {
 func (bucket *Bucket) ListObjects(options *ListOptions) (*ObjectList, error) {
 	scope := bucket.scope.child()
 
+	list, err := bucket.lib.ListObjects(scope.ctx, newListOptions(options))
+	if err != nil {
+		return nil, safeError(err)
+	}
+	return &ObjectList{list}, nil
+}
+
+// NewObjectIterator returns an iterator over the objects in bucket, if
+// authorized. It pages through the listing transparently.
+func (bucket *Bucket) NewObjectIterator(options *ListOptions) *ObjectIterator {
+	scope := bucket.scope.child()
+
+	var opts *storj.ListOptions
+	if options != nil {
+		opts = newListOptions(options)
+	}
+
+	return &ObjectIterator{
+		scope:    scope,
+		iterator: bucket.lib.NewObjectIterator(scope.ctx, opts),
+	}
+}
+
+func newListOptions(options *ListOptions) *storj.ListOptions {
 	opts := &storj.ListOptions{}
 	if options != nil {
 		opts.Prefix = options.Prefix
@@ -194,12 +221,7 @@ func (bucket *Bucket) ListObjects(options *ListOptions) (*ObjectList, error) {
 		opts.Recursive = options.Recursive
 		opts.Limit = options.Limit
 	}
-
-	list, err := bucket.lib.ListObjects(scope.ctx, opts)
-	if err != nil {
-		return nil, safeError(err)
-	}
-	return &ObjectList{list}, nil
+	return opts
 }
 
 // OpenObject returns an Object handle, if authorized.
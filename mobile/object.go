@@ -94,3 +94,31 @@ func (bl *ObjectList) Item(index int) (*ObjectInfo, error) {
 	}
 	return newObjectInfoFromObject(bl.list.Items[index]), nil
 }
+
+// ObjectIterator iterates over the objects of a bucket
+type ObjectIterator struct {
+	scope
+	iterator *libuplink.ObjectIterator
+}
+
+// Next prepares the next object for reading with Item. It returns false when
+// there are no more objects or an error happened, which is returned by Err.
+func (it *ObjectIterator) Next() bool {
+	return it.iterator.Next()
+}
+
+// Item returns the object prepared by Next
+func (it *ObjectIterator) Item() *ObjectInfo {
+	return newObjectInfoFromObject(it.iterator.Item())
+}
+
+// Err returns the error, if any, that stopped the iteration
+func (it *ObjectIterator) Err() error {
+	return safeError(it.iterator.Err())
+}
+
+// Close stops the iteration
+func (it *ObjectIterator) Close() error {
+	defer it.cancel()
+	return safeError(it.iterator.Close())
+}
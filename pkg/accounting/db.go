@@ -53,6 +53,14 @@ type NodeSpaceUsage struct {
 	TimeStamp time.Time
 }
 
+// ProjectLimits contains the usage limits of a project, zero values mean the
+// limit isn't set for the project
+type ProjectLimits struct {
+	Usage     memory.Size
+	Storage   memory.Size
+	Bandwidth memory.Size
+}
+
 // StoragenodeAccounting stores information about bandwidth and storage usage for storage nodes
 type StoragenodeAccounting interface {
 	// SaveTallies records tallies of data at rest
@@ -87,4 +95,6 @@ type ProjectAccounting interface {
 	GetStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
 	// GetProjectUsageLimits returns project usage limit
 	GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error)
+	// GetProjectLimits returns the usage, storage and bandwidth limits of a project
+	GetProjectLimits(ctx context.Context, projectID uuid.UUID) (ProjectLimits, error)
 }
@@ -15,7 +15,7 @@ import (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend string `help:"what to use for storing real-time accounting data" default:"plainmemory"`
+	StorageBackend string `help:"what to use for storing real-time accounting data (plainmemory or a redis://host:port?db=N address)" default:"plainmemory"`
 }
 
 // Service represents the external interface to the live accounting
@@ -23,7 +23,8 @@ type Config struct {
 type Service interface {
 	GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
 	AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) error
-	ResetTotals()
+	ResetTotals(ctx context.Context) error
+	Close() error
 }
 
 // New creates a new live.Service instance of the type specified in
@@ -36,8 +37,11 @@ func New(log *zap.Logger, config Config) (Service, error) {
 	} else {
 		backendType = parts[0]
 	}
-	if backendType == "plainmemory" {
+	switch backendType {
+	case "plainmemory":
 		return newPlainMemoryLiveAccounting(log)
+	case "redis":
+		return newRedisLiveAccounting(log, config.StorageBackend)
 	}
 	return nil, errs.New("unrecognized live accounting backend specifier %q", backendType)
 }
@@ -64,7 +68,7 @@ type spaceUsedAccounting struct {
 
 func newPlainMemoryLiveAccounting(log *zap.Logger) (*plainMemoryLiveAccounting, error) {
 	pmac := &plainMemoryLiveAccounting{log: log}
-	pmac.ResetTotals()
+	pmac.resetTotals()
 	return pmac, nil
 }
 
@@ -93,9 +97,19 @@ func (pmac *plainMemoryLiveAccounting) AddProjectStorageUsage(ctx context.Contex
 // ResetTotals reset all space-used totals for all projects back to zero. This
 // would normally be done in concert with calculating new tally counts in the
 // accountingDB.
-func (pmac *plainMemoryLiveAccounting) ResetTotals() {
+func (pmac *plainMemoryLiveAccounting) ResetTotals(ctx context.Context) error {
 	pmac.log.Info("Resetting real-time accounting data")
+	pmac.resetTotals()
+	return nil
+}
+
+func (pmac *plainMemoryLiveAccounting) resetTotals() {
 	pmac.spaceMapLock.Lock()
 	pmac.spaceDeltas = make(map[uuid.UUID]spaceUsedAccounting)
 	pmac.spaceMapLock.Unlock()
 }
+
+// Close matches the live.Service interface. There is nothing to release.
+func (pmac *plainMemoryLiveAccounting) Close() error {
+	return nil
+}
@@ -15,24 +15,51 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/redis/redisserver"
 )
 
 func TestPlainMemoryLiveAccounting(t *testing.T) {
-	const (
-		valuesListSize  = 1000
-		valueMultiplier = 4096
-		numProjects     = 200
-	)
 	config := Config{
 		StorageBackend: "plainmemory:",
 	}
 	service, err := New(zap.L().Named("live-accounting"), config)
 	require.NoError(t, err)
+	defer func() { require.NoError(t, service.Close()) }()
 
 	// ensure we are using the expected underlying type
 	_, ok := service.(*plainMemoryLiveAccounting)
 	require.True(t, ok)
 
+	testConcurrentUsage(t, service)
+}
+
+func TestRedisLiveAccounting(t *testing.T) {
+	addr, cleanup, err := redisserver.Start()
+	require.NoError(t, err)
+	defer cleanup()
+
+	config := Config{
+		StorageBackend: "redis://" + addr + "?db=0",
+	}
+	service, err := New(zap.L().Named("live-accounting"), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, service.Close()) }()
+
+	// ensure we are using the expected underlying type
+	_, ok := service.(*redisLiveAccounting)
+	require.True(t, ok)
+
+	testConcurrentUsage(t, service)
+}
+
+func testConcurrentUsage(t *testing.T, service Service) {
+	const (
+		valuesListSize  = 1000
+		valueMultiplier = 4096
+		numProjects     = 200
+	)
+
 	// make a largish list of varying values
 	someValues := make([]int64, valuesListSize)
 	sum := int64(0)
@@ -85,14 +112,68 @@ func TestResetTotals(t *testing.T) {
 	}
 	service, err := New(zap.L().Named("live-accounting"), config)
 	require.NoError(t, err)
+	defer func() { require.NoError(t, service.Close()) }()
 
 	// ensure we are using the expected underlying type
 	_, ok := service.(*plainMemoryLiveAccounting)
 	require.True(t, ok)
 
+	testResetTotals(t, service)
+}
+
+func TestRedisResetTotals(t *testing.T) {
+	addr, cleanup, err := redisserver.Start()
+	require.NoError(t, err)
+	defer cleanup()
+
+	config := Config{
+		StorageBackend: "redis://" + addr + "?db=0",
+	}
+	service, err := New(zap.L().Named("live-accounting"), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, service.Close()) }()
+
+	// ensure we are using the expected underlying type
+	cache, ok := service.(*redisLiveAccounting)
+	require.True(t, ok)
+
+	// keys of other services must survive the reset
+	ctx := context.Background()
+	err = cache.client.Put(ctx, storage.Key("other-service"), storage.Value("value"))
+	require.NoError(t, err)
+
+	testResetTotals(t, service)
+
+	value, err := cache.client.Get(ctx, storage.Key("other-service"))
+	require.NoError(t, err)
+	assert.Equal(t, storage.Value("value"), value)
+}
+
+func testResetTotals(t *testing.T, service Service) {
 	ctx := context.Background()
 
 	projectID := testrand.UUID()
-	err = service.AddProjectStorageUsage(ctx, projectID, 0, -20)
+	err := service.AddProjectStorageUsage(ctx, projectID, 0, -20)
+	require.NoError(t, err)
+
+	err = service.AddProjectStorageUsage(ctx, projectID, 10, 50)
+	require.NoError(t, err)
+
+	inlineUsed, remoteUsed, err := service.GetProjectStorageUsage(ctx, projectID)
 	require.NoError(t, err)
+	assert.EqualValues(t, 10, inlineUsed)
+	assert.EqualValues(t, 30, remoteUsed)
+
+	err = service.ResetTotals(ctx)
+	require.NoError(t, err)
+
+	inlineUsed, remoteUsed, err = service.GetProjectStorageUsage(ctx, projectID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, inlineUsed)
+	assert.EqualValues(t, 0, remoteUsed)
+}
+
+func TestUnknownBackend(t *testing.T) {
+	_, err := New(zap.L().Named("live-accounting"), Config{StorageBackend: "memcached://localhost"})
+	require.Error(t, err)
 }
@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/redis"
+)
+
+// keyPrefix is the prefix of all live accounting keys in redis
+const keyPrefix = "live-accounting/"
+
+// redisLiveAccounting represents a live.Service-implementing instance using
+// redis as the backing store. Since all satellite servers can point at the
+// same redis database, the totals are shared between them. All keys start
+// with keyPrefix, so the database can be shared with other services.
+type redisLiveAccounting struct {
+	log    *zap.Logger
+	client *redis.Client
+}
+
+func newRedisLiveAccounting(log *zap.Logger, address string) (*redisLiveAccounting, error) {
+	client, err := redis.NewClientFrom(address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &redisLiveAccounting{
+		log:    log,
+		client: client,
+	}, nil
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *redisLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (inlineTotal, remoteTotal int64, err error) {
+	inlineTotal, err = cache.getInt64(ctx, inlineKey(projectID))
+	if err != nil {
+		return 0, 0, err
+	}
+	remoteTotal, err = cache.getInt64(ctx, remoteKey(projectID))
+	if err != nil {
+		return 0, 0, err
+	}
+	return inlineTotal, remoteTotal, nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added inlineSpaceUsed bytes of inline space usage
+// and remoteSpaceUsed bytes of remote space usage.
+func (cache *redisLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) error {
+	if inlineSpaceUsed != 0 {
+		if err := cache.client.IncrBy(ctx, inlineKey(projectID), inlineSpaceUsed); err != nil {
+			return err
+		}
+	}
+	if remoteSpaceUsed != 0 {
+		if err := cache.client.IncrBy(ctx, remoteKey(projectID), remoteSpaceUsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetTotals reset all space-used totals for all projects back to zero. This
+// would normally be done in concert with calculating new tally counts in the
+// accountingDB.
+func (cache *redisLiveAccounting) ResetTotals(ctx context.Context) error {
+	cache.log.Info("Resetting real-time accounting data")
+	return cache.client.DeletePrefix(ctx, storage.Key(keyPrefix))
+}
+
+// Close the redis client connection.
+func (cache *redisLiveAccounting) Close() error {
+	return cache.client.Close()
+}
+
+func (cache *redisLiveAccounting) getInt64(ctx context.Context, key storage.Key) (int64, error) {
+	value, err := cache.client.Get(ctx, key)
+	if storage.ErrKeyNotFound.Has(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return n, nil
+}
+
+func inlineKey(projectID uuid.UUID) storage.Key {
+	return storage.Key(keyPrefix + projectID.String() + "/inline")
+}
+
+func remoteKey(projectID uuid.UUID) storage.Key {
+	return storage.Key(keyPrefix + projectID.String() + "/remote")
+}
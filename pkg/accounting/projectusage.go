@@ -26,6 +26,8 @@ const (
 var (
 	// ErrProjectUsage general error for project usage
 	ErrProjectUsage = errs.Class("project usage error")
+	// ErrProjectLimitExceeded is the error class for requests exceeding the project limits
+	ErrProjectLimitExceeded = errs.Class("project limit exceeded")
 )
 
 // ProjectUsage defines project usage
@@ -45,7 +47,8 @@ func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.
 }
 
 // ExceedsBandwidthUsage returns true if the bandwidth usage limits have been exceeded
-// for a project in the past month (30 days). The usage limit is (e.g 25GB) multiplied by the redundancy
+// for a project in the past month (30 days). The limit is the project bandwidth limit,
+// or the project usage limit when it isn't set, or the global limit otherwise. The usage limit is (e.g 25GB) multiplied by the redundancy
 // expansion factor, so that the uplinks have a raw limit.
 // Ref: https://storjlabs.atlassian.net/browse/V3-1274
 func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketID []byte) (_ bool, limit memory.Size, err error) {
@@ -53,14 +56,11 @@ func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID
 
 	var group errgroup.Group
 	var bandwidthGetTotal int64
-	limit = usage.maxAlphaUsage
 
-	// TODO(michal): to reduce db load, consider using a cache to retrieve the project.UsageLimit value if needed
+	// TODO(michal): to reduce db load, consider using a cache to retrieve the project limits if needed
 	group.Go(func() error {
-		projectLimit, err := usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
-		if projectLimit > 0 {
-			limit = projectLimit
-		}
+		projectLimits, err := usage.projectAccountingDB.GetProjectLimits(ctx, projectID)
+		limit = usage.limit(projectLimits.Bandwidth, projectLimits.Usage)
 		return err
 	})
 	group.Go(func() error {
@@ -84,7 +84,8 @@ func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID
 }
 
 // ExceedsStorageUsage returns true if the storage usage limits have been exceeded
-// for a project in the past month (30 days). The usage limit is (e.g. 25GB) multiplied by the redundancy
+// for a project in the past month (30 days). The limit is the project storage limit,
+// or the project usage limit when it isn't set, or the global limit otherwise. The usage limit is (e.g. 25GB) multiplied by the redundancy
 // expansion factor, so that the uplinks have a raw limit.
 // Ref: https://storjlabs.atlassian.net/browse/V3-1274
 func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
@@ -92,14 +93,11 @@ func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uu
 
 	var group errgroup.Group
 	var inlineTotal, remoteTotal int64
-	limit = usage.maxAlphaUsage
 
-	// TODO(michal): to reduce db load, consider using a cache to retrieve the project.UsageLimit value if needed
+	// TODO(michal): to reduce db load, consider using a cache to retrieve the project limits if needed
 	group.Go(func() error {
-		projectLimit, err := usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
-		if projectLimit > 0 {
-			limit = projectLimit
-		}
+		projectLimits, err := usage.projectAccountingDB.GetProjectLimits(ctx, projectID)
+		limit = usage.limit(projectLimits.Storage, projectLimits.Usage)
 		return err
 	})
 	group.Go(func() error {
@@ -120,6 +118,16 @@ func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uu
 	return false, limit, nil
 }
 
+// limit returns the first of the limits which is set, or the global limit
+func (usage *ProjectUsage) limit(limits ...memory.Size) memory.Size {
+	for _, limit := range limits {
+		if limit > 0 {
+			return limit
+		}
+	}
+	return usage.maxAlphaUsage
+}
+
 func (usage *ProjectUsage) getProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (inline int64, remote int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -29,10 +29,9 @@ func TestProjectUsageStorage(t *testing.T) {
 		name             string
 		expectedExceeded bool
 		expectedResource string
-		expectedErrMsg   string
 	}{
-		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
+		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false},
+		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage"},
 	}
 
 	testplanet.Run(t, testplanet.Config{
@@ -69,7 +68,8 @@ func TestProjectUsageStorage(t *testing.T) {
 				// Execute test: check that the uplink gets an error when they have exceeded storage limits and try to upload a file
 				actualErr := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", expectedData)
 				if testCase.expectedResource == "storage" {
-					assert.EqualError(t, actualErr, testCase.expectedErrMsg)
+					require.Error(t, actualErr)
+					assert.True(t, storj.ErrLimitExceeded.Has(actualErr), actualErr.Error())
 				} else {
 					require.NoError(t, actualErr)
 				}
@@ -83,10 +83,9 @@ func TestProjectUsageBandwidth(t *testing.T) {
 		name             string
 		expectedExceeded bool
 		expectedResource string
-		expectedErrMsg   string
 	}{
-		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
+		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false},
+		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth"},
 	}
 
 	for _, tt := range cases {
@@ -128,7 +127,8 @@ func TestProjectUsageBandwidth(t *testing.T) {
 				// Execute test: check that the uplink gets an error when they have exceeded bandwidth limits and try to download a file
 				_, actualErr := planet.Uplinks[0].Download(ctx, planet.Satellites[0], bucketName, filePath)
 				if testCase.expectedResource == "bandwidth" {
-					assert.EqualError(t, actualErr, testCase.expectedErrMsg)
+					require.Error(t, actualErr)
+					assert.True(t, storj.ErrLimitExceeded.Has(actualErr), actualErr.Error())
 				} else {
 					require.NoError(t, actualErr)
 				}
@@ -285,3 +285,51 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 		assert.Error(t, actualErr)
 	})
 }
+
+func TestProjectStorageAndBandwidthLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		projectsDB := satellite.DB.Console().Projects()
+		projects, err := projectsDB.GetAll(ctx)
+		require.NoError(t, err)
+
+		// set limits which are exceeded by the first upload and download,
+		// the usage limit is large enough to not be exceeded
+		project := projects[0]
+		project.UsageLimit = memory.TB.Int64()
+		project.StorageLimit = memory.KB.Int64()
+		project.BandwidthLimit = memory.KB.Int64()
+		require.NoError(t, projectsDB.Update(ctx, &project))
+
+		projectUsage := satellite.Accounting.ProjectUsage
+
+		data := testrand.Bytes(50 * memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "first", data))
+
+		exceeded, limit, err := projectUsage.ExceedsStorageUsage(ctx, project.ID)
+		require.NoError(t, err)
+		assert.True(t, exceeded)
+		assert.Equal(t, memory.KB, limit)
+
+		err = uplink.Upload(ctx, satellite, "testbucket", "second", data)
+		require.Error(t, err)
+		assert.True(t, storj.ErrLimitExceeded.Has(err), err.Error())
+
+		downloaded, err := uplink.Download(ctx, satellite, "testbucket", "first")
+		require.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+
+		exceeded, limit, err = projectUsage.ExceedsBandwidthUsage(ctx, project.ID, createBucketID(project.ID, []byte("testbucket")))
+		require.NoError(t, err)
+		assert.True(t, exceeded)
+		assert.Equal(t, memory.KB, limit)
+
+		_, err = uplink.Download(ctx, satellite, "testbucket", "first")
+		require.Error(t, err)
+		assert.True(t, storj.ErrLimitExceeded.Has(err), err.Error())
+	})
+}
@@ -79,7 +79,10 @@ func (t *Service) Tally(ctx context.Context) (err error) {
 	// double-counted (counted in the tally and also counted as a delta to
 	// the tally). If that happens, it will be fixed at the time of the next
 	// tally run.
-	t.liveAccounting.ResetTotals()
+	err = t.liveAccounting.ResetTotals(ctx)
+	if err != nil {
+		return errs.New("Resetting live accounting totals failed : %v", err)
+	}
 
 	var errAtRest, errBucketInfo error
 	latestTally, nodeData, bucketData, err := t.CalculateAtRestData(ctx)
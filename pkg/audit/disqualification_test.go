@@ -212,7 +212,7 @@ func TestDisqualifiedNodeRemainsDisqualified(t *testing.T) {
 		disqualifiedNode := planet.StorageNodes[0]
 		disqualifyNode(t, ctx, satellite, disqualifiedNode.ID())
 
-		_, err = satellite.DB.OverlayCache().UpdateUptime(ctx, disqualifiedNode.ID(), true, 0, 1, 0)
+		_, err = satellite.DB.OverlayCache().UpdateUptime(ctx, disqualifiedNode.ID(), true, 0, 1, 0, overlay.SuspensionConfig{})
 		require.NoError(t, err)
 
 		assert.True(t, isDisqualified(t, ctx, satellite, disqualifiedNode.ID()))
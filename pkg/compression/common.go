@@ -0,0 +1,17 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package compression implements compressing the data of a segment in blocks,
+// which can be decompressed independently. This keeps ranged downloads of
+// compressed data possible without reading it from the beginning.
+package compression
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var mon = monkit.Package()
+
+// Error is the default compression errs class
+var Error = errs.Class("compression error")
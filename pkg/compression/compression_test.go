@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compression_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/compression"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestCompressRange(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	params := storj.CompressionParameters{
+		Algorithm: storj.CompressionGzip,
+		BlockSize: 1024,
+	}
+
+	compressible := bytes.Repeat([]byte("compressible "), 1000)
+	mixed := append(testrand.BytesInt(3000), compressible...)
+
+	for _, data := range [][]byte{
+		{},
+		compressible,
+		testrand.BytesInt(5000),
+		mixed,
+	} {
+		reader, err := compression.NewReader(bytes.NewReader(data), params)
+		require.NoError(t, err)
+
+		compressed, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.True(t, len(compressed) <= len(data))
+		assert.EqualValues(t, len(compressed), compression.CompressedSize(reader.BlockSizes()))
+
+		rr, err := compression.NewRanger(ranger.ByteRanger(compressed), params, int64(len(data)), reader.BlockSizes())
+		require.NoError(t, err)
+		assert.EqualValues(t, len(data), rr.Size())
+
+		for _, r := range []struct{ offset, length int64 }{
+			{0, int64(len(data))},
+			{0, 0},
+			{1, 1023},
+			{1000, 100},
+			{1024, 2048},
+			{int64(len(data)) / 2, int64(len(data)) / 4},
+		} {
+			if r.offset+r.length > int64(len(data)) {
+				continue
+			}
+			rc, err := rr.Range(ctx, r.offset, r.length)
+			require.NoError(t, err)
+			result, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, data[r.offset:r.offset+r.length], result, "%+v", r)
+		}
+
+		_, err = rr.Range(ctx, 0, int64(len(data))+1)
+		assert.Error(t, err)
+	}
+
+	// compressible data is smaller
+	reader, err := compression.NewReader(bytes.NewReader(compressible), params)
+	require.NoError(t, err)
+	compressed, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.True(t, len(compressed) < len(compressible)/10)
+}
+
+func TestCompressedRange(t *testing.T) {
+	params := storj.CompressionParameters{
+		Algorithm: storj.CompressionGzip,
+		BlockSize: 1024,
+	}
+	blockSizes := []int32{100, 200, 300, 50}
+
+	for _, r := range []struct {
+		offset, length                     int64
+		compressedOffset, compressedLength int64
+	}{
+		{0, 4096, 0, 650},
+		{0, 1, 0, 100},
+		{1023, 2, 0, 300},
+		{1024, 1024, 100, 200},
+		{1500, 1000, 100, 500},
+		{4095, 1, 600, 50},
+		{100, 0, 0, 0},
+	} {
+		offset, length := compression.CompressedRange(params, blockSizes, r.offset, r.length)
+		assert.Equal(t, r.compressedOffset, offset, "%+v", r)
+		assert.Equal(t, r.compressedLength, length, "%+v", r)
+	}
+}
+
+func TestNewRangerInvalid(t *testing.T) {
+	params := storj.CompressionParameters{
+		Algorithm: storj.CompressionGzip,
+		BlockSize: 100,
+	}
+
+	_, err := compression.NewReader(bytes.NewReader(nil), storj.CompressionParameters{BlockSize: 100})
+	assert.Error(t, err)
+	_, err = compression.NewReader(bytes.NewReader(nil), storj.CompressionParameters{Algorithm: storj.CompressionGzip})
+	assert.Error(t, err)
+
+	data := make([]byte, 150)
+	for _, blockSizes := range [][]int32{
+		nil,
+		{100},
+		{100, 50, 0},
+		{101, 49},
+		{0, 150},
+	} {
+		_, err := compression.NewRanger(ranger.ByteRanger(data), params, 150, blockSizes)
+		assert.Error(t, err, "%v", blockSizes)
+	}
+
+	_, err = compression.NewRanger(ranger.ByteRanger(data), params, 150, []int32{100, 50})
+	assert.NoError(t, err)
+}
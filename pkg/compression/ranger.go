@@ -0,0 +1,189 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+
+	"storj.io/storj/internal/readcloser"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+// CompressedSize returns the size of the compressed data with the given block sizes
+func CompressedSize(blockSizes []int32) int64 {
+	var size int64
+	for _, blockSize := range blockSizes {
+		size += int64(blockSize)
+	}
+	return size
+}
+
+// CompressedRange returns the range of the compressed data with the given block
+// sizes, which contains the compressed blocks of the given range of the data
+func CompressedRange(params storj.CompressionParameters, blockSizes []int32, offset, length int64) (compressedOffset, compressedLength int64) {
+	if length <= 0 || params.BlockSize <= 0 {
+		return 0, 0
+	}
+
+	blockSize := int64(params.BlockSize)
+	firstBlock := offset / blockSize
+	lastBlock := (offset + length - 1) / blockSize
+	if lastBlock >= int64(len(blockSizes)) {
+		lastBlock = int64(len(blockSizes)) - 1
+	}
+
+	for _, size := range blockSizes[:firstBlock] {
+		compressedOffset += int64(size)
+	}
+	for _, size := range blockSizes[firstBlock : lastBlock+1] {
+		compressedLength += int64(size)
+	}
+	return compressedOffset, compressedLength
+}
+
+type compressedRanger struct {
+	rr      ranger.Ranger
+	params  storj.CompressionParameters
+	size    int64
+	offsets []int64
+}
+
+// NewRanger returns a ranger for the decompressed data of rr. The data of rr
+// was compressed with params in blocks of the given sizes and size is the
+// size of the decompressed data.
+func NewRanger(rr ranger.Ranger, params storj.CompressionParameters, size int64, blockSizes []int32) (ranger.Ranger, error) {
+	if err := validate(params); err != nil {
+		return nil, err
+	}
+
+	blockSize := int64(params.BlockSize)
+	blockCount := (size + blockSize - 1) / blockSize
+	if int64(len(blockSizes)) != blockCount {
+		return nil, Error.New("invalid number of compressed blocks: got %d, expected %d", len(blockSizes), blockCount)
+	}
+
+	cr := &compressedRanger{
+		rr:      rr,
+		params:  params,
+		size:    size,
+		offsets: make([]int64, len(blockSizes)+1),
+	}
+	for i, compressedSize := range blockSizes {
+		if compressedSize <= 0 || compressedSize > cr.blockSize(int64(i)) {
+			return nil, Error.New("invalid size of compressed block %d: %d", i, compressedSize)
+		}
+		cr.offsets[i+1] = cr.offsets[i] + int64(compressedSize)
+	}
+	if cr.offsets[len(blockSizes)] != rr.Size() {
+		return nil, Error.New("invalid compressed size: got %d, expected %d", rr.Size(), cr.offsets[len(blockSizes)])
+	}
+
+	return cr, nil
+}
+
+// Size implements Ranger.Size
+func (cr *compressedRanger) Size() int64 {
+	return cr.size
+}
+
+// Range implements Ranger.Range. Only the compressed blocks containing the
+// requested range are read from the underlying ranger.
+func (cr *compressedRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if offset < 0 {
+		return nil, Error.New("negative offset")
+	}
+	if length < 0 {
+		return nil, Error.New("negative length")
+	}
+	if offset+length > cr.size {
+		return nil, Error.New("range beyond end")
+	}
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	blockSize := int64(cr.params.BlockSize)
+	firstBlock := offset / blockSize
+	lastBlock := (offset + length - 1) / blockSize
+
+	rc, err := cr.rr.Range(ctx, cr.offsets[firstBlock], cr.offsets[lastBlock+1]-cr.offsets[firstBlock])
+	if err != nil {
+		return nil, err
+	}
+
+	return readcloser.LimitReadCloser(&decompressedReader{
+		rc:        rc,
+		ranger:    cr,
+		nextBlock: firstBlock,
+		lastBlock: lastBlock,
+		skip:      offset - firstBlock*blockSize,
+	}, length), nil
+}
+
+// blockSize returns the decompressed size of the block with the given index
+func (cr *compressedRanger) blockSize(index int64) int32 {
+	remaining := cr.size - index*int64(cr.params.BlockSize)
+	if remaining < int64(cr.params.BlockSize) {
+		return int32(remaining)
+	}
+	return cr.params.BlockSize
+}
+
+// decompressedReader decompresses the blocks of a compressed range one by one
+type decompressedReader struct {
+	rc     io.ReadCloser
+	ranger *compressedRanger
+
+	nextBlock  int64
+	lastBlock  int64
+	skip       int64
+	compressed []byte
+	out        []byte
+}
+
+func (reader *decompressedReader) Read(p []byte) (n int, err error) {
+	for len(reader.out) == 0 {
+		if reader.nextBlock > reader.lastBlock {
+			return 0, io.EOF
+		}
+
+		index := reader.nextBlock
+		compressedSize := int(reader.ranger.offsets[index+1] - reader.ranger.offsets[index])
+		if cap(reader.compressed) < compressedSize {
+			reader.compressed = make([]byte, compressedSize)
+		}
+		reader.compressed = reader.compressed[:compressedSize]
+
+		_, err = io.ReadFull(reader.rc, reader.compressed)
+		if err != nil {
+			if err == io.EOF {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		block, err := decompress(reader.ranger.params.Algorithm, reader.compressed, int(reader.ranger.blockSize(index)))
+		if err != nil {
+			return 0, err
+		}
+
+		reader.out = block[reader.skip:]
+		reader.skip = 0
+		reader.nextBlock++
+	}
+
+	n = copy(p, reader.out)
+	reader.out = reader.out[n:]
+	return n, nil
+}
+
+func (reader *decompressedReader) Close() error {
+	return reader.rc.Close()
+}
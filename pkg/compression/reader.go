@@ -0,0 +1,143 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Reader compresses the data read from an underlying reader in blocks of
+// a fixed size. Every block is compressed independently, blocks that don't
+// get smaller are stored uncompressed.
+type Reader struct {
+	r      io.Reader
+	params storj.CompressionParameters
+
+	block      []byte
+	buf        bytes.Buffer
+	gzip       *gzip.Writer
+	out        []byte
+	eof        bool
+	blockSizes []int32
+}
+
+// NewReader returns a reader, which compresses the data of r with the given parameters
+func NewReader(r io.Reader, params storj.CompressionParameters) (*Reader, error) {
+	if err := validate(params); err != nil {
+		return nil, err
+	}
+	return &Reader{
+		r:      r,
+		params: params,
+		block:  make([]byte, params.BlockSize),
+	}, nil
+}
+
+// Read reads the compressed data
+func (reader *Reader) Read(p []byte) (n int, err error) {
+	for len(reader.out) == 0 {
+		if reader.eof {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(reader.r, reader.block)
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			reader.eof = true
+		default:
+			return 0, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		reader.out, err = reader.compress(reader.block[:n])
+		if err != nil {
+			return 0, err
+		}
+		reader.blockSizes = append(reader.blockSizes, int32(len(reader.out)))
+	}
+
+	n = copy(p, reader.out)
+	reader.out = reader.out[n:]
+	return n, nil
+}
+
+// BlockSizes returns the sizes of the compressed blocks read so far
+func (reader *Reader) BlockSizes() []int32 {
+	return reader.blockSizes
+}
+
+// compress returns the compressed block, or the block itself when compressing
+// doesn't make it smaller
+func (reader *Reader) compress(block []byte) ([]byte, error) {
+	reader.buf.Reset()
+
+	switch reader.params.Algorithm {
+	case storj.CompressionGzip:
+		if reader.gzip == nil {
+			reader.gzip = gzip.NewWriter(&reader.buf)
+		} else {
+			reader.gzip.Reset(&reader.buf)
+		}
+		if _, err := reader.gzip.Write(block); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if err := reader.gzip.Close(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	default:
+		return nil, Error.New("unsupported algorithm %v", reader.params.Algorithm)
+	}
+
+	if reader.buf.Len() >= len(block) {
+		return block, nil
+	}
+	return reader.buf.Bytes(), nil
+}
+
+// decompress returns the decompressed block of the given size
+func decompress(algorithm storj.CompressionAlgorithm, data []byte, size int) ([]byte, error) {
+	if len(data) == size {
+		// the block is stored uncompressed
+		return data, nil
+	}
+
+	switch algorithm {
+	case storj.CompressionGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		block, err := ioutil.ReadAll(io.LimitReader(zr, int64(size)+1))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if len(block) != size {
+			return nil, Error.New("invalid decompressed block size: got %d, expected %d", len(block), size)
+		}
+		return block, nil
+	default:
+		return nil, Error.New("unsupported algorithm %v", algorithm)
+	}
+}
+
+// validate checks whether data can be compressed with params
+func validate(params storj.CompressionParameters) error {
+	switch params.Algorithm {
+	case storj.CompressionGzip:
+	default:
+		return Error.New("unsupported algorithm %v", params.Algorithm)
+	}
+	if params.BlockSize <= 0 {
+		return Error.New("invalid block size %d", params.BlockSize)
+	}
+	return nil
+}
@@ -208,7 +208,7 @@ func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Poi
 			Path:         []byte(path),
 			LostPieces:   missingPieces,
 			InsertedTime: time.Now().UTC(),
-		})
+		}, int(numHealthy))
 		if err != nil {
 			return Error.New("error adding injured segment to queue %s", err)
 		}
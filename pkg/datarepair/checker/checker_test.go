@@ -271,7 +271,7 @@ type mockRepairQueue struct {
 	injuredSegments []pb.InjuredSegment
 }
 
-func (mockRepairQueue *mockRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment) error {
+func (mockRepairQueue *mockRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment, numHealthy int) error {
 	if bytes.Equal(s.Path, []byte("b")) || bytes.Equal(s.Path, []byte("d")) {
 		return errs.New("mock Insert error")
 	}
@@ -308,3 +308,11 @@ func (mockRepairQueue *mockRepairQueue) Delete(ctx context.Context, s *pb.Injure
 func (mockRepairQueue *mockRepairQueue) SelectN(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
 	return []pb.InjuredSegment{}, errs.New("mock SelectN error")
 }
+
+func (mockRepairQueue *mockRepairQueue) Count(ctx context.Context) (int, error) {
+	return len(mockRepairQueue.injuredSegments), nil
+}
+
+func (mockRepairQueue *mockRepairQueue) CountByHealthyPieces(ctx context.Context) (map[int]int, error) {
+	return nil, errs.New("mock CountByHealthyPieces error")
+}
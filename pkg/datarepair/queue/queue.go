@@ -10,14 +10,20 @@ import (
 )
 
 // RepairQueue implements queueing for segments that need repairing.
+// Segments with fewer healthy pieces are more urgent and selected first.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 type RepairQueue interface {
-	// Insert adds an injured segment.
-	Insert(ctx context.Context, s *pb.InjuredSegment) error
-	// Select gets an injured segment.
+	// Insert adds an injured segment, or updates its number of healthy pieces
+	// when it's already queued.
+	Insert(ctx context.Context, s *pb.InjuredSegment, numHealthy int) error
+	// Select gets the most urgent injured segment.
 	Select(ctx context.Context) (*pb.InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *pb.InjuredSegment) error
-	// SelectN lists limit amount of injured segments.
+	// SelectN lists limit amount of injured segments, most urgent first.
 	SelectN(ctx context.Context, limit int) ([]pb.InjuredSegment, error)
+	// Count counts the number of injured segments.
+	Count(ctx context.Context) (int, error)
+	// CountByHealthyPieces counts the injured segments by their number of healthy pieces.
+	CountByHealthyPieces(ctx context.Context) (map[int]int, error)
 }
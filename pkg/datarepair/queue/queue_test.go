@@ -29,7 +29,7 @@ func TestInsertSelect(t *testing.T) {
 			Path:       []byte("abc"),
 			LostPieces: []int32{int32(1), int32(3)},
 		}
-		err := q.Insert(ctx, seg)
+		err := q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
 		s, err := q.Select(ctx)
 		require.NoError(t, err)
@@ -50,10 +50,49 @@ func TestInsertDuplicate(t *testing.T) {
 			Path:       []byte("abc"),
 			LostPieces: []int32{int32(1), int32(3)},
 		}
-		err := q.Insert(ctx, seg)
+		err := q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
-		err = q.Insert(ctx, seg)
+		err = q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
+
+		count, err := q.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+}
+
+func TestPriority(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		healthy := &pb.InjuredSegment{Path: []byte("healthy")}
+		urgent := &pb.InjuredSegment{Path: []byte("urgent")}
+		other := &pb.InjuredSegment{Path: []byte("other")}
+
+		require.NoError(t, q.Insert(ctx, healthy, 10))
+		require.NoError(t, q.Insert(ctx, urgent, 5))
+		require.NoError(t, q.Insert(ctx, other, 10))
+
+		counts, err := q.CountByHealthyPieces(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[int]int{5: 1, 10: 2}, counts)
+
+		// reinserting updates the priority of the segment
+		require.NoError(t, q.Insert(ctx, other, 3))
+
+		counts, err = q.CountByHealthyPieces(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[int]int{3: 1, 5: 1, 10: 1}, counts)
+
+		for _, expected := range []*pb.InjuredSegment{other, urgent, healthy} {
+			s, err := q.Select(ctx)
+			require.NoError(t, err)
+			require.True(t, pb.Equal(expected, s))
+			require.NoError(t, q.Delete(ctx, s))
+		}
 	})
 }
 
@@ -84,7 +123,7 @@ func TestSequential(t *testing.T) {
 				Path:       []byte(strconv.Itoa(i)),
 				LostPieces: []int32{int32(i)},
 			}
-			err := q.Insert(ctx, seg)
+			err := q.Insert(ctx, seg, i)
 			require.NoError(t, err)
 			addSegs = append(addSegs, seg)
 		}
@@ -96,14 +135,12 @@ func TestSequential(t *testing.T) {
 			require.True(t, pb.Equal(addSegs[i], &list[i]))
 		}
 
-		// TODO: fix out of order issue
 		for i := 0; i < N; i++ {
 			s, err := q.Select(ctx)
 			require.NoError(t, err)
 			err = q.Delete(ctx, s)
 			require.NoError(t, err)
-			expected := s.LostPieces[0]
-			require.True(t, pb.Equal(addSegs[expected], s))
+			require.True(t, pb.Equal(addSegs[i], s))
 		}
 	})
 }
@@ -125,7 +162,7 @@ func TestParallel(t *testing.T) {
 				return q.Insert(ctx, &pb.InjuredSegment{
 					Path:       []byte(strconv.Itoa(i)),
 					LostPieces: []int32{int32(i)},
-				})
+				}, i)
 			})
 		}
 		require.Empty(t, inserts.Wait(), "unexpected queue.Insert errors")
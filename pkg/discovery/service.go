@@ -28,6 +28,7 @@ var (
 
 // Config loads on the configuration values for the cache
 type Config struct {
+	Enabled            bool          `help:"set if the satellite discovers nodes with kademlia lookups and refreshes them, requires kademlia" default:"true"`
 	RefreshInterval    time.Duration `help:"the interval at which the cache refreshes itself in seconds" default:"1s"`
 	DiscoveryInterval  time.Duration `help:"the interval at which the satellite attempts to find new nodes via random node ID lookups" default:"1s"`
 	RefreshLimit       int           `help:"the amount of nodes read from the overlay cache in a single pagination call" default:"100"`
@@ -191,6 +191,7 @@ func (dr *decodedRanger) Range(ctx context.Context, offset, length int64) (_ io.
 	// offset and length might not be block-aligned. figure out which
 	// blocks contain this request
 	firstBlock, blockCount := encryption.CalcEncompassingBlocks(offset, length, dr.es.StripeSize())
+	pieceOffset, pieceLength := CalcPieceRange(offset, length, dr.es)
 	// go ask for ranges for all those block boundaries
 	// do it parallel to save from network latency
 	readers := make(map[int]io.ReadCloser, len(dr.rrs))
@@ -202,9 +203,7 @@ func (dr *decodedRanger) Range(ctx context.Context, offset, length int64) (_ io.
 	result := make(chan indexReadCloser, len(dr.rrs))
 	for i, rr := range dr.rrs {
 		go func(i int, rr ranger.Ranger) {
-			r, err := rr.Range(ctx,
-				firstBlock*int64(dr.es.ErasureShareSize()),
-				blockCount*int64(dr.es.ErasureShareSize()))
+			r, err := rr.Range(ctx, pieceOffset, pieceLength)
 			result <- indexReadCloser{i: i, r: r, err: err}
 		}(i, rr)
 	}
@@ -299,3 +299,12 @@ func CalcPieceSize(dataSize int64, scheme ErasureScheme) int64 {
 
 	return pieceSize
 }
+
+// CalcPieceRange calculates the range of every piece, which has to be read for
+// decoding the given range of the data. The range of the pieces covers the
+// erasure shares of all stripes intersecting the range of the data.
+func CalcPieceRange(offset, length int64, scheme ErasureScheme) (pieceOffset, pieceLength int64) {
+	firstStripe, stripeCount := encryption.CalcEncompassingBlocks(offset, length, scheme.StripeSize())
+	shareSize := int64(scheme.ErasureShareSize())
+	return firstStripe * shareSize, stripeCount * shareSize
+}
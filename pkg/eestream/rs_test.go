@@ -673,3 +673,27 @@ func TestCalcPieceSize(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcPieceRange(t *testing.T) {
+	fc, err := infectious.NewFEC(2, 4)
+	require.NoError(t, err)
+	es := NewRSScheme(fc, 1*memory.KiB.Int())
+
+	// a stripe is 2 KiB, every stripe has one 1 KiB erasure share per piece
+	for i, tt := range []struct {
+		offset, length           int64
+		pieceOffset, pieceLength int64
+	}{
+		{0, 0, 0, 0},
+		{0, 1, 0, 1024},
+		{0, 2048, 0, 1024},
+		{1, 2048, 0, 2048},
+		{2048, 4096, 1024, 2048},
+		{10000, 10, 4096, 1024},
+		{4095, 2, 1024, 2048},
+	} {
+		pieceOffset, pieceLength := CalcPieceRange(tt.offset, tt.length, es)
+		assert.Equal(t, tt.pieceOffset, pieceOffset, "%d. %+v", i, tt)
+		assert.Equal(t, tt.pieceLength, pieceLength, "%d. %+v", i, tt)
+	}
+}
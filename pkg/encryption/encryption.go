@@ -27,6 +27,16 @@ func ToAESGCMNonce(nonce *storj.Nonce) *AESGCMNonce {
 	return aes
 }
 
+// NonceSize returns the number of nonce bytes used by the cipher
+func NonceSize(cipher storj.CipherSuite) int {
+	switch cipher {
+	case storj.EncAESGCM:
+		return AESGCMNonceSize
+	default:
+		return storj.NonceSize
+	}
+}
+
 // Increment increments the nonce with the given amount
 func Increment(nonce *storj.Nonce, amount int64) (truncated bool, err error) {
 	return incrementBytes(nonce[:], amount)
@@ -46,6 +56,8 @@ func Encrypt(data []byte, cipher storj.CipherSuite, key *storj.Key, nonce *storj
 		return EncryptAESGCM(data, key, ToAESGCMNonce(nonce))
 	case storj.EncSecretBox:
 		return EncryptSecretBox(data, key, nonce)
+	case storj.EncXChaCha20Poly1305:
+		return EncryptXChaCha20Poly1305(data, key, nonce)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -65,6 +77,8 @@ func Decrypt(cipherData []byte, cipher storj.CipherSuite, key *storj.Key, nonce
 		return DecryptAESGCM(cipherData, key, ToAESGCMNonce(nonce))
 	case storj.EncSecretBox:
 		return DecryptSecretBox(cipherData, key, nonce)
+	case storj.EncXChaCha20Poly1305:
+		return DecryptXChaCha20Poly1305(cipherData, key, nonce)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -79,6 +93,8 @@ func NewEncrypter(cipher storj.CipherSuite, key *storj.Key, startingNonce *storj
 		return NewAESGCMEncrypter(key, ToAESGCMNonce(startingNonce), encryptedBlockSize)
 	case storj.EncSecretBox:
 		return NewSecretboxEncrypter(key, startingNonce, encryptedBlockSize)
+	case storj.EncXChaCha20Poly1305:
+		return NewXChaCha20Poly1305Encrypter(key, startingNonce, encryptedBlockSize)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -93,6 +109,8 @@ func NewDecrypter(cipher storj.CipherSuite, key *storj.Key, startingNonce *storj
 		return NewAESGCMDecrypter(key, ToAESGCMNonce(startingNonce), encryptedBlockSize)
 	case storj.EncSecretBox:
 		return NewSecretboxDecrypter(key, startingNonce, encryptedBlockSize)
+	case storj.EncXChaCha20Poly1305:
+		return NewXChaCha20Poly1305Decrypter(key, startingNonce, encryptedBlockSize)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
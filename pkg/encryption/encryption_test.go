@@ -4,6 +4,7 @@
 package encryption_test
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -63,7 +64,72 @@ func forAllCiphers(test func(cipher storj.CipherSuite)) {
 		storj.EncNull,
 		storj.EncAESGCM,
 		storj.EncSecretBox,
+		storj.EncXChaCha20Poly1305,
 	} {
 		test(cipher)
 	}
 }
+
+func TestCipherSuiteCompatibility(t *testing.T) {
+	key := testrand.Key()
+	nonce := testrand.Nonce()
+	data := testrand.BytesInt(1 * memory.KiB.Int())
+
+	forAllCiphers(func(encCipher storj.CipherSuite) {
+		cipherData, err := encryption.Encrypt(data, encCipher, &key, &nonce)
+		require.NoError(t, err)
+
+		forAllCiphers(func(decCipher storj.CipherSuite) {
+			errTag := fmt.Sprintf("encrypted with %d, decrypted with %d", encCipher, decCipher)
+
+			if encCipher == storj.EncNull || decCipher == storj.EncNull {
+				return
+			}
+
+			plainData, err := encryption.Decrypt(cipherData, decCipher, &key, &nonce)
+			if encCipher == decCipher {
+				require.NoError(t, err, errTag)
+				assert.Equal(t, data, plainData, errTag)
+			} else {
+				assert.True(t, encryption.ErrDecryptFailed.Has(err), errTag)
+			}
+		})
+	})
+}
+
+func TestCipherSuiteTransformerCompatibility(t *testing.T) {
+	key := testrand.Key()
+	nonce := testrand.Nonce()
+	blockSize := 1 * memory.KiB.Int()
+
+	forAllCiphers(func(cipher storj.CipherSuite) {
+		errTag := fmt.Sprintf("cipher %d", cipher)
+
+		encrypter, err := encryption.NewEncrypter(cipher, &key, &nonce, blockSize)
+		require.NoError(t, err, errTag)
+
+		data := testrand.BytesInt(encrypter.InBlockSize() * 3)
+
+		// blocks encrypted as a stream can be decrypted one by one with Decrypt,
+		// when the nonce is incremented by the block number
+		cipherData, err := ioutil.ReadAll(encryption.TransformReader(ioutil.NopCloser(bytes.NewReader(data)), encrypter, 0))
+		require.NoError(t, err, errTag)
+
+		for block := 0; block < 3; block++ {
+			if cipher == storj.EncNull {
+				break
+			}
+
+			blockNonce := nonce
+			_, err := encryption.Increment(&blockNonce, int64(block))
+			require.NoError(t, err, errTag)
+
+			out := encrypter.OutBlockSize()
+			plainData, err := encryption.Decrypt(cipherData[block*out:(block+1)*out], cipher, &key, &blockNonce)
+			require.NoError(t, err, errTag)
+
+			in := encrypter.InBlockSize()
+			assert.Equal(t, data[block*in:(block+1)*in], plainData, errTag)
+		}
+	})
+}
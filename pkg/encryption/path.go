@@ -264,10 +264,7 @@ func encryptPathComponent(comp string, cipher storj.CipherSuite, key *storj.Key)
 		return "", Error.Wrap(err)
 	}
 
-	nonceSize := storj.NonceSize
-	if cipher == storj.EncAESGCM {
-		nonceSize = AESGCMNonceSize
-	}
+	nonceSize := NonceSize(cipher)
 
 	// keep the nonce together with the cipher text
 	return string(encodeSegment(append(nonce[:nonceSize], cipherText...))), nil
@@ -284,10 +281,7 @@ func decryptPathComponent(comp string, cipher storj.CipherSuite, key *storj.Key)
 		return "", Error.Wrap(err)
 	}
 
-	nonceSize := storj.NonceSize
-	if cipher == storj.EncAESGCM {
-		nonceSize = AESGCMNonceSize
-	}
+	nonceSize := NonceSize(cipher)
 	if len(data) < nonceSize || nonceSize < 0 {
 		return "", errs.New("component did not contain enough nonce bytes")
 	}
@@ -62,6 +62,7 @@ func forAllCiphers(test func(cipher storj.CipherSuite)) {
 		storj.EncNull,
 		storj.EncAESGCM,
 		storj.EncSecretBox,
+		storj.EncXChaCha20Poly1305,
 	} {
 		test(cipher)
 	}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// FileDB is an in-memory lookup loaded from CSV records of the form
+// 'network,country_code,asn', where network is in CIDR notation. Empty lines
+// and lines starting with '#' are ignored. The most specific network wins.
+type FileDB struct {
+	records []record
+}
+
+type record struct {
+	network  *net.IPNet
+	location Location
+}
+
+// OpenFileDB loads the records of the file at path.
+func OpenFileDB(path string) (_ *FileDB, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	return NewFileDB(file)
+}
+
+// NewFileDB loads the records from r.
+func NewFileDB(r io.Reader) (*FileDB, error) {
+	db := &FileDB{}
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) != 3 {
+			return nil, Error.New("line %d: expected 3 fields, got %d", lineNumber, len(fields))
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, Error.New("line %d: %v", lineNumber, err)
+		}
+
+		var asn uint64
+		if field := strings.TrimSpace(fields[2]); field != "" {
+			asn, err = strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(field), "AS"), 10, 32)
+			if err != nil {
+				return nil, Error.New("line %d: invalid ASN %q", lineNumber, field)
+			}
+		}
+
+		db.records = append(db.records, record{
+			network: network,
+			location: Location{
+				CountryCode: strings.ToUpper(strings.TrimSpace(fields[1])),
+				ASN:         uint32(asn),
+			},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the most specific networks are matched first
+	sort.SliceStable(db.records, func(i, k int) bool {
+		onesI, _ := db.records[i].network.Mask.Size()
+		onesK, _ := db.records[k].network.Mask.Size()
+		return onesI > onesK
+	})
+
+	return db, nil
+}
+
+// Lookup returns the location of the most specific network containing ip.
+func (db *FileDB) Lookup(ctx context.Context, ip net.IP) (_ Location, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, record := range db.records {
+		if record.network.Contains(ip) {
+			return record.location, nil
+		}
+	}
+	return Location{}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/geoip"
+)
+
+func TestFileDB(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := geoip.NewFileDB(strings.NewReader(`
+		# network, country code, asn
+		10.0.0.0/8, de, AS3320
+		10.1.0.0/16, fr, 3215
+		2001:db8::/32, NL,
+	`))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		ip       string
+		location geoip.Location
+	}{
+		{"10.0.0.1", geoip.Location{CountryCode: "DE", ASN: 3320}},
+		{"10.1.2.3", geoip.Location{CountryCode: "FR", ASN: 3215}},
+		{"2001:db8::1", geoip.Location{CountryCode: "NL"}},
+		{"192.168.1.1", geoip.Location{}},
+	} {
+		location, err := db.Lookup(ctx, net.ParseIP(test.ip))
+		require.NoError(t, err, test.ip)
+		assert.Equal(t, test.location, location, test.ip)
+	}
+
+	for _, invalid := range []string{
+		"10.0.0.0/8,DE",
+		"10.0.0.0,DE,1",
+		"10.0.0.0/8,DE,ASX",
+	} {
+		_, err := geoip.NewFileDB(strings.NewReader(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	lookup, err := geoip.Open(geoip.Config{})
+	require.NoError(t, err)
+	assert.Nil(t, lookup)
+
+	_, err = geoip.Open(geoip.Config{Database: ctx.File("missing.csv")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package geoip resolves the geographic and network location of IP addresses.
+package geoip
+
+import (
+	"context"
+	"net"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is the default geoip errs class
+	Error = errs.Class("geoip error")
+)
+
+// Location is the geographic and network location of an IP address
+type Location struct {
+	// CountryCode is the ISO 3166-1 alpha-2 country code, empty if unknown
+	CountryCode string
+	// ASN is the autonomous system number, 0 if unknown
+	ASN uint32
+}
+
+// Lookup resolves the location of IP addresses
+type Lookup interface {
+	// Lookup returns the location of ip, the zero Location if it is unknown
+	Lookup(ctx context.Context, ip net.IP) (Location, error)
+}
+
+// Config is the configuration for the geoip lookup
+type Config struct {
+	Database string `help:"path to a CSV file with 'network,country_code,asn' records used to look up node locations, empty disables the lookup" default:""`
+}
+
+// Open returns the lookup configured by config. It returns nil when no
+// database is configured.
+func Open(config Config) (Lookup, error) {
+	if config.Database == "" {
+		return nil, nil
+	}
+	db, err := OpenFileDB(config.Database)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -84,7 +85,7 @@ func New(log *zap.Logger, config Config) (*Server, error) {
 	return &Server{
 		log:             log,
 		name:            config.Name,
-		listener:        listener,
+		listener:        &closeOnceListener{Listener: listener},
 		server:          server,
 		shutdownTimeout: config.ShutdownTimeout,
 	}, nil
@@ -122,6 +123,27 @@ func (server *Server) Addr() string {
 	return server.listener.Addr().String()
 }
 
+// Close closes the server and its listener immediately, without waiting for
+// requests to finish.
+func (server *Server) Close() error {
+	return errs.Combine(server.server.Close(), server.listener.Close())
+}
+
+// closeOnceListener allows closing the listener both by the http.Server and
+// by Close, when the server never ran.
+type closeOnceListener struct {
+	net.Listener
+	once sync.Once
+	err  error
+}
+
+func (listener *closeOnceListener) Close() error {
+	listener.once.Do(func() {
+		listener.err = listener.Listener.Close()
+	})
+	return listener.err
+}
+
 func shutdownWithTimeout(server *http.Server, timeout time.Duration) error {
 	if timeout < 0 {
 		return server.Close()
@@ -55,7 +55,7 @@ func (db *Project) CreateBucket(ctx context.Context, bucketName string, info *st
 		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
 	}
 
-	if info.PathCipher < storj.EncNull || info.PathCipher > storj.EncSecretBox {
+	if info.PathCipher < storj.EncNull || info.PathCipher > storj.EncXChaCha20Poly1305 {
 		return storj.Bucket{}, encryption.ErrInvalidConfig.New("encryption type %d is not supported", info.PathCipher)
 	}
 
@@ -129,3 +129,19 @@ func (db *Project) ListBuckets(ctx context.Context, listOpts storj.BucketListOpt
 
 	return bucketList, nil
 }
+
+// SetBucketVersioning changes the versioning state of a bucket
+func (db *Project) SetBucketVersioning(ctx context.Context, bucketName string, versioning storj.BucketVersioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucketName == "" {
+		return storj.ErrNoBucket.New("")
+	}
+
+	err = db.buckets.SetVersioning(ctx, bucketName, versioning)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+
+	return nil
+}
@@ -278,7 +278,7 @@ func newMetainfoParts(t *testing.T, planet *testplanet.Planet) (*kvmetainfo.DB,
 	const stripesPerBlock = 2
 	blockSize := stripesPerBlock * rs.StripeSize()
 	inlineThreshold := 8 * memory.KiB.Int()
-	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold)
+	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold, storj.CompressionParameters{})
 	if err != nil {
 		return nil, nil, err
 	}
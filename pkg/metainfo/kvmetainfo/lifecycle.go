@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/storj"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket. The prefixes
+// of the rules are encrypted before they are sent to the satellite, so they
+// only match whole path components.
+func (db *DB) SetBucketLifecycle(ctx context.Context, bucket string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	encRules := make([]storj.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		prefix := strings.TrimSuffix(rule.Prefix, "/")
+		if prefix != "" {
+			encPrefix, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(prefix), bucketInfo.PathCipher, db.encStore)
+			if err != nil {
+				return err
+			}
+			rule.Prefix = encPrefix.Raw()
+		}
+		encRules = append(encRules, rule)
+	}
+
+	return db.metainfo.SetBucketLifecycle(ctx, bucket, encRules)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket with decrypted prefixes
+func (db *DB) GetBucketLifecycle(ctx context.Context, bucket string) (_ []storj.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]storj.LifecycleRule, 0, len(bucketInfo.Lifecycle))
+	for _, rule := range bucketInfo.Lifecycle {
+		if rule.Prefix != "" {
+			prefix, err := encryption.DecryptPath(bucket, paths.NewEncrypted(rule.Prefix), bucketInfo.PathCipher, db.encStore)
+			if err != nil {
+				return nil, err
+			}
+			rule.Prefix = prefix.Raw()
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -77,3 +77,8 @@ func (db *DB) GetBucket(ctx context.Context, bucketName string) (bucketInfo stor
 func (db *DB) ListBuckets(ctx context.Context, options storj.BucketListOptions) (list storj.BucketList, err error) {
 	return db.project.ListBuckets(ctx, options)
 }
+
+// SetBucketVersioning changes the versioning state of a bucket
+func (db *DB) SetBucketVersioning(ctx context.Context, bucketName string, versioning storj.BucketVersioning) (err error) {
+	return db.project.SetBucketVersioning(ctx, bucketName, versioning)
+}
@@ -5,6 +5,7 @@ package kvmetainfo
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
+	"storj.io/storj/uplink/metainfo"
 )
 
 // DefaultRS default values for RedundancyScheme
@@ -128,7 +130,8 @@ func (db *DB) ModifyObject(ctx context.Context, bucket string, path storj.Path)
 	return nil, errors.New("not implemented")
 }
 
-// DeleteObject deletes an object from database
+// DeleteObject deletes an object from database. In a bucket with versioning
+// the object is kept as an older version and a delete marker is added.
 func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -139,6 +142,30 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 		}
 		return err
 	}
+
+	if bucketInfo.Versioning == storj.VersioningUnversioned {
+		return db.deleteObject(ctx, bucketInfo, path)
+	}
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.metainfo.PutDeleteMarker(ctx, []byte(bucket), []byte(encPath.Raw()))
+	return err
+}
+
+// deleteObject deletes the latest version of an object. In a bucket with versioning
+// the satellite keeps it as an older version.
+func (db *DB) deleteObject(ctx context.Context, bucketInfo storj.Bucket, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := bucketInfo.Name
 	prefixed := prefixedObjStore{
 		store:  objects.NewStore(db.streams, bucketInfo.PathCipher),
 		prefix: bucket,
@@ -146,6 +173,168 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 	return prefixed.Delete(ctx, path)
 }
 
+// CopyObject copies an object to a new path without transferring its data
+func (db *DB) CopyObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.copyObject(ctx, bucket, path, newBucket, newPath, false)
+}
+
+// MoveObject moves an object to a new path without transferring its data
+func (db *DB) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.copyObject(ctx, bucket, path, newBucket, newPath, true)
+}
+
+// copyObject re-encrypts the content key envelope of every segment for the new path
+// and asks the satellite to duplicate or move the segments.
+func (db *DB) copyObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, move bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if newPath == "" {
+		return storj.ErrNoPath.New("")
+	}
+	if bucket == newBucket && path == newPath {
+		return errClass.New("source and destination are the same")
+	}
+
+	obj, _, err := db.getInfo(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	newBucketInfo, err := db.GetBucket(ctx, newBucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	newEncPath, err := encryption.EncryptPath(newBucket, paths.NewUnencrypted(newPath), newBucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(bucket, paths.NewUnencrypted(path), db.encStore)
+	if err != nil {
+		return err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(newBucket, paths.NewUnencrypted(newPath), db.encStore)
+	if err != nil {
+		return err
+	}
+
+	segmentsMeta, err := db.reencryptSegments(ctx, bucket, obj, derivedKey, newDerivedKey)
+	if err != nil {
+		return err
+	}
+
+	// like an upload, copying replaces an existing object at the destination
+	err = db.deleteObject(ctx, newBucketInfo, newPath)
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return err
+	}
+
+	if move {
+		err = db.metainfo.MoveObject(ctx, []byte(bucket), []byte(obj.encPath.Raw()), []byte(newBucket), []byte(newEncPath.Raw()), segmentsMeta)
+	} else {
+		err = db.metainfo.CopyObject(ctx, []byte(bucket), []byte(obj.encPath.Raw()), []byte(newBucket), []byte(newEncPath.Raw()), segmentsMeta)
+	}
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// reencryptSegments returns the metadata of all segments of the object with the content
+// keys decrypted with derivedKey and encrypted with newDerivedKey.
+func (db *DB) reencryptSegments(ctx context.Context, bucket string, obj object, derivedKey, newDerivedKey *storj.Key) (_ []*pb.SegmentMetadata, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cipher := storj.CipherSuite(obj.streamMeta.EncryptionType)
+
+	var segmentsMeta []*pb.SegmentMetadata
+	for i := int64(0); i < obj.streamInfo.NumberOfSegments-1; i++ {
+		pointer, err := db.metainfo.SegmentInfo(ctx, bucket, obj.encPath.Raw(), i)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := pointer.GetMetadata()
+		if cipher != storj.EncNull {
+			segmentMeta := &pb.SegmentMeta{}
+			err = proto.Unmarshal(metadata, segmentMeta)
+			if err != nil {
+				return nil, err
+			}
+
+			err = reencryptSegmentKey(segmentMeta, cipher, derivedKey, newDerivedKey)
+			if err != nil {
+				return nil, err
+			}
+
+			metadata, err = proto.Marshal(segmentMeta)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		segmentsMeta = append(segmentsMeta, &pb.SegmentMetadata{
+			SegmentIndex: i,
+			Metadata:     metadata,
+		})
+	}
+
+	streamMeta := obj.streamMeta
+	if streamMeta.LastSegmentMeta != nil {
+		lastSegmentMeta := *streamMeta.LastSegmentMeta
+		err = reencryptSegmentKey(&lastSegmentMeta, cipher, derivedKey, newDerivedKey)
+		if err != nil {
+			return nil, err
+		}
+		streamMeta.LastSegmentMeta = &lastSegmentMeta
+	}
+
+	lastMetadata, err := proto.Marshal(&streamMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentsMeta = append(segmentsMeta, &pb.SegmentMetadata{
+		SegmentIndex: -1,
+		Metadata:     lastMetadata,
+	})
+
+	return segmentsMeta, nil
+}
+
+// reencryptSegmentKey decrypts the content key of the segment with the derived key
+// of the old path and encrypts it with the derived key of the new path.
+func reencryptSegmentKey(segmentMeta *pb.SegmentMeta, cipher storj.CipherSuite, derivedKey, newDerivedKey *storj.Key) error {
+	var keyNonce storj.Nonce
+	copy(keyNonce[:], segmentMeta.KeyNonce)
+
+	contentKey, err := encryption.DecryptKey(segmentMeta.EncryptedKey, cipher, derivedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	var newKeyNonce storj.Nonce
+	_, err = rand.Read(newKeyNonce[:])
+	if err != nil {
+		return err
+	}
+
+	encryptedKey, err := encryption.EncryptKey(contentKey, cipher, newDerivedKey, &newKeyNonce)
+	if err != nil {
+		return err
+	}
+
+	segmentMeta.EncryptedKey = encryptedKey
+	segmentMeta.KeyNonce = newKeyNonce[:]
+	return nil
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -214,6 +403,141 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 	return list, nil
 }
 
+// GetObjectVersion returns information about a version of an object
+func (db *DB) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID == "" {
+		return storj.Object{}, errClass.New("no version ID")
+	}
+
+	_, info, err = db.getInfo(metainfo.WithVersion(ctx, versionID), bucket, path)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	info.VersionID = versionID
+	return info, nil
+}
+
+// DeleteObjectVersion permanently deletes a version of an object or a delete marker
+func (db *DB) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	err = db.metainfo.DeleteObjectVersion(ctx, []byte(bucket), []byte(encPath.Raw()), versionID)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// ListObjectVersions lists all versions of the objects in bucket, including the delete
+// markers. The listing is always recursive and only lists forward. The limit applies
+// to the number of objects, the list contains all of their versions newest first.
+func (db *DB) ListObjectVersions(ctx context.Context, bucket string, options storj.ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	switch {
+	case options.Direction == storj.After:
+	case options.Direction == storj.Forward && options.Cursor == "":
+	default:
+		return storj.ObjectList{}, errClass.New("unsupported direction %d for listing versions", options.Direction)
+	}
+
+	prefix := streams.ParsePath(storj.JoinPaths(bucket, options.Prefix))
+	cipher := bucketInfo.PathCipher
+
+	prefixKey, err := encryption.DerivePathKey(prefix.Bucket(), prefix.UnencryptedPath(), db.encStore)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	encPrefix, err := encryption.EncryptPath(prefix.Bucket(), prefix.UnencryptedPath(), cipher, db.encStore)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	var encCursor string
+	if options.Cursor != "" {
+		encCursor, err = encryption.EncryptPathRaw(options.Cursor, cipher, prefixKey)
+		if err != nil {
+			return storj.ObjectList{}, err
+		}
+	}
+
+	items, more, err := db.metainfo.ListObjectVersions(ctx, []byte(bucket), []byte(encPrefix.Raw()), []byte(encCursor), int32(options.Limit))
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	list = storj.ObjectList{
+		Bucket: bucket,
+		Prefix: options.Prefix,
+		More:   more,
+		Items:  make([]storj.Object, 0, len(items)),
+	}
+
+	for _, item := range items {
+		itemPath, err := encryption.DecryptPathRaw(string(item.EncryptedPath), cipher, prefixKey)
+		if err != nil {
+			return storj.ObjectList{}, err
+		}
+
+		fullPath := prefix.UnencryptedPath().Raw()
+		if len(fullPath) > 0 && fullPath[len(fullPath)-1] != '/' {
+			fullPath += "/"
+		}
+		fullPath += itemPath
+
+		var info storj.Object
+		if item.IsDeleteMarker {
+			info = storj.Object{
+				Bucket:   bucketInfo,
+				Path:     itemPath,
+				Created:  item.CreatedAt,
+				Modified: item.CreatedAt,
+			}
+		} else {
+			encPath := paths.NewEncrypted(string(item.EncryptedPath))
+			if encPrefix.Valid() {
+				encPath = paths.NewEncrypted(storj.JoinPaths(encPrefix.Raw(), string(item.EncryptedPath)))
+			}
+
+			_, info, err = db.objectFromPointer(ctx, bucketInfo, fullPath, encPath, item.Pointer)
+			if err != nil {
+				return storj.ObjectList{}, err
+			}
+			info.Path = itemPath
+		}
+
+		info.VersionID = item.VersionId
+		info.IsLatest = item.IsLatest
+		info.IsDeleteMarker = item.IsDeleteMarker
+		list.Items = append(list.Items, info)
+	}
+
+	return list, nil
+}
+
 type object struct {
 	fullpath        streams.Path
 	bucket          string
@@ -236,8 +560,6 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, storj.ErrNoPath.New("")
 	}
 
-	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(path))
-
 	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
 	if err != nil {
 		return object{}, storj.Object{}, err
@@ -251,6 +573,16 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, err
 	}
 
+	return db.objectFromPointer(ctx, bucketInfo, path, encPath, pointer)
+}
+
+// objectFromPointer decrypts the object information stored in the last segment of the object
+func (db *DB) objectFromPointer(ctx context.Context, bucketInfo storj.Bucket, path storj.Path, encPath paths.Encrypted, pointer *pb.Pointer) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := bucketInfo.Name
+	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(path))
+
 	var redundancyScheme *pb.RedundancyScheme
 	if pointer.GetType() == pb.Pointer_REMOTE {
 		redundancyScheme = pointer.GetRemote().GetRedundancy()
@@ -289,6 +621,11 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, err
 	}
 
+	info.VersionID = pointer.VersionId
+	if info.VersionID == "" && bucketInfo.Versioning != storj.VersioningUnversioned {
+		info.VersionID = storj.NullVersionID
+	}
+
 	return object{
 		fullpath:        fullpath,
 		bucket:          bucket,
@@ -366,6 +703,10 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 				CipherSuite: storj.CipherSuite(streamMeta.EncryptionType),
 				BlockSize:   streamMeta.EncryptionBlockSize,
 			},
+			Compression: storj.CompressionParameters{
+				Algorithm: storj.CompressionAlgorithm(streamMeta.CompressionType),
+				BlockSize: streamMeta.CompressionBlockSize,
+			},
 			LastSegment: storj.LastSegment{
 				Size:              stream.LastSegmentSize,
 				EncryptedKeyNonce: nonce,
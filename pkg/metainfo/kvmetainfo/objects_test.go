@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -266,6 +267,76 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestCopyMoveObject(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		destBucket, err := db.CreateBucket(ctx, "dest-bucket", &storj.Bucket{PathCipher: storj.EncNull})
+		require.NoError(t, err)
+
+		for _, tt := range []struct {
+			name string
+			data []byte
+		}{
+			{name: "inline", data: []byte("test")},
+			{name: "remote", data: testrand.Bytes(32 * memory.KiB)},
+		} {
+			src := tt.name + "/file"
+			upload(ctx, t, db, streams, bucket, src, tt.data)
+
+			err = db.CopyObject(ctx, bucket.Name, src, bucket.Name, "")
+			assert.True(t, storj.ErrNoPath.Has(err), tt.name)
+
+			err = db.CopyObject(ctx, bucket.Name, src, bucket.Name, src)
+			assert.Error(t, err, tt.name)
+
+			err = db.CopyObject(ctx, bucket.Name, "non-existing-file", bucket.Name, "copy")
+			assert.True(t, storj.ErrObjectNotFound.Has(err), tt.name)
+
+			err = db.CopyObject(ctx, bucket.Name, src, "non-existing-bucket", "copy")
+			assert.True(t, storj.ErrBucketNotFound.Has(err), tt.name)
+
+			// copy within the same bucket and to a bucket with a different path cipher
+			err = db.CopyObject(ctx, bucket.Name, src, bucket.Name, tt.name+"/copy")
+			require.NoError(t, err, tt.name)
+			err = db.CopyObject(ctx, bucket.Name, src, destBucket.Name, tt.name+"/copy")
+			require.NoError(t, err, tt.name)
+
+			// copying again replaces the existing destination
+			err = db.CopyObject(ctx, bucket.Name, src, bucket.Name, tt.name+"/copy")
+			require.NoError(t, err, tt.name)
+
+			// the copies must stay readable after the source is deleted
+			err = db.DeleteObject(ctx, bucket.Name, src)
+			require.NoError(t, err, tt.name)
+
+			assert.Equal(t, tt.data, download(ctx, t, db, streams, bucket.Name, tt.name+"/copy"), tt.name)
+			assert.Equal(t, tt.data, download(ctx, t, db, streams, destBucket.Name, tt.name+"/copy"), tt.name)
+
+			err = db.MoveObject(ctx, destBucket.Name, tt.name+"/copy", bucket.Name, tt.name+"/moved")
+			require.NoError(t, err, tt.name)
+
+			_, err = db.GetObject(ctx, destBucket.Name, tt.name+"/copy")
+			assert.True(t, storj.ErrObjectNotFound.Has(err), tt.name)
+
+			assert.Equal(t, tt.data, download(ctx, t, db, streams, bucket.Name, tt.name+"/moved"), tt.name)
+		}
+	})
+}
+
+func download(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, bucket string, path storj.Path) []byte {
+	readOnly, err := db.GetObjectStream(ctx, bucket, path)
+	require.NoError(t, err)
+
+	download := stream.NewDownload(ctx, readOnly, streams)
+	defer func() { assert.NoError(t, download.Close()) }()
+
+	data, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	return data
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
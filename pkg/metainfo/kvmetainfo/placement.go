@@ -0,0 +1,21 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// SetBucketPlacement replaces the placement constraints of a bucket
+func (db *DB) SetBucketPlacement(ctx context.Context, bucket string, placement *storj.PlacementConstraints) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return storj.ErrNoBucket.New("")
+	}
+
+	return db.metainfo.SetBucketPlacement(ctx, bucket, placement)
+}
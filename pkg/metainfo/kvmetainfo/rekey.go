@@ -0,0 +1,256 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/uplink/metainfo"
+)
+
+// RekeyObject re-encrypts the path and the content keys of all versions of an
+// object with the keys from newStore. The content itself isn't re-encrypted, so
+// only the metadata on the satellite changes and no data is transferred.
+// Versions, which were already re-encrypted by an interrupted call, are skipped.
+func (db *DB) RekeyObject(ctx context.Context, bucket string, path storj.Path, newStore *encryption.Store) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	prefix, name := "", path
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		prefix, name = path[:i], path[i+1:]
+	}
+
+	rekeyed, err := db.rekeyVersions(ctx, bucket, prefix, name, newStore)
+	if err != nil {
+		return err
+	}
+	if len(rekeyed) == 0 {
+		// the object is only missing, when it can't be read with the new keys either
+		if _, err := db.withStore(newStore).GetObject(ctx, bucket, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RekeyObjects re-encrypts all versions of all objects below prefix and the
+// prefixes of the lifecycle rules within it with the keys from newStore, see
+// RekeyObject. It returns the paths of the objects, which were re-encrypted,
+// also when it fails in between. Running it again after a failure continues
+// where it stopped.
+func (db *DB) RekeyObjects(ctx context.Context, bucket string, prefix storj.Path, newStore *encryption.Store) (rekeyed []storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	rekeyed, err = db.rekeyVersions(ctx, bucket, prefix, "", newStore)
+	if err != nil {
+		return rekeyed, err
+	}
+
+	return rekeyed, db.rekeyLifecycle(ctx, bucket, prefix, newStore)
+}
+
+// rekeyVersions re-encrypts the versions of the objects below prefix. When name
+// is set, only the versions of the object with this name are re-encrypted.
+func (db *DB) rekeyVersions(ctx context.Context, bucket string, prefix storj.Path, name string, newStore *encryption.Store) (rekeyed []storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	cipher := bucketInfo.PathCipher
+
+	fullPrefix := streams.ParsePath(storj.JoinPaths(bucket, prefix))
+
+	prefixKey, err := encryption.DerivePathKey(bucket, fullPrefix.UnencryptedPath(), db.encStore)
+	if err != nil {
+		return nil, err
+	}
+	newPrefixKey, err := encryption.DerivePathKey(bucket, fullPrefix.UnencryptedPath(), newStore)
+	if err != nil {
+		return nil, err
+	}
+
+	encPrefix, err := encryption.EncryptPath(bucket, fullPrefix.UnencryptedPath(), cipher, db.encStore)
+	if err != nil {
+		return nil, err
+	}
+
+	var encName string
+	if name != "" {
+		encName, err = encryption.EncryptPathRaw(name, cipher, prefixKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the re-encrypted versions may be listed again, when the path cipher
+	// or the prefix doesn't change the encrypted path, they are skipped
+	var cursor string
+	for {
+		items, more, err := db.metainfo.ListObjectVersions(ctx, []byte(bucket), []byte(encPrefix.Raw()), []byte(cursor), 0)
+		if err != nil {
+			return rekeyed, err
+		}
+
+		for _, item := range items {
+			encItemPath := string(item.EncryptedPath)
+			cursor = encItemPath
+
+			if encName != "" {
+				if encItemPath > encName {
+					return rekeyed, nil
+				}
+				if encItemPath != encName {
+					continue
+				}
+			}
+
+			itemPath, err := encryption.DecryptPathRaw(encItemPath, cipher, prefixKey)
+			if err != nil {
+				// only the paths, which are encrypted with the new keys already, are skipped
+				if _, newErr := encryption.DecryptPathRaw(encItemPath, cipher, newPrefixKey); newErr == nil {
+					continue
+				}
+				return rekeyed, err
+			}
+
+			path := itemPath
+			if prefix != "" {
+				path = storj.JoinPaths(prefix, itemPath)
+			}
+			encPath := paths.NewEncrypted(encItemPath)
+			if encPrefix.Valid() {
+				encPath = paths.NewEncrypted(storj.JoinPaths(encPrefix.Raw(), encItemPath))
+			}
+
+			ok, err := db.rekeyVersion(ctx, bucketInfo, path, encPath, item, newStore)
+			if err != nil {
+				return rekeyed, err
+			}
+			if ok && (len(rekeyed) == 0 || rekeyed[len(rekeyed)-1] != path) {
+				rekeyed = append(rekeyed, path)
+			}
+		}
+
+		if !more || len(items) == 0 {
+			return rekeyed, nil
+		}
+	}
+}
+
+// rekeyVersion re-encrypts a version of an object. It returns false, when the
+// version is already encrypted with the keys from newStore.
+func (db *DB) rekeyVersion(ctx context.Context, bucketInfo storj.Bucket, path storj.Path, encPath paths.Encrypted, item *pb.ObjectVersion, newStore *encryption.Store) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := bucketInfo.Name
+	unencPath := paths.NewUnencrypted(path)
+	ctx = metainfo.WithVersion(ctx, item.VersionId)
+
+	newEncPath, err := encryption.EncryptPath(bucket, unencPath, bucketInfo.PathCipher, newStore)
+	if err != nil {
+		return false, err
+	}
+
+	if item.IsDeleteMarker {
+		if newEncPath.Raw() == encPath.Raw() {
+			return false, nil
+		}
+		err = db.metainfo.RekeyObject(ctx, []byte(bucket), []byte(encPath.Raw()), []byte(newEncPath.Raw()), nil)
+		return err == nil, err
+	}
+
+	obj, _, err := db.objectFromPointer(ctx, bucketInfo, path, encPath, item.Pointer)
+	if err != nil {
+		if _, _, newErr := db.withStore(newStore).objectFromPointer(ctx, bucketInfo, path, encPath, item.Pointer); newErr == nil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(bucket, unencPath, db.encStore)
+	if err != nil {
+		return false, err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(bucket, unencPath, newStore)
+	if err != nil {
+		return false, err
+	}
+
+	segmentsMeta, err := db.reencryptSegments(ctx, bucket, obj, derivedKey, newDerivedKey)
+	if err != nil {
+		return false, err
+	}
+
+	err = db.metainfo.RekeyObject(ctx, []byte(bucket), []byte(encPath.Raw()), []byte(newEncPath.Raw()), segmentsMeta)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err == nil, err
+}
+
+// rekeyLifecycle re-encrypts the prefixes of the lifecycle rules below prefix
+// with the keys from newStore. Prefixes, which are encrypted with the new keys
+// already, are kept.
+func (db *DB) rekeyLifecycle(ctx context.Context, bucket string, prefix storj.Path, newStore *encryption.Store) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	cipher := bucketInfo.PathCipher
+
+	changed := false
+	rules := make([]storj.LifecycleRule, 0, len(bucketInfo.Lifecycle))
+	for _, rule := range bucketInfo.Lifecycle {
+		if rule.Prefix != "" {
+			rulePrefix, err := encryption.DecryptPath(bucket, paths.NewEncrypted(rule.Prefix), cipher, db.encStore)
+			if err != nil {
+				if _, newErr := encryption.DecryptPath(bucket, paths.NewEncrypted(rule.Prefix), cipher, newStore); newErr == nil {
+					rules = append(rules, rule)
+					continue
+				}
+				return err
+			}
+
+			raw := rulePrefix.Raw()
+			if prefix == "" || raw == prefix || strings.HasPrefix(raw, prefix+"/") {
+				newPrefix, err := encryption.EncryptPath(bucket, rulePrefix, cipher, newStore)
+				if err != nil {
+					return err
+				}
+				changed = changed || newPrefix.Raw() != rule.Prefix
+				rule.Prefix = newPrefix.Raw()
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	if !changed {
+		return nil
+	}
+	return db.metainfo.SetBucketLifecycle(ctx, bucket, rules)
+}
+
+// withStore returns a copy of db, which uses the keys from encStore
+func (db *DB) withStore(encStore *encryption.Store) *DB {
+	clone := *db
+	clone.encStore = encStore
+	return &clone
+}
@@ -182,15 +182,7 @@ func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucketName, object
 	}
 	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	return minio.ObjectInfo{
-		Name:        object.Meta.Path,
-		Bucket:      object.Meta.Bucket,
-		ModTime:     object.Meta.Modified,
-		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
-		ContentType: object.Meta.ContentType,
-		UserDefined: object.Meta.Metadata,
-	}, err
+	return objectInfoFromMeta(object.Meta), err
 }
 
 func (layer *gatewayLayer) ListBuckets(ctx context.Context) (bucketItems []minio.BucketInfo, err error) {
@@ -402,27 +394,30 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	}
 	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	object, err := bucket.OpenObject(ctx, srcObject)
-	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
+	if srcObject == "" {
+		return minio.ObjectInfo{}, minio.ObjectNameInvalid{Bucket: srcBucket}
 	}
-	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	reader, err := object.DownloadRange(ctx, 0, -1)
+	_, _, err = layer.gateway.project.GetBucketInfo(ctx, destBucket)
 	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
+		return minio.ObjectInfo{}, convertError(err, destBucket, "")
 	}
-	defer func() { err = errs.Combine(err, reader.Close()) }()
 
-	opts := uplink.UploadOptions{
-		ContentType: object.Meta.ContentType,
-		Metadata:    object.Meta.Metadata,
-		Expires:     object.Meta.Expires,
+	if destObject == "" {
+		return minio.ObjectInfo{}, minio.ObjectNameInvalid{Bucket: destBucket}
+	}
+
+	// copying an object onto itself doesn't change anything as the metadata
+	// is stored encrypted together with the object
+	if srcBucket != destBucket || srcObject != destObject {
+		// the pointers are duplicated by the satellite, no data is transferred
+		err = bucket.CopyObject(ctx, srcObject, destBucket, destObject)
+		if err != nil {
+			return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
+		}
 	}
-	opts.Volatile.EncryptionParameters = object.Meta.Volatile.EncryptionParameters
-	opts.Volatile.RedundancyScheme = object.Meta.Volatile.RedundancyScheme
 
-	return layer.putObject(ctx, destBucket, destObject, reader, &opts)
+	return layer.GetObjectInfo(ctx, destBucket, destObject)
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath string, reader io.Reader, opts *uplink.UploadOptions) (objInfo minio.ObjectInfo, err error) {
@@ -445,15 +440,7 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath
 	}
 	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	return minio.ObjectInfo{
-		Name:        object.Meta.Path,
-		Bucket:      object.Meta.Bucket,
-		ModTime:     object.Meta.Modified,
-		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
-		ContentType: object.Meta.ContentType,
-		UserDefined: object.Meta.Metadata,
-	}, nil
+	return objectInfoFromMeta(object.Meta), nil
 }
 
 func upload(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
@@ -474,6 +461,7 @@ func (layer *gatewayLayer) PutObject(ctx context.Context, bucketName, objectPath
 
 	contentType := metadata["content-type"]
 	delete(metadata, "content-type")
+	removeVersionID(metadata)
 
 	opts := uplink.UploadOptions{
 		ContentType: contentType,
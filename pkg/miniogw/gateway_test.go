@@ -165,6 +165,8 @@ func TestPutObject(t *testing.T) {
 		"content-type": "media/foo",
 		"key1":         "value1",
 		"key2":         "value2",
+		// the version is assigned by the satellite, a client provided one isn't stored
+		"X-Amz-Version-Id": "version",
 	}
 
 	serMetaInfo := pb.SerializableMeta{
@@ -434,6 +436,146 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestBucketVersioning(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		gateway := layer.(*gatewayLayer)
+
+		// Check the error when enabling versioning of a non-existing bucket
+		err := gateway.SetBucketVersioning(ctx, TestBucket, versioningEnabled)
+		assert.Equal(t, minio.BucketNotFound{Bucket: TestBucket}, err)
+
+		// Create the bucket using the Metainfo API
+		_, err = m.CreateBucket(ctx, TestBucket, nil)
+		assert.NoError(t, err)
+
+		// Versioning was never enabled
+		status, err := gateway.GetBucketVersioning(ctx, TestBucket)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "", status)
+		}
+
+		// Only the S3 versioning states are accepted
+		err = gateway.SetBucketVersioning(ctx, TestBucket, "Disabled")
+		assert.Equal(t, minio.NotImplemented{}, err)
+
+		err = gateway.SetBucketVersioning(ctx, TestBucket, versioningEnabled)
+		assert.NoError(t, err)
+
+		status, err = gateway.GetBucketVersioning(ctx, TestBucket)
+		if assert.NoError(t, err) {
+			assert.Equal(t, versioningEnabled, status)
+		}
+
+		// Put two versions of the object with the Minio API
+		var versionIDs []string
+		for _, content := range []string{"first", "second"} {
+			data, err := hash.NewReader(bytes.NewReader([]byte(content)), int64(len(content)), "", "")
+			if !assert.NoError(t, err) {
+				return
+			}
+			info, err := layer.PutObject(ctx, TestBucket, TestFile, data, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			versionIDs = append(versionIDs, info.UserDefined[amzVersionIDHeader])
+		}
+		assert.NotEqual(t, versionIDs[0], versionIDs[1])
+
+		list, err := gateway.ListObjectVersions(ctx, TestBucket, "", "", 10)
+		if assert.NoError(t, err) && assert.Len(t, list.Versions, 2) {
+			assert.False(t, list.IsTruncated)
+			for _, version := range list.Versions {
+				assert.Equal(t, TestFile, version.Name)
+				assert.False(t, version.IsDeleteMarker)
+				assert.Equal(t, version.VersionID == versionIDs[1], version.IsLatest)
+			}
+		}
+
+		// Get the previous version with the Minio API
+		var buf bytes.Buffer
+		err = gateway.GetObjectVersion(ctx, TestBucket, TestFile, versionIDs[0], 0, -1, &buf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "first", buf.String())
+		}
+
+		// Permanently delete the previous version
+		err = gateway.DeleteObjectVersion(ctx, TestBucket, TestFile, versionIDs[0])
+		assert.NoError(t, err)
+
+		list, err = gateway.ListObjectVersions(ctx, TestBucket, "", "", 10)
+		if assert.NoError(t, err) && assert.Len(t, list.Versions, 1) {
+			assert.Equal(t, versionIDs[1], list.Versions[0].VersionID)
+		}
+
+		// The latest version is still the current object
+		buf.Reset()
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &buf, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "second", buf.String())
+		}
+	})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		gateway := layer.(*gatewayLayer)
+
+		// Check the error when getting the lifecycle of a non-existing bucket
+		_, err := gateway.GetBucketLifecycle(ctx, TestBucket)
+		assert.Equal(t, minio.BucketNotFound{Bucket: TestBucket}, err)
+
+		// Create the bucket using the Metainfo API
+		_, err = m.CreateBucket(ctx, TestBucket, nil)
+		assert.NoError(t, err)
+
+		// Check the error when the bucket has no lifecycle
+		_, err = gateway.GetBucketLifecycle(ctx, TestBucket)
+		assert.Equal(t, LifecycleNotFound{Bucket: TestBucket}, err)
+
+		// Expiration at a date isn't supported
+		err = gateway.PutBucketLifecycle(ctx, TestBucket, &LifecycleConfiguration{
+			Rules: []LifecycleRule{{
+				Status:     lifecycleEnabled,
+				Expiration: &LifecycleExpiration{Date: "2019-10-01T00:00:00Z"},
+			}},
+		})
+		assert.Equal(t, minio.NotImplemented{}, err)
+
+		// Put the lifecycle with the Minio API
+		config := &LifecycleConfiguration{
+			Rules: []LifecycleRule{
+				{
+					ID:         "logs",
+					Status:     lifecycleEnabled,
+					Filter:     &LifecycleFilter{Prefix: "logs/2019"},
+					Expiration: &LifecycleExpiration{Days: 30},
+				},
+				{
+					ID:                             "uploads",
+					Status:                         lifecycleDisabled,
+					Filter:                         &LifecycleFilter{Prefix: ""},
+					AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 7},
+				},
+			},
+		}
+		err = gateway.PutBucketLifecycle(ctx, TestBucket, config)
+		assert.NoError(t, err)
+
+		// Check that the prefixes are encrypted using the Metainfo API
+		bucket, err := m.GetBucket(ctx, TestBucket)
+		if assert.NoError(t, err) && assert.Len(t, bucket.Lifecycle, 2) {
+			assert.NotEqual(t, "logs/2019", bucket.Lifecycle[0].Prefix)
+			assert.Equal(t, "", bucket.Lifecycle[1].Prefix)
+		}
+
+		// Check the lifecycle using the Minio API
+		lifecycle, err := gateway.GetBucketLifecycle(ctx, TestBucket)
+		if assert.NoError(t, err) {
+			assert.Equal(t, config, lifecycle)
+		}
+	})
+}
+
 func TestListObjects(t *testing.T) {
 	testListObjects(t, func(ctx context.Context, layer minio.ObjectLayer, bucket, prefix, marker, delimiter string, maxKeys int) ([]string, []minio.ObjectInfo, bool, error) {
 		list, err := layer.ListObjects(ctx, TestBucket, prefix, marker, delimiter, maxKeys)
@@ -709,7 +851,7 @@ func initEnv(ctx context.Context, planet *testplanet.Planet) (minio.ObjectLayer,
 
 	blockSize := rs.StripeSize()
 	inlineThreshold := 4 * memory.KiB.Int()
-	strms, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold)
+	strms, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold, storj.CompressionParameters{})
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/xml"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/lib/uplink"
+)
+
+// PutBucketLifecycle and GetBucketLifecycle use the S3 XML configuration. Like
+// the versioning operations, they aren't routed by the pinned minio yet.
+
+// S3 status of a lifecycle rule
+const (
+	lifecycleEnabled  = "Enabled"
+	lifecycleDisabled = "Disabled"
+)
+
+// LifecycleNotFound is returned when a bucket has no lifecycle configuration
+type LifecycleNotFound minio.GenericError
+
+func (e LifecycleNotFound) Error() string {
+	return "No lifecycle configuration found for bucket: " + e.Bucket
+}
+
+// LifecycleConfiguration is the S3 lifecycle configuration of a bucket
+type LifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule is a rule of the S3 lifecycle configuration of a bucket.
+// Only expiration after a number of days is supported.
+type LifecycleRule struct {
+	ID     string           `xml:"ID,omitempty"`
+	Status string           `xml:"Status"`
+	Filter *LifecycleFilter `xml:"Filter,omitempty"`
+	// Prefix is the filter of the rule in the legacy format
+	Prefix                         *string                         `xml:"Prefix,omitempty"`
+	Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+// LifecycleFilter selects the objects of a lifecycle rule
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// LifecycleExpiration expires objects after a number of days
+type LifecycleExpiration struct {
+	Days int    `xml:"Days,omitempty"`
+	Date string `xml:"Date,omitempty"`
+}
+
+// AbortIncompleteMultipartUpload aborts multipart uploads after a number of days
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+// PutBucketLifecycle replaces the lifecycle rules of a bucket with an S3 lifecycle configuration
+func (layer *gatewayLayer) PutBucketLifecycle(ctx context.Context, bucketName string, config *LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules := make([]uplink.LifecycleRule, 0, len(config.Rules))
+	for _, s3Rule := range config.Rules {
+		rule, err := convertLifecycleRule(s3Rule)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	err = bucket.SetLifecycle(ctx, rules)
+	return convertError(err, bucketName, "")
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket as an S3 lifecycle configuration
+func (layer *gatewayLayer) GetBucketLifecycle(ctx context.Context, bucketName string) (config *LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	rules, err := bucket.GetLifecycle(ctx)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+	if len(rules) == 0 {
+		return nil, LifecycleNotFound{Bucket: bucketName}
+	}
+
+	config = &LifecycleConfiguration{}
+	for _, rule := range rules {
+		s3Rule := LifecycleRule{
+			ID:     rule.ID,
+			Status: lifecycleEnabled,
+			Filter: &LifecycleFilter{Prefix: rule.Prefix},
+		}
+		if rule.Disabled {
+			s3Rule.Status = lifecycleDisabled
+		}
+		if rule.ExpireAfterDays > 0 {
+			s3Rule.Expiration = &LifecycleExpiration{Days: rule.ExpireAfterDays}
+		}
+		if rule.AbortIncompleteUploadAfterDays > 0 {
+			s3Rule.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: rule.AbortIncompleteUploadAfterDays,
+			}
+		}
+		config.Rules = append(config.Rules, s3Rule)
+	}
+	return config, nil
+}
+
+// convertLifecycleRule converts an S3 lifecycle rule. Expiration at a date isn't supported.
+func convertLifecycleRule(s3Rule LifecycleRule) (rule uplink.LifecycleRule, err error) {
+	rule.ID = s3Rule.ID
+
+	switch s3Rule.Status {
+	case lifecycleEnabled:
+	case lifecycleDisabled:
+		rule.Disabled = true
+	default:
+		return rule, minio.NotImplemented{}
+	}
+
+	switch {
+	case s3Rule.Filter != nil:
+		rule.Prefix = s3Rule.Filter.Prefix
+	case s3Rule.Prefix != nil:
+		rule.Prefix = *s3Rule.Prefix
+	}
+
+	if s3Rule.Expiration != nil {
+		if s3Rule.Expiration.Date != "" {
+			return rule, minio.NotImplemented{}
+		}
+		rule.ExpireAfterDays = s3Rule.Expiration.Days
+	}
+	if s3Rule.AbortIncompleteMultipartUpload != nil {
+		rule.AbortIncompleteUploadAfterDays = s3Rule.AbortIncompleteMultipartUpload.DaysAfterInitiation
+	}
+
+	return rule, nil
+}
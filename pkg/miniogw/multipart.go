@@ -37,6 +37,7 @@ func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucket, objec
 	go func() {
 		contentType := metadata["content-type"]
 		delete(metadata, "content-type")
+		removeVersionID(metadata)
 
 		opts := uplink.UploadOptions{
 			ContentType: contentType,
@@ -0,0 +1,221 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+// The S3 versioning and lifecycle APIs (the ?versioning, ?versions and ?lifecycle
+// subresources and the versionId query parameter) aren't routed by the pinned
+// minio, which rejects these requests as not implemented, and StartGateway doesn't
+// allow registering additional routes. The gateway layer implements the versioning
+// and lifecycle operations, so that they are served as soon as the minio dependency
+// is updated. Until then they are only reachable through lib/uplink, and the gateway
+// reports the version of an object with the x-amz-version-id header.
+
+// amzVersionIDHeader is the header with the version ID of an object
+const amzVersionIDHeader = "x-amz-version-id"
+
+// S3 versioning states of a bucket
+const (
+	versioningEnabled   = "Enabled"
+	versioningSuspended = "Suspended"
+)
+
+// ObjectVersionInfo is a version of an object or a delete marker
+type ObjectVersionInfo struct {
+	minio.ObjectInfo
+
+	VersionID      string
+	IsLatest       bool
+	IsDeleteMarker bool
+}
+
+// ListObjectVersionsInfo is the result of listing object versions
+type ListObjectVersionsInfo struct {
+	IsTruncated   bool
+	NextKeyMarker string
+	Versions      []ObjectVersionInfo
+}
+
+// objectInfoFromMeta converts the object metadata for minio. The version ID is
+// reported as a header, as minio passes the user defined metadata to the response.
+func objectInfoFromMeta(meta uplink.ObjectMeta) minio.ObjectInfo {
+	userDefined := meta.Metadata
+	if meta.VersionID != "" {
+		userDefined = make(map[string]string, len(meta.Metadata)+1)
+		for key, value := range meta.Metadata {
+			userDefined[key] = value
+		}
+		userDefined[amzVersionIDHeader] = meta.VersionID
+	}
+
+	return minio.ObjectInfo{
+		Name:        meta.Path,
+		Bucket:      meta.Bucket,
+		ModTime:     meta.Modified,
+		Size:        meta.Size,
+		ETag:        hex.EncodeToString(meta.Checksum),
+		ContentType: meta.ContentType,
+		UserDefined: userDefined,
+	}
+}
+
+// SetBucketVersioning sets the S3 versioning state of a bucket, either Enabled or Suspended
+func (layer *gatewayLayer) SetBucketVersioning(ctx context.Context, bucketName, status string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var versioning storj.BucketVersioning
+	switch status {
+	case versioningEnabled:
+		versioning = storj.VersioningEnabled
+	case versioningSuspended:
+		versioning = storj.VersioningSuspended
+	default:
+		return minio.NotImplemented{}
+	}
+
+	err = layer.gateway.project.SetBucketVersioning(ctx, bucketName, versioning)
+	return convertError(err, bucketName, "")
+}
+
+// GetBucketVersioning returns the S3 versioning state of a bucket. It is empty,
+// when versioning was never enabled.
+func (layer *gatewayLayer) GetBucketVersioning(ctx context.Context, bucketName string) (status string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, _, err := layer.gateway.project.GetBucketInfo(ctx, bucketName)
+	if err != nil {
+		return "", convertError(err, bucketName, "")
+	}
+
+	switch bucket.Versioning {
+	case storj.VersioningEnabled:
+		return versioningEnabled, nil
+	case storj.VersioningSuspended:
+		return versioningSuspended, nil
+	}
+	return "", nil
+}
+
+// ListObjectVersions lists the versions of all objects below prefix after keyMarker
+func (layer *gatewayLayer) ListObjectVersions(ctx context.Context, bucketName, prefix, keyMarker string, maxKeys int) (result ListObjectVersionsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return ListObjectVersionsInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	list, err := bucket.ListObjectVersions(ctx, &storj.ListOptions{
+		Direction: storj.After,
+		Cursor:    keyMarker,
+		Prefix:    prefix,
+		Recursive: true,
+		Limit:     maxKeys,
+	})
+	if err != nil {
+		return ListObjectVersionsInfo{}, convertError(err, bucketName, "")
+	}
+
+	for _, item := range list.Items {
+		path := item.Path
+		if prefix != "" {
+			path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
+		}
+
+		result.Versions = append(result.Versions, ObjectVersionInfo{
+			ObjectInfo: minio.ObjectInfo{
+				Name:        path,
+				Bucket:      bucketName,
+				ModTime:     item.Modified,
+				Size:        item.Size,
+				ETag:        hex.EncodeToString(item.Checksum),
+				ContentType: item.ContentType,
+				UserDefined: item.Metadata,
+			},
+			VersionID:      item.VersionID,
+			IsLatest:       item.IsLatest,
+			IsDeleteMarker: item.IsDeleteMarker,
+		})
+	}
+
+	result.IsTruncated = list.More
+	if list.More && len(list.Items) > 0 {
+		result.NextKeyMarker = list.Items[len(list.Items)-1].Path
+	}
+
+	return result, nil
+}
+
+// GetObjectVersion downloads a range of a version of an object
+func (layer *gatewayLayer) GetObjectVersion(ctx context.Context, bucketName, objectPath, versionID string, startOffset int64, length int64, writer io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	object, err := bucket.OpenObjectVersion(ctx, objectPath, versionID)
+	if err != nil {
+		return convertError(err, bucketName, objectPath)
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	if startOffset < 0 || length < -1 || startOffset+length > object.Meta.Size {
+		return minio.InvalidRange{
+			OffsetBegin:  startOffset,
+			OffsetEnd:    startOffset + length,
+			ResourceSize: object.Meta.Size,
+		}
+	}
+
+	reader, err := object.DownloadRange(ctx, startOffset, length)
+	if err != nil {
+		return convertError(err, bucketName, objectPath)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	_, err = io.Copy(writer, reader)
+
+	return err
+}
+
+// DeleteObjectVersion permanently deletes a version of an object or a delete marker
+func (layer *gatewayLayer) DeleteObjectVersion(ctx context.Context, bucketName, objectPath, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	err = bucket.DeleteObjectVersion(ctx, objectPath, versionID)
+
+	return convertError(err, bucketName, objectPath)
+}
+
+// removeVersionID removes the version ID header from the metadata of a new object,
+// the version is assigned by the satellite and must not be stored with the object.
+func removeVersionID(metadata map[string]string) {
+	for key := range metadata {
+		if strings.EqualFold(key, amzVersionIDHeader) {
+			delete(metadata, key)
+		}
+	}
+}
@@ -110,7 +110,7 @@ func BenchmarkOverlay(b *testing.B) {
 		b.Run("UpdateUptime", func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				id := all[i%len(all)]
-				_, err := overlaydb.UpdateUptime(ctx, id, i&1 == 0, 1, 1, 0.5)
+				_, err := overlaydb.UpdateUptime(ctx, id, i&1 == 0, 1, 1, 0.5, overlay.SuspensionConfig{})
 				require.NoError(b, err)
 			}
 		})
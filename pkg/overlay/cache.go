@@ -7,11 +7,13 @@ import (
 	"context"
 	"errors"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
@@ -41,9 +43,9 @@ var OverlayError = errs.Class("overlay error")
 // DB implements the database for overlay.Cache
 type DB interface {
 	// SelectStorageNodes looks up nodes based on criteria
-	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
+	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*NodeDossier, error)
 	// SelectNewStorageNodes looks up nodes based on new node criteria
-	SelectNewStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
+	SelectNewStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*NodeDossier, error)
 
 	// Get looks up the node by nodeID
 	Get(ctx context.Context, nodeID storj.NodeID) (*NodeDossier, error)
@@ -66,7 +68,15 @@ type DB interface {
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64, suspension SuspensionConfig) (stats *NodeStats, err error)
+	// UpdateExitStatus updates a single storagenode's graceful exit status.
+	UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error)
+	// UpdateLocation updates the geographic and network location of a node.
+	UpdateLocation(ctx context.Context, nodeID storj.NodeID, location geoip.Location) error
+	// DisqualifyNode disqualifies a storagenode for the given reason.
+	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason pb.DisqualificationReason) error
+	// ReinstateNode lifts the disqualification of a storagenode and resets its reputation.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults NodeSelectionConfig) error
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -77,6 +87,9 @@ type FindStorageNodesRequest struct {
 	FreeDisk             int64
 	ExcludedNodes        []storj.NodeID
 	MinimumVersion       string // semver or empty
+	// Placement restricts the location of the selected nodes in addition
+	// to the configured constraints, if not nil.
+	Placement *storj.PlacementConstraints
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -90,6 +103,11 @@ type NodeCriteria struct {
 	MinimumVersion string // semver or empty
 	OnlineWindow   time.Duration
 	DistinctIP     bool
+
+	CountryCodes         []string // ISO 3166-1 alpha-2, empty allows all countries
+	ExcludedCountryCodes []string
+	DistinctASN          bool
+	ExcludedASNs         []uint32
 }
 
 // UpdateRequest is used to update a node status.
@@ -106,18 +124,44 @@ type UpdateRequest struct {
 	UptimeLambda float64
 	UptimeWeight float64
 	UptimeDQ     float64
+	Suspension   SuspensionConfig
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node
 type NodeDossier struct {
 	pb.Node
-	Type         pb.NodeType
-	Operator     pb.NodeOperator
-	Capacity     pb.NodeCapacity
-	Reputation   NodeStats
-	Version      pb.NodeVersion
-	Contained    bool
-	Disqualified *time.Time
+	Type                   pb.NodeType
+	Operator               pb.NodeOperator
+	Capacity               pb.NodeCapacity
+	Reputation             NodeStats
+	Version                pb.NodeVersion
+	Contained              bool
+	Disqualified           *time.Time
+	DisqualificationReason pb.DisqualificationReason
+	Suspended              *time.Time
+	ExitStatus             ExitStatus
+	Location               geoip.Location
+}
+
+// ExitStatus is used for reading graceful exit status.
+type ExitStatus struct {
+	NodeID          storj.NodeID
+	ExitInitiatedAt *time.Time
+	ExitFinishedAt  *time.Time
+	ExitSuccess     bool
+
+	// ExitFailureReason is only meaningful when the exit finished without success.
+	ExitFailureReason pb.ExitFailed_Reason
+}
+
+// ExitStatusRequest is used to update a node's graceful exit status,
+// only the timestamps that are set are updated.
+type ExitStatusRequest struct {
+	NodeID            storj.NodeID
+	ExitInitiatedAt   time.Time
+	ExitFinishedAt    time.Time
+	ExitSuccess       bool
+	ExitFailureReason pb.ExitFailed_Reason
 }
 
 // NodeStats contains statistics about a node.
@@ -134,20 +178,49 @@ type NodeStats struct {
 	AuditReputationBeta   float64
 	UptimeReputationBeta  float64
 	Disqualified          *time.Time
+	// DisqualificationReason is only meaningful when Disqualified is set.
+	DisqualificationReason pb.DisqualificationReason
+	// Suspended is when the node's reputation fell below the suspension
+	// cut-off, it's cleared once the node recovers.
+	Suspended *time.Time
+}
+
+// AuditReputationScore returns the audit reputation score the satellite uses for disqualification.
+func (stats *NodeStats) AuditReputationScore() float64 {
+	return ReputationScore(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputationScore returns the uptime reputation score the satellite uses for disqualification.
+func (stats *NodeStats) UptimeReputationScore() float64 {
+	return ReputationScore(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+// ReputationScore calculates the reputation score from the shape parameters of
+// the beta distribution. Since alpha and beta decay by the forgetting factor
+// on every update, recent checks outweigh old ones. Without any history there
+// is nothing held against the node, so the score is 1.
+func ReputationScore(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 1
+	}
+	return alpha / (alpha + beta)
 }
 
 // Cache is used to store and handle node information
 type Cache struct {
 	log         *zap.Logger
 	db          DB
+	geoip       geoip.Lookup
 	preferences NodeSelectionConfig
 }
 
-// NewCache returns a new Cache
-func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig) *Cache {
+// NewCache returns a new Cache. The location of nodes is only tracked when
+// lookup is not nil.
+func NewCache(log *zap.Logger, db DB, lookup geoip.Lookup, preferences NodeSelectionConfig) *Cache {
 	return &Cache{
 		log:         log,
 		db:          db,
+		geoip:       lookup,
 		preferences: preferences,
 	}
 }
@@ -207,13 +280,14 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	}
 
 	excludedNodes := req.ExcludedNodes
+	countryCodes, excludedCountryCodes, distinctASN := placementCriteria(preferences, req.Placement)
 
 	newNodeCount := 0
 	if preferences.NewNodePercentage > 0 {
 		newNodeCount = int(float64(reputableNodeCount) * preferences.NewNodePercentage)
 	}
 
-	var newNodes []*pb.Node
+	var newNodes []*NodeDossier
 	if newNodeCount > 0 {
 		newNodes, err = cache.db.SelectNewStorageNodes(ctx, newNodeCount, &NodeCriteria{
 			FreeBandwidth:  req.FreeBandwidth,
@@ -223,6 +297,10 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 			MinimumVersion: preferences.MinimumVersion,
 			OnlineWindow:   preferences.OnlineWindow,
 			DistinctIP:     preferences.DistinctIP,
+
+			CountryCodes:         countryCodes,
+			ExcludedCountryCodes: excludedCountryCodes,
+			DistinctASN:          distinctASN,
 		})
 		if err != nil {
 			return nil, err
@@ -230,12 +308,17 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	}
 
 	var excludedIPs []string
+	var excludedASNs []uint32
 	// add selected new nodes and their IPs to the excluded lists for reputable node selection
 	for _, newNode := range newNodes {
 		excludedNodes = append(excludedNodes, newNode.Id)
 		if preferences.DistinctIP {
 			excludedIPs = append(excludedIPs, newNode.LastIp)
 		}
+		// nodes without a known autonomous system don't exclude each other
+		if distinctASN && newNode.Location.ASN != 0 {
+			excludedASNs = append(excludedASNs, newNode.Location.ASN)
+		}
 	}
 
 	criteria := NodeCriteria{
@@ -248,14 +331,23 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		MinimumVersion: preferences.MinimumVersion,
 		OnlineWindow:   preferences.OnlineWindow,
 		DistinctIP:     preferences.DistinctIP,
+
+		CountryCodes:         countryCodes,
+		ExcludedCountryCodes: excludedCountryCodes,
+		DistinctASN:          distinctASN,
+		ExcludedASNs:         excludedASNs,
 	}
 	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes = append(nodes, newNodes...)
-	nodes = append(nodes, reputableNodes...)
+	for _, node := range newNodes {
+		nodes = append(nodes, &node.Node)
+	}
+	for _, node := range reputableNodes {
+		nodes = append(nodes, &node.Node)
+	}
 
 	if len(nodes) < reputableNodeCount {
 		return nodes, ErrNotEnoughNodes.New("requested %d found %d; %+v ", reputableNodeCount, len(nodes), criteria)
@@ -264,6 +356,44 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	return nodes, nil
 }
 
+// placementCriteria combines the configured placement constraints with the
+// ones of a request. Both lists of allowed countries must allow a country.
+func placementCriteria(preferences *NodeSelectionConfig, placement *storj.PlacementConstraints) (countryCodes, excludedCountryCodes []string, distinctASN bool) {
+	countryCodes = splitCountryCodes(preferences.CountryCodes)
+	excludedCountryCodes = splitCountryCodes(preferences.ExcludedCountryCodes)
+	distinctASN = preferences.DistinctASN
+
+	if placement == nil {
+		return countryCodes, excludedCountryCodes, distinctASN
+	}
+
+	requested := splitCountryCodes(strings.Join(placement.CountryCodes, ","))
+	switch {
+	case len(requested) == 0:
+	case len(countryCodes) == 0:
+		countryCodes = requested
+	default:
+		// the configured countries not allowed by the request are excluded
+		for _, code := range countryCodes {
+			if !containsString(requested, code) {
+				excludedCountryCodes = append(excludedCountryCodes, code)
+			}
+		}
+	}
+
+	excludedCountryCodes = append(excludedCountryCodes, splitCountryCodes(strings.Join(placement.ExcludedCountryCodes, ","))...)
+	return countryCodes, excludedCountryCodes, distinctASN || placement.DistinctASN
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
 // KnownOffline filters a set of nodes to offline nodes
 func (cache *Cache) KnownOffline(ctx context.Context, nodeIds storj.NodeIDList) (offlineNodes storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -307,11 +437,30 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 		return errors.New("node has no address")
 	}
 	// Resolve IP Address Network to ensure it is set
-	value.LastIp, err = GetNetwork(ctx, value.Address.Address)
+	addr, err := getIP(ctx, value.Address.Address)
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+	value.LastIp, err = getNetwork(addr)
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+	err = cache.db.UpdateAddress(ctx, &value, cache.preferences)
+	if err != nil || cache.geoip == nil {
+		return err
+	}
+
+	location, err := cache.geoip.Lookup(ctx, addr.IP)
 	if err != nil {
 		return OverlayError.Wrap(err)
 	}
-	return cache.db.UpdateAddress(ctx, &value, cache.preferences)
+	return cache.db.UpdateLocation(ctx, nodeID, location)
+}
+
+// LocatesNodes returns whether the locations of the nodes are looked up.
+// Without them placement constraints can't be honored.
+func (cache *Cache) LocatesNodes() bool {
+	return cache.geoip != nil
 }
 
 // IsVetted returns whether or not the node reaches reputable thresholds
@@ -338,6 +487,7 @@ func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (st
 	request.UptimeLambda = cache.preferences.UptimeReputationLambda
 	request.UptimeWeight = cache.preferences.UptimeReputationWeight
 	request.UptimeDQ = cache.preferences.UptimeReputationDQ
+	request.Suspension = cache.preferences.Suspension
 
 	return cache.db.UpdateStats(ctx, request)
 }
@@ -355,7 +505,28 @@ func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp
 	weight := cache.preferences.UptimeReputationWeight
 	uptimeDQ := cache.preferences.UptimeReputationDQ
 
-	return cache.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
+	return cache.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ, cache.preferences.Suspension)
+}
+
+// DisqualifyNode disqualifies a storagenode, it isn't selected for uploads, audits
+// or repair downloads anymore and its pieces are repaired.
+func (cache *Cache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason pb.DisqualificationReason) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifyNode(ctx, nodeID, reason)
+}
+
+// ReinstateNode lifts the disqualification of a storagenode. Its audit and uptime
+// reputation are reset to the initial values, so it isn't disqualified again
+// right away.
+func (cache *Cache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.ReinstateNode(ctx, nodeID, cache.preferences)
+}
+
+// UpdateExitStatus updates a single storagenode's graceful exit status.
+func (cache *Cache) UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UpdateExitStatus(ctx, request)
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -370,7 +541,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err = cache.db.UpdateUptime(ctx, node.Id, false, lambda, weight, uptimeDQ)
+	_, err = cache.db.UpdateUptime(ctx, node.Id, false, lambda, weight, uptimeDQ, cache.preferences.Suspension)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
@@ -390,7 +561,7 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	weight := cache.preferences.UptimeReputationWeight
 	uptimeDQ := cache.preferences.UptimeReputationDQ
 
-	_, err = cache.db.UpdateUptime(ctx, node.Id, true, lambda, weight, uptimeDQ)
+	_, err = cache.db.UpdateUptime(ctx, node.Id, true, lambda, weight, uptimeDQ, cache.preferences.Suspension)
 	if err != nil {
 		zap.L().Debug("error updating node connection info", zap.Error(err))
 	}
@@ -439,7 +610,11 @@ func GetNetwork(ctx context.Context, target string) (network string, err error)
 	if err != nil {
 		return "", err
 	}
+	return getNetwork(addr)
+}
 
+// getNetwork determines the IP /24 Subnet of addr
+func getNetwork(addr net.IPAddr) (network string, err error) {
 	// If addr can be converted to 4byte notation, it is an IPv4 address, else its an IPv6 address
 	if ipv4 := addr.IP.To4(); ipv4 != nil {
 		//Filter all IPv4 Addresses into /24 Subnet's
@@ -5,6 +5,7 @@ package overlay_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -34,6 +36,35 @@ func TestCache_Database(t *testing.T) {
 	})
 }
 
+func TestCache_Location(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		lookup, err := geoip.NewFileDB(strings.NewReader("127.0.0.0/8,DE,AS3320\n10.0.0.0/8,US,7922\n"))
+		require.NoError(t, err)
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), lookup, testNodeSelectionConfig(0, 0, false))
+
+		for _, tt := range []struct {
+			address  string
+			location geoip.Location
+		}{
+			{"127.0.0.1:0", geoip.Location{CountryCode: "DE", ASN: 3320}},
+			{"10.1.2.3:0", geoip.Location{CountryCode: "US", ASN: 7922}},
+			{"192.168.0.1:0", geoip.Location{}},
+		} {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: tt.address}})
+			require.NoError(t, err)
+
+			dossier, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.location, dossier.Location, tt.address)
+		}
+	})
+}
+
 // returns a NodeSelectionConfig with sensible test values
 func testNodeSelectionConfig(auditCount int64, newNodePercentage float64, distinctIP bool) overlay.NodeSelectionConfig {
 	return overlay.NodeSelectionConfig{
@@ -68,7 +99,7 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB) {
 	address := &pb.NodeAddress{Address: "127.0.0.1:0"}
 
 	nodeSelectionConfig := testNodeSelectionConfig(0, 0, false)
-	cache := overlay.NewCache(zaptest.NewLogger(t), store, nodeSelectionConfig)
+	cache := overlay.NewCache(zaptest.NewLogger(t), store, nil, nodeSelectionConfig)
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID, Address: address})
@@ -242,7 +273,7 @@ func TestRandomizedSelection(t *testing.T) {
 
 		// select numNodesToSelect nodes selectIterations times
 		for i := 0; i < selectIterations; i++ {
-			var nodes []*pb.Node
+			var nodes []*overlay.NodeDossier
 			var err error
 
 			if i%2 == 0 {
@@ -290,6 +321,87 @@ func TestRandomizedSelection(t *testing.T) {
 	})
 }
 
+func TestSuspension(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+		defaults := overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  1,
+			AuditReputationBeta0:   0,
+			UptimeReputationAlpha0: 1,
+			UptimeReputationBeta0:  0,
+		}
+
+		update := func(nodeID storj.NodeID, auditSuccess bool, gracePeriod time.Duration) *overlay.NodeStats {
+			stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       nodeID,
+				IsUp:         true,
+				AuditSuccess: auditSuccess,
+				AuditLambda:  1,
+				AuditWeight:  1,
+				AuditDQ:      0.1,
+				UptimeLambda: 1,
+				UptimeWeight: 1,
+				UptimeDQ:     0.1,
+				Suspension: overlay.SuspensionConfig{
+					AuditReputation: 0.7,
+					GracePeriod:     gracePeriod,
+				},
+			})
+			require.NoError(t, err)
+			return stats
+		}
+
+		goodID, suspendedID := testrand.NodeID(), testrand.NodeID()
+		for _, nodeID := range []storj.NodeID{goodID, suspendedID} {
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, defaults)
+			require.NoError(t, err)
+			_, err = cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+				Type:     pb.NodeType_STORAGE,
+				Capacity: &pb.NodeCapacity{},
+			})
+			require.NoError(t, err)
+
+			stats := update(nodeID, true, time.Hour)
+			require.Nil(t, stats.Suspended)
+		}
+
+		// the audit score drops below the suspension cut-off, but not below the disqualification one
+		stats := update(suspendedID, false, time.Hour)
+		require.NotNil(t, stats.Suspended)
+		require.Nil(t, stats.Disqualified)
+
+		dossier, err := cache.Get(ctx, suspendedID)
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Suspended)
+
+		// suspended nodes aren't selected for uploads
+		nodes, err := cache.SelectStorageNodes(ctx, 2, &overlay.NodeCriteria{
+			OnlineWindow: time.Hour,
+			AuditCount:   1,
+		})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		require.Equal(t, goodID, nodes[0].Id)
+
+		// the suspension is lifted when the node recovers
+		stats = update(suspendedID, true, time.Hour)
+		require.Nil(t, stats.Suspended)
+		require.Nil(t, stats.Disqualified)
+
+		// the node is disqualified when it doesn't recover within the grace period
+		stats = update(suspendedID, false, 0)
+		require.NotNil(t, stats.Suspended)
+		require.Nil(t, stats.Disqualified)
+
+		stats = update(suspendedID, false, 0)
+		require.NotNil(t, stats.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_AUDIT_FAILURE, stats.DisqualificationReason)
+	})
+}
+
 func TestIsVetted(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
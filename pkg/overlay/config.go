@@ -4,10 +4,13 @@
 package overlay
 
 import (
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/geoip"
 )
 
 var (
@@ -19,7 +22,8 @@ var (
 // Config is a configuration struct for everything you need to start the
 // Overlay cache responsibility.
 type Config struct {
-	Node NodeSelectionConfig
+	Node  NodeSelectionConfig
+	GeoIP geoip.Config
 }
 
 // NodeSelectionConfig is a configuration struct to determine the minimum
@@ -32,6 +36,10 @@ type NodeSelectionConfig struct {
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
 	DistinctIP        bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 
+	CountryCodes         string `help:"comma separated ISO 3166-1 alpha-2 codes of the countries where pieces may be stored, empty allows all countries" default:""`
+	ExcludedCountryCodes string `help:"comma separated ISO 3166-1 alpha-2 codes of the countries where pieces must not be stored" default:""`
+	DistinctASN          bool   `help:"require distinct autonomous systems when choosing nodes for upload" default:"false"`
+
 	AuditReputationRepairWeight  float64 `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
 	AuditReputationUplinkWeight  float64 `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
 	AuditReputationAlpha0        float64 `help:"the initial shape 'alpha' used to calculate audit SNs reputation" default:"1.0"`
@@ -46,4 +54,26 @@ type NodeSelectionConfig struct {
 	UptimeReputationLambda       float64 `help:"the forgetting factor used to calculate the uptime SNs reputation" default:"0.99"`
 	UptimeReputationWeight       float64 `help:"the normalization weight used to calculate the uptime SNs reputation" default:"1.0"`
 	UptimeReputationDQ           float64 `help:"the reputation cut-off for disqualifying SNs based on uptime history" default:"0"`
+
+	Suspension SuspensionConfig
+}
+
+// SuspensionConfig is a configuration struct for suspending nodes before
+// they are disqualified.
+type SuspensionConfig struct {
+	AuditReputation  float64       `help:"the reputation cut-off for suspending SNs based on audit history, suspended SNs aren't selected for uploads" default:"0.7"`
+	UptimeReputation float64       `help:"the reputation cut-off for suspending SNs based on uptime history, suspended SNs aren't selected for uploads" default:"0"`
+	GracePeriod      time.Duration `help:"the time a suspended SN has to recover its reputation before it's disqualified" default:"168h"`
+}
+
+// splitCountryCodes splits a comma separated list of country codes.
+func splitCountryCodes(list string) []string {
+	var codes []string
+	for _, code := range strings.Split(list, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
 }
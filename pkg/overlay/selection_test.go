@@ -13,6 +13,7 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/storj"
 )
@@ -246,6 +247,110 @@ func TestDistinctIPs(t *testing.T) {
 	})
 }
 
+func TestPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Overlay.Service
+
+		// nodes 0-4 are in Germany, 5-7 in the US and 8-9 in France
+		locations := []geoip.Location{
+			{CountryCode: "DE", ASN: 3320}, {CountryCode: "DE", ASN: 3320},
+			{CountryCode: "DE", ASN: 3320}, {CountryCode: "DE", ASN: 3209},
+			{CountryCode: "DE", ASN: 3209},
+			{CountryCode: "US", ASN: 7922}, {CountryCode: "US", ASN: 7922},
+			{CountryCode: "US", ASN: 701},
+			{CountryCode: "FR", ASN: 3215}, {CountryCode: "FR", ASN: 3215},
+		}
+		byID := make(map[storj.NodeID]geoip.Location)
+		for i, node := range planet.StorageNodes {
+			err := satellite.DB.OverlayCache().UpdateLocation(ctx, node.ID(), locations[i])
+			require.NoError(t, err)
+			byID[node.ID()] = locations[i]
+		}
+
+		type test struct {
+			Preferences    overlay.NodeSelectionConfig
+			Placement      *storj.PlacementConstraints
+			RequestCount   int
+			Countries      []string
+			ShouldFailWith *errs.Class
+		}
+
+		eu := testNodeSelectionConfig(0, 0, false)
+		eu.CountryCodes = "de, fr"
+
+		for i, tt := range []test{
+			{ // only German nodes
+				Preferences:  testNodeSelectionConfig(0, 0, false),
+				Placement:    &storj.PlacementConstraints{CountryCodes: []string{"DE"}},
+				RequestCount: 5,
+				Countries:    []string{"DE"},
+			},
+			{ // not enough German nodes
+				Preferences:    testNodeSelectionConfig(0, 0, false),
+				Placement:      &storj.PlacementConstraints{CountryCodes: []string{"DE"}},
+				RequestCount:   6,
+				ShouldFailWith: &overlay.ErrNotEnoughNodes,
+			},
+			{ // excluded country
+				Preferences:  testNodeSelectionConfig(0, 0, false),
+				Placement:    &storj.PlacementConstraints{ExcludedCountryCodes: []string{"DE"}},
+				RequestCount: 5,
+				Countries:    []string{"US", "FR"},
+			},
+			{ // configured countries
+				Preferences:  eu,
+				RequestCount: 7,
+				Countries:    []string{"DE", "FR"},
+			},
+			{ // configured countries intersect with the requested ones
+				Preferences:  eu,
+				Placement:    &storj.PlacementConstraints{CountryCodes: []string{"FR", "US"}},
+				RequestCount: 2,
+				Countries:    []string{"FR"},
+			},
+			{ // distinct autonomous systems
+				Preferences:  testNodeSelectionConfig(0, 0, false),
+				Placement:    &storj.PlacementConstraints{DistinctASN: true},
+				RequestCount: 5,
+				Countries:    []string{"DE", "US", "FR"},
+			},
+			{ // not enough distinct autonomous systems
+				Preferences:    testNodeSelectionConfig(0, 0, false),
+				Placement:      &storj.PlacementConstraints{DistinctASN: true},
+				RequestCount:   6,
+				ShouldFailWith: &overlay.ErrNotEnoughNodes,
+			},
+		} {
+			t.Logf("#%2d. %+v", i, tt)
+
+			response, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: tt.RequestCount,
+				Placement:      tt.Placement,
+			}, &tt.Preferences)
+			if tt.ShouldFailWith != nil {
+				assert.Error(t, err)
+				assert.True(t, tt.ShouldFailWith.Has(err))
+				continue
+			}
+			require.NoError(t, err)
+			require.Len(t, response, tt.RequestCount)
+
+			asns := make(map[uint32]bool)
+			for _, node := range response {
+				location := byID[node.Id]
+				assert.Contains(t, tt.Countries, location.CountryCode)
+				if tt.Placement != nil && tt.Placement.DistinctASN {
+					assert.False(t, asns[location.ASN])
+					asns[location.ASN] = true
+				}
+			}
+		}
+	})
+}
+
 func TestAddrtoNetwork_Conversion(t *testing.T) {
 	ctx := testcontext.New(t)
 
@@ -40,6 +40,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			{storj.NodeID{1}, 20, 0, 20, 0}, // good reputations => good
 			{storj.NodeID{2}, 0, 20, 20, 0}, // bad audit rep, good uptime rep => bad
 			{storj.NodeID{3}, 20, 0, 0, 20}, // good audit rep, bad uptime rep => bad
+			{storj.NodeID{5}, 0, 20, 0, 20}, // bad audit rep, bad uptime rep => bad
 		} {
 			startingRep := overlay.NodeSelectionConfig{
 				AuditReputationAlpha0:  tt.auditAlpha,
@@ -76,6 +77,34 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.Contains(t, invalid, storj.NodeID{3}) // bad uptime
 		require.Contains(t, invalid, storj.NodeID{4}) // not in db
 		require.Len(t, invalid, 3)
+
+		dossier, err := cache.Get(ctx, storj.NodeID{2})
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_AUDIT_FAILURE, dossier.DisqualificationReason)
+
+		dossier, err = cache.Get(ctx, storj.NodeID{3})
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_OFFLINE, dossier.DisqualificationReason)
+
+		// failing audits takes precedence over being offline
+		dossier, err = cache.Get(ctx, storj.NodeID{5})
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_AUDIT_FAILURE, dossier.DisqualificationReason)
+
+		// disqualifying again keeps the first disqualification
+		err = cache.DisqualifyNode(ctx, storj.NodeID{5}, pb.DisqualificationReason_OFFLINE)
+		require.NoError(t, err)
+
+		again, err := cache.Get(ctx, storj.NodeID{5})
+		require.NoError(t, err)
+		require.Equal(t, dossier.Disqualified, again.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_AUDIT_FAILURE, again.DisqualificationReason)
+
+		err = cache.DisqualifyNode(ctx, storj.NodeID{4}, pb.DisqualificationReason_OFFLINE)
+		require.True(t, overlay.ErrNodeNotFound.Has(err), err)
 	}
 
 	{ // TestUpdateOperator
@@ -189,7 +218,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		weight := 0.876
 		dq := float64(0) // don't disqualify for any reason
 
-		stats, err := cache.UpdateUptime(ctx, nodeID, false, lambda, weight, dq)
+		stats, err := cache.UpdateUptime(ctx, nodeID, false, lambda, weight, dq, overlay.SuspensionConfig{})
 		require.NoError(t, err)
 
 		expectedAlpha := lambda * alpha
@@ -200,7 +229,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		alpha = expectedAlpha
 		beta = expectedBeta
 
-		stats, err = cache.UpdateUptime(ctx, nodeID, true, lambda, weight, dq)
+		stats, err = cache.UpdateUptime(ctx, nodeID, true, lambda, weight, dq, overlay.SuspensionConfig{})
 		require.NoError(t, err)
 
 		expectedAlpha = lambda*alpha + weight
@@ -208,4 +237,39 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.EqualValues(t, stats.UptimeReputationAlpha, expectedAlpha)
 		require.EqualValues(t, stats.UptimeReputationBeta, expectedBeta)
 	}
+
+	{ // TestUptimeReputationDecay
+		nodeID := storj.NodeID{20}
+
+		// a long-lived node that was always online has reached the
+		// steady state alpha of weight / (1 - lambda)
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{
+			UptimeReputationAlpha0: 20,
+			UptimeReputationBeta0:  0,
+		})
+		require.NoError(t, err)
+
+		stats, err := cache.UpdateUptime(ctx, nodeID, true, 0.95, 1, 0, overlay.SuspensionConfig{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.UptimeCount)
+		require.EqualValues(t, 1, stats.UptimeSuccessCount)
+
+		// goes offline for a while
+		const offline = 20
+		for i := 0; i < offline; i++ {
+			stats, err = cache.UpdateUptime(ctx, nodeID, false, 0.95, 1, 0, overlay.SuspensionConfig{})
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 1+offline, stats.UptimeCount)
+		require.EqualValues(t, 1, stats.UptimeSuccessCount)
+
+		// the old successes are forgotten, so the score drops quickly
+		require.True(t, stats.UptimeReputationScore() < 0.5, stats.UptimeReputationScore())
+
+		// and the node gets disqualified once the score reaches the threshold
+		stats, err = cache.UpdateUptime(ctx, nodeID, false, 0.95, 1, 0.5, overlay.SuspensionConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, stats.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_OFFLINE, stats.DisqualificationReason)
+	}
 }
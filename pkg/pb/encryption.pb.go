@@ -23,10 +23,11 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type CipherSuite int32
 
 const (
-	CipherSuite_ENC_UNSPECIFIED CipherSuite = 0
-	CipherSuite_ENC_NULL        CipherSuite = 1
-	CipherSuite_ENC_AESGCM      CipherSuite = 2
-	CipherSuite_ENC_SECRETBOX   CipherSuite = 3
+	CipherSuite_ENC_UNSPECIFIED       CipherSuite = 0
+	CipherSuite_ENC_NULL              CipherSuite = 1
+	CipherSuite_ENC_AESGCM            CipherSuite = 2
+	CipherSuite_ENC_SECRETBOX         CipherSuite = 3
+	CipherSuite_ENC_XCHACHA20POLY1305 CipherSuite = 4
 )
 
 var CipherSuite_name = map[int32]string{
@@ -34,13 +35,15 @@ var CipherSuite_name = map[int32]string{
 	1: "ENC_NULL",
 	2: "ENC_AESGCM",
 	3: "ENC_SECRETBOX",
+	4: "ENC_XCHACHA20POLY1305",
 }
 
 var CipherSuite_value = map[string]int32{
-	"ENC_UNSPECIFIED": 0,
-	"ENC_NULL":        1,
-	"ENC_AESGCM":      2,
-	"ENC_SECRETBOX":   3,
+	"ENC_UNSPECIFIED":       0,
+	"ENC_NULL":              1,
+	"ENC_AESGCM":            2,
+	"ENC_SECRETBOX":         3,
+	"ENC_XCHACHA20POLY1305": 4,
 }
 
 func (x CipherSuite) String() string {
@@ -105,7 +108,7 @@ func init() {
 func init() { proto.RegisterFile("encryption.proto", fileDescriptor_8293a649ce9418c6) }
 
 var fileDescriptor_8293a649ce9418c6 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
+	// 231 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0xcd, 0x4b, 0x2e,
 	0xaa, 0x2c, 0x28, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x88,
 	0x28, 0x15, 0x72, 0x89, 0xb8, 0xc2, 0x79, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xa9, 0x25, 0xa9, 0x45,
@@ -113,11 +116,12 @@ var fileDescriptor_8293a649ce9418c6 = []byte{
 	0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xe2, 0x7a, 0x48, 0x86, 0x39, 0x83, 0xe5, 0x83,
 	0x41, 0xd2, 0x41, 0xdc, 0xc9, 0x08, 0x8e, 0x90, 0x2c, 0x17, 0x57, 0x52, 0x4e, 0x7e, 0x72, 0x76,
 	0x7c, 0x71, 0x66, 0x55, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x27, 0x58, 0x24, 0x38,
-	0xb3, 0x2a, 0x55, 0x2b, 0x98, 0x8b, 0x1b, 0x49, 0xab, 0x90, 0x30, 0x17, 0xbf, 0xab, 0x9f, 0x73,
+	0xb3, 0x2a, 0x55, 0x2b, 0x8f, 0x8b, 0x1b, 0x49, 0xab, 0x90, 0x30, 0x17, 0xbf, 0xab, 0x9f, 0x73,
 	0x7c, 0xa8, 0x5f, 0x70, 0x80, 0xab, 0xb3, 0xa7, 0x9b, 0xa7, 0xab, 0x8b, 0x00, 0x83, 0x10, 0x0f,
 	0x17, 0x07, 0x48, 0xd0, 0x2f, 0xd4, 0xc7, 0x47, 0x80, 0x51, 0x88, 0x8f, 0x8b, 0x0b, 0xc4, 0x73,
 	0x74, 0x0d, 0x76, 0x77, 0xf6, 0x15, 0x60, 0x12, 0x12, 0xe4, 0xe2, 0x05, 0xf1, 0x83, 0x5d, 0x9d,
-	0x83, 0x5c, 0x43, 0x9c, 0xfc, 0x23, 0x04, 0x98, 0x9d, 0x58, 0xa2, 0x98, 0x0a, 0x92, 0x92, 0xd8,
-	0xc0, 0x1e, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x9d, 0x88, 0x06, 0xf4, 0x00, 0x00,
-	0x00,
+	0x83, 0x5c, 0x43, 0x9c, 0xfc, 0x23, 0x04, 0x98, 0x85, 0x24, 0xb9, 0x44, 0x41, 0x42, 0x11, 0xce,
+	0x1e, 0x8e, 0xce, 0x1e, 0x8e, 0x46, 0x06, 0x01, 0xfe, 0x3e, 0x91, 0x86, 0xc6, 0x06, 0xa6, 0x02,
+	0x2c, 0x4e, 0x2c, 0x51, 0x4c, 0x05, 0x49, 0x49, 0x6c, 0x60, 0xbf, 0x1b, 0x03, 0x06, 0x00, 0x0c,
+	0x16, 0x4b, 0x33, 0x0f, 0x01, 0x00, 0x00,
 }
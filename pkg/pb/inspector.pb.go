@@ -1111,6 +1111,7 @@ type DashboardResponse struct {
 	Uptime               *duration.Duration   `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
 	LastPinged           time.Time            `protobuf:"bytes,9,opt,name=last_pinged,json=lastPinged,proto3,stdtime" json:"last_pinged"`
 	LastQueried          time.Time            `protobuf:"bytes,10,opt,name=last_queried,json=lastQueried,proto3,stdtime" json:"last_queried"`
+	Payouts              []*PayoutEstimate    `protobuf:"bytes,11,rep,name=payouts,proto3" json:"payouts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1203,6 +1204,85 @@ func (m *DashboardResponse) GetLastQueried() time.Time {
 	return time.Time{}
 }
 
+func (m *DashboardResponse) GetPayouts() []*PayoutEstimate {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// PayoutEstimate is the estimated payout of the current month in USD.
+type PayoutEstimate struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Egress               float64  `protobuf:"fixed64,2,opt,name=egress,proto3" json:"egress,omitempty"`
+	RepairEgress         float64  `protobuf:"fixed64,3,opt,name=repair_egress,json=repairEgress,proto3" json:"repair_egress,omitempty"`
+	AuditEgress          float64  `protobuf:"fixed64,4,opt,name=audit_egress,json=auditEgress,proto3" json:"audit_egress,omitempty"`
+	Storage              float64  `protobuf:"fixed64,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	Total                float64  `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutEstimate) Reset()         { *m = PayoutEstimate{} }
+func (m *PayoutEstimate) String() string { return proto.CompactTextString(m) }
+func (*PayoutEstimate) ProtoMessage()    {}
+func (*PayoutEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+}
+func (m *PayoutEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutEstimate.Unmarshal(m, b)
+}
+func (m *PayoutEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutEstimate.Marshal(b, m, deterministic)
+}
+func (m *PayoutEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutEstimate.Merge(m, src)
+}
+func (m *PayoutEstimate) XXX_Size() int {
+	return xxx_messageInfo_PayoutEstimate.Size(m)
+}
+func (m *PayoutEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutEstimate proto.InternalMessageInfo
+
+func (m *PayoutEstimate) GetEgress() float64 {
+	if m != nil {
+		return m.Egress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetRepairEgress() float64 {
+	if m != nil {
+		return m.RepairEgress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetAuditEgress() float64 {
+	if m != nil {
+		return m.AuditEgress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetStorage() float64 {
+	if m != nil {
+		return m.Storage
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 type SegmentHealthRequest struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
@@ -1217,7 +1297,7 @@ func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
 func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthRequest) ProtoMessage()    {}
 func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
@@ -1279,7 +1359,7 @@ func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
 func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealth) ProtoMessage()    {}
 func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
@@ -1318,7 +1398,7 @@ func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
 func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthResponse) ProtoMessage()    {}
 func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
 func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
@@ -1368,7 +1448,7 @@ func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
 func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthRequest) ProtoMessage()    {}
 func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
 func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
@@ -1442,7 +1522,7 @@ func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
 func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthResponse) ProtoMessage()    {}
 func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
 func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
@@ -1476,6 +1556,67 @@ func (m *ObjectHealthResponse) GetRedundancy() *RedundancyScheme {
 	return nil
 }
 
+type StartGracefulExitRequest struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartGracefulExitRequest) Reset()         { *m = StartGracefulExitRequest{} }
+func (m *StartGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*StartGracefulExitRequest) ProtoMessage()    {}
+func (*StartGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+}
+func (m *StartGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *StartGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *StartGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGracefulExitRequest.Merge(m, src)
+}
+func (m *StartGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_StartGracefulExitRequest.Size(m)
+}
+func (m *StartGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartGracefulExitRequest proto.InternalMessageInfo
+
+type StartGracefulExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartGracefulExitResponse) Reset()         { *m = StartGracefulExitResponse{} }
+func (m *StartGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*StartGracefulExitResponse) ProtoMessage()    {}
+func (*StartGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+}
+func (m *StartGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *StartGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *StartGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGracefulExitResponse.Merge(m, src)
+}
+func (m *StartGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_StartGracefulExitResponse.Size(m)
+}
+func (m *StartGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartGracefulExitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
 	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
@@ -1504,122 +1645,135 @@ func init() {
 	proto.RegisterType((*StatSummaryResponse)(nil), "inspector.StatSummaryResponse")
 	proto.RegisterType((*DashboardRequest)(nil), "inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "inspector.DashboardResponse")
+	proto.RegisterType((*PayoutEstimate)(nil), "inspector.PayoutEstimate")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealth)(nil), "inspector.SegmentHealth")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
 	proto.RegisterType((*ObjectHealthRequest)(nil), "inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "inspector.ObjectHealthResponse")
+	proto.RegisterType((*StartGracefulExitRequest)(nil), "inspector.StartGracefulExitRequest")
+	proto.RegisterType((*StartGracefulExitResponse)(nil), "inspector.StartGracefulExitResponse")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x39, 0x73, 0x1b, 0x47,
-	0x16, 0xe6, 0xe0, 0x22, 0xf0, 0x00, 0xe2, 0x68, 0x52, 0x12, 0x16, 0x92, 0x08, 0xee, 0xec, 0xa1,
-	0x83, 0xbb, 0xa0, 0x16, 0xab, 0x0d, 0x54, 0x5b, 0x0e, 0x08, 0x52, 0x07, 0x4a, 0xb2, 0x44, 0x0d,
-	0x65, 0x07, 0x2e, 0x95, 0x51, 0x0d, 0x74, 0x03, 0x1c, 0x13, 0x98, 0x1e, 0xcd, 0x34, 0x64, 0xe1,
-	0x0f, 0xb8, 0xec, 0xc8, 0x4a, 0x1c, 0x38, 0x76, 0xf9, 0x1f, 0x38, 0x72, 0xea, 0xc4, 0xbf, 0xc1,
-	0x81, 0x9c, 0xd9, 0xb9, 0x33, 0x67, 0xae, 0x3e, 0xe6, 0xc2, 0x61, 0xd2, 0x65, 0x3b, 0xc3, 0xbc,
-	0xef, 0xeb, 0xd7, 0xef, 0xbd, 0x3e, 0xde, 0xd7, 0x80, 0x8a, 0xed, 0xf8, 0x2e, 0x1d, 0x70, 0xe6,
-	0xb5, 0x5c, 0x8f, 0x71, 0x86, 0x0a, 0xa1, 0xa1, 0x01, 0x23, 0x36, 0x62, 0xca, 0xdc, 0x00, 0x87,
-	0x11, 0xaa, 0x7f, 0x57, 0x5c, 0x66, 0x3b, 0x9c, 0x7a, 0xa4, 0xaf, 0x0d, 0xdb, 0x23, 0xc6, 0x46,
-	0x63, 0xba, 0x27, 0xbf, 0xfa, 0xd3, 0xe1, 0x1e, 0x99, 0x7a, 0x98, 0xdb, 0xcc, 0xd1, 0x78, 0x73,
-	0x1e, 0xe7, 0xf6, 0x84, 0xfa, 0x1c, 0x4f, 0x5c, 0x45, 0x30, 0x1f, 0xc3, 0xf6, 0x23, 0xdb, 0xe7,
-	0x5d, 0xcf, 0xa3, 0x2e, 0xf6, 0x70, 0x7f, 0x4c, 0x8f, 0xe9, 0x68, 0x42, 0x1d, 0xee, 0x5b, 0xf4,
-	0xc5, 0x94, 0xfa, 0x1c, 0x6d, 0x41, 0x76, 0x6c, 0x4f, 0x6c, 0x5e, 0x37, 0x76, 0x8c, 0xeb, 0x59,
-	0x4b, 0x7d, 0xa0, 0x8b, 0x90, 0x63, 0xc3, 0xa1, 0x4f, 0x79, 0x3d, 0x25, 0xcd, 0xfa, 0xcb, 0xfc,
-	0xc1, 0x00, 0xb4, 0xe8, 0x0c, 0x21, 0xc8, 0xb8, 0x98, 0x9f, 0x48, 0x1f, 0x25, 0x4b, 0xfe, 0x46,
-	0x77, 0xa0, 0xec, 0x2b, 0xb8, 0x47, 0x28, 0xc7, 0xf6, 0x58, 0xba, 0x2a, 0xb6, 0x51, 0x2b, 0xca,
-	0xf2, 0x48, 0xfd, 0xb2, 0x36, 0x34, 0xf3, 0x50, 0x12, 0x51, 0x13, 0x8a, 0x63, 0xe6, 0xf3, 0x9e,
-	0x6b, 0xd3, 0x01, 0xf5, 0xeb, 0x69, 0x19, 0x02, 0x08, 0xd3, 0x91, 0xb4, 0xa0, 0x16, 0x6c, 0x8e,
-	0xb1, 0xcf, 0x7b, 0x22, 0x10, 0xdb, 0xeb, 0x61, 0xce, 0xe9, 0xc4, 0xe5, 0xf5, 0xcc, 0x8e, 0x71,
-	0x3d, 0x6d, 0xd5, 0x04, 0x64, 0x49, 0x64, 0x5f, 0x01, 0xe8, 0x16, 0x6c, 0x25, 0xa9, 0xbd, 0x01,
-	0x9b, 0x3a, 0xbc, 0x9e, 0x95, 0x03, 0x90, 0x17, 0x27, 0x1f, 0x08, 0xc4, 0x7c, 0x0e, 0xcd, 0x95,
-	0x85, 0xf3, 0x5d, 0xe6, 0xf8, 0x14, 0xdd, 0x81, 0xbc, 0x0e, 0xdb, 0xaf, 0x1b, 0x3b, 0xe9, 0xeb,
-	0xc5, 0xf6, 0xd5, 0x56, 0xb4, 0xe8, 0x8b, 0x23, 0xad, 0x90, 0x6e, 0xde, 0x04, 0x24, 0xa7, 0x79,
-	0xcc, 0x08, 0x8d, 0x1c, 0x6e, 0x41, 0x56, 0x85, 0x65, 0xc8, 0xb0, 0xd4, 0x87, 0xb9, 0x09, 0xb5,
-	0x38, 0x57, 0xae, 0x9a, 0x79, 0x11, 0xb6, 0xee, 0x53, 0xde, 0x99, 0x0e, 0x4e, 0x29, 0x17, 0x71,
-	0x06, 0xf6, 0x9f, 0x0c, 0xb8, 0x30, 0x07, 0x68, 0xe7, 0xfb, 0xb0, 0xde, 0x97, 0xd6, 0x20, 0xd8,
-	0x6b, 0xb1, 0x60, 0x97, 0x0e, 0x69, 0x29, 0x93, 0x15, 0x8c, 0x6b, 0x7c, 0x66, 0x40, 0x4e, 0xd9,
-	0xd0, 0x2e, 0x14, 0x94, 0xb5, 0x67, 0x13, 0xb5, 0xea, 0x9d, 0xf2, 0xb7, 0x6f, 0x9a, 0x6b, 0xdf,
-	0xbd, 0x69, 0xe6, 0x44, 0xa0, 0xdd, 0x43, 0x2b, 0xaf, 0x08, 0x5d, 0x82, 0xf6, 0x60, 0xc3, 0x63,
-	0x53, 0x6e, 0x3b, 0xa3, 0x9e, 0xd8, 0xec, 0x7e, 0x3d, 0x25, 0x03, 0x80, 0x96, 0xdc, 0xfa, 0x82,
-	0x6e, 0x95, 0x34, 0x41, 0x26, 0x89, 0xfe, 0x0d, 0xa5, 0x01, 0x1e, 0x9c, 0x50, 0xa2, 0xf9, 0xe9,
-	0x05, 0x7e, 0x51, 0xe1, 0x92, 0x2e, 0x2a, 0x14, 0x26, 0x10, 0x56, 0xe8, 0x01, 0xa0, 0xb8, 0x31,
-	0x2a, 0x31, 0x67, 0x1c, 0x8f, 0x83, 0x12, 0xcb, 0x0f, 0x74, 0x05, 0xd2, 0x36, 0x51, 0x61, 0x95,
-	0x3a, 0x10, 0xcb, 0x41, 0x98, 0xcd, 0x36, 0x54, 0x43, 0x4f, 0xc1, 0xa9, 0xd9, 0x86, 0xd4, 0xca,
-	0xc4, 0x53, 0x36, 0x31, 0xdf, 0x89, 0x85, 0x14, 0x4e, 0x7e, 0xc6, 0x20, 0xb4, 0x03, 0xd9, 0x55,
-	0xf5, 0x51, 0x80, 0xd9, 0x02, 0x88, 0xd6, 0x29, 0xe2, 0x1b, 0xab, 0xf8, 0x0f, 0xa1, 0x72, 0xa4,
-	0xab, 0x7a, 0xce, 0xc8, 0x51, 0x1d, 0xd6, 0x31, 0x21, 0x1e, 0xf5, 0x7d, 0x79, 0x5e, 0x0b, 0x56,
-	0xf0, 0x69, 0x9a, 0x50, 0x8d, 0x9c, 0xe9, 0x94, 0xca, 0x90, 0x62, 0xa7, 0xd2, 0x5b, 0xde, 0x4a,
-	0xb1, 0x53, 0xf3, 0x2d, 0xa8, 0x3d, 0x62, 0xec, 0x74, 0xea, 0xc6, 0xa7, 0x2c, 0x87, 0x53, 0x16,
-	0xce, 0x98, 0xe2, 0x39, 0xa0, 0xf8, 0xf0, 0xb0, 0x6e, 0x19, 0x91, 0x8e, 0xf4, 0x90, 0x4c, 0x53,
-	0xda, 0xd1, 0x3f, 0x21, 0x33, 0xa1, 0x1c, 0x87, 0xf7, 0x4b, 0x88, 0xbf, 0x4d, 0x39, 0x26, 0x98,
-	0x63, 0x4b, 0xe2, 0xe6, 0xfb, 0x50, 0x91, 0x89, 0x3a, 0x43, 0x76, 0xde, 0x6a, 0xec, 0x26, 0x43,
-	0x2d, 0xb6, 0x6b, 0x91, 0xf7, 0x7d, 0x05, 0x44, 0xd1, 0x7f, 0x63, 0x40, 0x35, 0x9a, 0x40, 0x07,
-	0x6f, 0x42, 0x86, 0xcf, 0x5c, 0x15, 0x7c, 0xb9, 0x5d, 0x8e, 0x86, 0x3f, 0x9b, 0xb9, 0xd4, 0x92,
-	0x18, 0x6a, 0x41, 0x9e, 0xb9, 0xd4, 0xc3, 0x9c, 0x79, 0x8b, 0x49, 0x3c, 0xd1, 0x88, 0x15, 0x72,
-	0x04, 0x7f, 0x80, 0x5d, 0x3c, 0xb0, 0xf9, 0x4c, 0x5e, 0x8e, 0x09, 0xfe, 0x81, 0x46, 0xac, 0x90,
-	0x23, 0xb2, 0x78, 0x49, 0x3d, 0xdf, 0x66, 0x8e, 0xbc, 0x22, 0x13, 0x59, 0xbc, 0xab, 0x00, 0x2b,
-	0x60, 0x98, 0x13, 0xa8, 0xdc, 0xb3, 0x1d, 0xf2, 0x98, 0x62, 0xef, 0xbc, 0x55, 0xfa, 0x3b, 0x64,
-	0x7d, 0x8e, 0x3d, 0xd5, 0x2c, 0x16, 0x29, 0x0a, 0x8c, 0x3a, 0x4d, 0x5a, 0x9d, 0x3d, 0xf9, 0x61,
-	0xde, 0x86, 0x6a, 0x34, 0x9d, 0xae, 0xd9, 0xd9, 0x07, 0x01, 0x41, 0xf5, 0x70, 0x3a, 0x71, 0x13,
-	0x77, 0xe2, 0xff, 0xa0, 0x16, 0xb3, 0xcd, 0xbb, 0x5a, 0x79, 0x46, 0xca, 0x50, 0x3a, 0xe6, 0x38,
-	0xba, 0x38, 0x7e, 0x36, 0x60, 0x53, 0x18, 0x8e, 0xa7, 0x93, 0x09, 0xf6, 0x66, 0xa1, 0xa7, 0xab,
-	0x00, 0x53, 0x9f, 0x92, 0x9e, 0xef, 0xe2, 0x01, 0xd5, 0xf7, 0x47, 0x41, 0x58, 0x8e, 0x85, 0x01,
-	0x5d, 0x83, 0x0a, 0x7e, 0x89, 0xed, 0xb1, 0xb8, 0xf0, 0x35, 0x27, 0x25, 0x39, 0xe5, 0xd0, 0xac,
-	0x88, 0x7f, 0x85, 0x92, 0xf4, 0x63, 0x3b, 0x23, 0xb9, 0xaf, 0x54, 0x35, 0x8a, 0xc2, 0xd6, 0x55,
-	0x26, 0xd1, 0xff, 0x24, 0x85, 0x2a, 0x86, 0x6a, 0x6b, 0x72, 0xf6, 0xbb, 0x8a, 0xf0, 0x0f, 0x28,
-	0x4b, 0x42, 0x1f, 0x3b, 0xe4, 0x43, 0x9b, 0xf0, 0x13, 0xdd, 0xc9, 0x36, 0x84, 0xb5, 0x13, 0x18,
-	0xd1, 0x1e, 0x6c, 0x46, 0x31, 0x45, 0xdc, 0x9c, 0xea, 0x7a, 0x21, 0x14, 0x0e, 0x90, 0x65, 0xc5,
-	0xfe, 0x49, 0x9f, 0x61, 0x8f, 0x04, 0xf5, 0x78, 0x9d, 0x81, 0x5a, 0xcc, 0xa8, 0xab, 0x71, 0x0d,
-	0xd6, 0x45, 0xf9, 0x56, 0x5f, 0xff, 0x39, 0x01, 0x77, 0x09, 0xba, 0x01, 0x55, 0x49, 0x1c, 0x30,
-	0xc7, 0xa1, 0x03, 0xa1, 0x5d, 0x7c, 0x5d, 0x98, 0x8a, 0xb0, 0x1f, 0x44, 0x66, 0xb4, 0x0b, 0xb5,
-	0x3e, 0x63, 0xdc, 0xe7, 0x1e, 0x76, 0x7b, 0xc1, 0xb1, 0x4b, 0xcb, 0x1b, 0xa2, 0x1a, 0x02, 0xfa,
-	0xd4, 0x09, 0xbf, 0x52, 0x3b, 0x38, 0x78, 0x1c, 0x72, 0x33, 0x92, 0x5b, 0x09, 0xec, 0x31, 0x2a,
-	0x7d, 0x35, 0x47, 0xcd, 0x2a, 0x6a, 0x60, 0x0f, 0xa8, 0xbb, 0x50, 0x23, 0x41, 0xae, 0x21, 0x37,
-	0xa7, 0x42, 0x08, 0x81, 0x80, 0x7c, 0x5b, 0x6e, 0x7b, 0xee, 0xd7, 0xd7, 0xe5, 0xa1, 0xda, 0x8e,
-	0x35, 0xd4, 0x25, 0x1b, 0xc8, 0x52, 0x64, 0xf4, 0x1f, 0xc8, 0x4d, 0x5d, 0xa1, 0xd3, 0xea, 0x79,
-	0x39, 0xec, 0x2f, 0x2d, 0x25, 0xe2, 0x5a, 0x81, 0x88, 0x6b, 0x1d, 0x6a, 0x91, 0x67, 0x69, 0x22,
-	0xba, 0x0b, 0x45, 0x29, 0x77, 0x5c, 0xdb, 0x19, 0x51, 0x52, 0x2f, 0xc8, 0x71, 0x8d, 0x85, 0x71,
-	0xcf, 0x02, 0xf1, 0xd7, 0xc9, 0x8b, 0xc5, 0x78, 0xfd, 0x7d, 0xd3, 0xb0, 0x40, 0x0c, 0x3c, 0x92,
-	0xe3, 0xd0, 0x7d, 0x28, 0x49, 0x37, 0x2f, 0xa6, 0xd4, 0xb3, 0x29, 0xa9, 0xc3, 0x6f, 0xf0, 0x23,
-	0x03, 0x78, 0xaa, 0x06, 0x9a, 0x9f, 0x1b, 0xb0, 0xa5, 0x45, 0xcd, 0x03, 0x8a, 0xc7, 0xfc, 0x24,
-	0xb8, 0x28, 0x2e, 0x42, 0x4e, 0x75, 0x7d, 0xad, 0x04, 0xf5, 0x97, 0xd8, 0xaf, 0xd4, 0x19, 0x78,
-	0x33, 0x97, 0x53, 0xd2, 0x93, 0x4a, 0x51, 0xde, 0x14, 0xd6, 0x46, 0x68, 0x3d, 0x12, 0x92, 0xf1,
-	0x6f, 0x10, 0x08, 0xc1, 0x9e, 0xed, 0x10, 0xfa, 0x4a, 0x9f, 0x8d, 0x92, 0x36, 0x76, 0x85, 0x4d,
-	0x9c, 0x43, 0xd7, 0x63, 0x1f, 0xd0, 0x81, 0xd4, 0x1e, 0x19, 0xe9, 0xa7, 0xa0, 0x2d, 0x5d, 0x62,
-	0x7e, 0x65, 0xc0, 0x46, 0x22, 0x36, 0xb4, 0x0b, 0xc5, 0x13, 0xf9, 0x6b, 0xd6, 0x13, 0x5d, 0xde,
-	0x58, 0xe8, 0xf2, 0xa0, 0xe1, 0x2e, 0xf1, 0x85, 0x56, 0x99, 0x3a, 0x71, 0xfa, 0xa2, 0x28, 0x28,
-	0x85, 0x04, 0x31, 0x60, 0x17, 0x8a, 0x6c, 0x38, 0x1c, 0xdb, 0x0e, 0x95, 0xf4, 0xf4, 0xa2, 0x77,
-	0x0d, 0x0b, 0x72, 0x1d, 0xd6, 0x75, 0x2e, 0x3a, 0xf0, 0xe0, 0xd3, 0xfc, 0xc8, 0x80, 0x0b, 0x73,
-	0x25, 0xd5, 0x27, 0xed, 0x16, 0xe4, 0xd4, 0x74, 0xba, 0xff, 0xd5, 0xe3, 0xdb, 0x2c, 0x31, 0x42,
-	0xf3, 0xd0, 0xff, 0x01, 0x3c, 0x4a, 0xa6, 0x0e, 0xc1, 0xce, 0x60, 0xa6, 0x1b, 0xca, 0xe5, 0x98,
-	0xea, 0xb6, 0x42, 0xf0, 0x78, 0x70, 0x42, 0x27, 0xd4, 0x8a, 0xd1, 0xcd, 0x1f, 0x0d, 0xd8, 0x7c,
-	0xd2, 0x17, 0xc5, 0x4c, 0x2e, 0xed, 0xe2, 0x12, 0x1a, 0xcb, 0x96, 0x30, 0xda, 0x01, 0xa9, 0xc4,
-	0x0e, 0x48, 0xae, 0x5a, 0x7a, 0x6e, 0xd5, 0x84, 0xa0, 0x97, 0x4d, 0xa2, 0x87, 0x87, 0x9c, 0x7a,
-	0xbd, 0x78, 0x91, 0xd2, 0x56, 0x4d, 0x42, 0xfb, 0x02, 0x09, 0x1e, 0x1c, 0xff, 0x02, 0x44, 0x1d,
-	0xd2, 0xeb, 0xd3, 0x21, 0xf3, 0x68, 0x48, 0x57, 0x97, 0x60, 0x95, 0x3a, 0xa4, 0x23, 0x81, 0x80,
-	0x1d, 0x76, 0x9e, 0x5c, 0xec, 0x8d, 0x63, 0x7e, 0x62, 0xc0, 0x56, 0x32, 0x53, 0x5d, 0xf1, 0xdb,
-	0x0b, 0xc2, 0x7e, 0x75, 0xcd, 0x43, 0xe6, 0xef, 0xaa, 0x7a, 0xfb, 0xd3, 0x0c, 0x94, 0x1e, 0x62,
-	0xd2, 0x0d, 0x66, 0x41, 0x5d, 0x80, 0x48, 0xf5, 0xa3, 0x2b, 0xb1, 0xf9, 0x17, 0x1e, 0x03, 0x8d,
-	0xab, 0x2b, 0x50, 0x9d, 0xce, 0x01, 0xe4, 0x03, 0xdd, 0x86, 0x1a, 0x31, 0xea, 0x9c, 0x32, 0x6c,
-	0x5c, 0x5e, 0x8a, 0x69, 0x27, 0x5d, 0x80, 0x48, 0x99, 0x25, 0xe2, 0x59, 0xd0, 0x7b, 0x89, 0x78,
-	0x96, 0xc8, 0xb9, 0x03, 0xc8, 0x07, 0x2a, 0x29, 0x11, 0xcf, 0x9c, 0x36, 0x4b, 0xc4, 0xb3, 0x20,
-	0xab, 0x0e, 0x20, 0x1f, 0xc8, 0x86, 0x84, 0x93, 0x39, 0xe9, 0x92, 0x70, 0xb2, 0xa0, 0x33, 0xee,
-	0x41, 0x21, 0x54, 0x0c, 0x28, 0xce, 0x9c, 0xd7, 0x16, 0x8d, 0x2b, 0xcb, 0x41, 0xed, 0xc7, 0x82,
-	0x8d, 0xc4, 0x0b, 0x0a, 0x35, 0x57, 0xbf, 0xad, 0x94, 0xbf, 0x9d, 0xb3, 0x1e, 0x5f, 0xed, 0x2f,
-	0x0d, 0xa8, 0x3e, 0x79, 0x49, 0xbd, 0x31, 0x9e, 0xfd, 0x29, 0xbb, 0xe2, 0x0f, 0xca, 0xbd, 0xfd,
-	0x85, 0x01, 0x9b, 0xf2, 0x55, 0x7e, 0xcc, 0x99, 0x47, 0xa3, 0x50, 0x3b, 0x90, 0x95, 0xb2, 0x0a,
-	0x5d, 0x9a, 0x6b, 0x8b, 0xa1, 0xdf, 0x33, 0xfa, 0xa5, 0xb9, 0x86, 0x1e, 0x40, 0x21, 0x54, 0x1e,
-	0xc9, 0x18, 0xe7, 0x44, 0x4a, 0x32, 0xc6, 0x79, 0xb1, 0x62, 0xae, 0xb5, 0x3f, 0x36, 0x60, 0x2b,
-	0xf6, 0x22, 0x8f, 0xc2, 0x74, 0xe1, 0xd2, 0x8a, 0x77, 0x3e, 0xba, 0x11, 0xdf, 0xc6, 0xbf, 0xfa,
-	0x27, 0x4a, 0xe3, 0xe6, 0x79, 0xa8, 0xba, 0x60, 0x5f, 0x1b, 0x50, 0x51, 0x97, 0x47, 0x14, 0xc5,
-	0x53, 0x28, 0xc5, 0x6f, 0x22, 0x14, 0x2f, 0xcd, 0x92, 0xcb, 0xb8, 0xd1, 0x5c, 0x89, 0x87, 0xb5,
-	0x7b, 0x36, 0xdf, 0x06, 0x9b, 0x2b, 0xef, 0xb0, 0x25, 0x7b, 0x72, 0x69, 0x2b, 0x32, 0xd7, 0x3a,
-	0x99, 0xf7, 0x52, 0x6e, 0xbf, 0x9f, 0x93, 0x52, 0xe1, 0xbf, 0xbf, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x3c, 0xf6, 0x3f, 0xd4, 0xe4, 0x12, 0x00, 0x00,
+	// 1828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x93, 0x1b, 0x47,
+	0x15, 0xf7, 0x48, 0x5a, 0xed, 0xea, 0x49, 0xab, 0x3f, 0xbd, 0x1b, 0x67, 0xac, 0xb5, 0xad, 0xcd,
+	0x38, 0x60, 0x27, 0x0b, 0x72, 0xa2, 0x98, 0x43, 0x8a, 0xe2, 0x60, 0xd9, 0x8e, 0xad, 0x4a, 0x62,
+	0x6f, 0x66, 0x0d, 0x07, 0x2a, 0x85, 0x68, 0x4d, 0xb7, 0xb4, 0x83, 0xa5, 0xe9, 0xc9, 0x4c, 0x8f,
+	0xb1, 0x3e, 0x00, 0x14, 0x9c, 0xe0, 0xc2, 0x81, 0x0f, 0xc0, 0x37, 0xe0, 0xc4, 0x95, 0xa2, 0x8a,
+	0x3b, 0x37, 0x0e, 0xe1, 0x06, 0x77, 0x6e, 0xdc, 0xa8, 0xfe, 0x33, 0x3d, 0x33, 0xfa, 0xe3, 0x5d,
+	0x17, 0xe4, 0xa6, 0x7e, 0xbf, 0x5f, 0xbf, 0x79, 0xef, 0x75, 0xf7, 0xeb, 0x5f, 0x0b, 0x5a, 0x7e,
+	0x10, 0x87, 0xd4, 0xe3, 0x2c, 0xea, 0x87, 0x11, 0xe3, 0x0c, 0xd5, 0x8c, 0xa1, 0x0b, 0x33, 0x36,
+	0x63, 0xca, 0xdc, 0x85, 0x80, 0x11, 0xaa, 0x7f, 0xb7, 0x42, 0xe6, 0x07, 0x9c, 0x46, 0x64, 0xa2,
+	0x0d, 0x37, 0x67, 0x8c, 0xcd, 0xe6, 0xf4, 0xae, 0x1c, 0x4d, 0x92, 0xe9, 0x5d, 0x92, 0x44, 0x98,
+	0xfb, 0x2c, 0xd0, 0x78, 0x6f, 0x15, 0xe7, 0xfe, 0x82, 0xc6, 0x1c, 0x2f, 0x42, 0x45, 0x70, 0x9e,
+	0xc2, 0xcd, 0xcf, 0xfc, 0x98, 0x8f, 0xa2, 0x88, 0x86, 0x38, 0xc2, 0x93, 0x39, 0x3d, 0xa3, 0xb3,
+	0x05, 0x0d, 0x78, 0xec, 0xd2, 0xaf, 0x12, 0x1a, 0x73, 0x74, 0x08, 0x3b, 0x73, 0x7f, 0xe1, 0x73,
+	0xdb, 0x3a, 0xb6, 0xee, 0xec, 0xb8, 0x6a, 0x80, 0xae, 0x42, 0x95, 0x4d, 0xa7, 0x31, 0xe5, 0x76,
+	0x49, 0x9a, 0xf5, 0xc8, 0xf9, 0xa7, 0x05, 0x68, 0xdd, 0x19, 0x42, 0x50, 0x09, 0x31, 0x3f, 0x97,
+	0x3e, 0x1a, 0xae, 0xfc, 0x8d, 0x3e, 0x86, 0x66, 0xac, 0xe0, 0x31, 0xa1, 0x1c, 0xfb, 0x73, 0xe9,
+	0xaa, 0x3e, 0x40, 0xfd, 0x2c, 0xcb, 0x53, 0xf5, 0xcb, 0xdd, 0xd7, 0xcc, 0x87, 0x92, 0x88, 0x7a,
+	0x50, 0x9f, 0xb3, 0x98, 0x8f, 0x43, 0x9f, 0x7a, 0x34, 0xb6, 0xcb, 0x32, 0x04, 0x10, 0xa6, 0x53,
+	0x69, 0x41, 0x7d, 0x38, 0x98, 0xe3, 0x98, 0x8f, 0x45, 0x20, 0x7e, 0x34, 0xc6, 0x9c, 0xd3, 0x45,
+	0xc8, 0xed, 0xca, 0xb1, 0x75, 0xa7, 0xec, 0x76, 0x04, 0xe4, 0x4a, 0xe4, 0xbe, 0x02, 0xd0, 0x07,
+	0x70, 0x58, 0xa4, 0x8e, 0x3d, 0x96, 0x04, 0xdc, 0xde, 0x91, 0x13, 0x50, 0x94, 0x27, 0x3f, 0x10,
+	0x88, 0xf3, 0x25, 0xf4, 0xb6, 0x16, 0x2e, 0x0e, 0x59, 0x10, 0x53, 0xf4, 0x31, 0xec, 0xe9, 0xb0,
+	0x63, 0xdb, 0x3a, 0x2e, 0xdf, 0xa9, 0x0f, 0x6e, 0xf4, 0xb3, 0x45, 0x5f, 0x9f, 0xe9, 0x1a, 0xba,
+	0xf3, 0x3e, 0x20, 0xf9, 0x99, 0xa7, 0x8c, 0xd0, 0xcc, 0xe1, 0x21, 0xec, 0xa8, 0xb0, 0x2c, 0x19,
+	0x96, 0x1a, 0x38, 0x07, 0xd0, 0xc9, 0x73, 0xe5, 0xaa, 0x39, 0x57, 0xe1, 0xf0, 0x31, 0xe5, 0xc3,
+	0xc4, 0x7b, 0x41, 0xb9, 0x88, 0x33, 0xb5, 0xff, 0xdb, 0x82, 0xb7, 0x56, 0x00, 0xed, 0xfc, 0x3e,
+	0xec, 0x4e, 0xa4, 0x35, 0x0d, 0xf6, 0x76, 0x2e, 0xd8, 0x8d, 0x53, 0xfa, 0xca, 0xe4, 0xa6, 0xf3,
+	0xba, 0xbf, 0xb3, 0xa0, 0xaa, 0x6c, 0xe8, 0x04, 0x6a, 0xca, 0x3a, 0xf6, 0x89, 0x5a, 0xf5, 0x61,
+	0xf3, 0xaf, 0x5f, 0xf7, 0xae, 0xfc, 0xfd, 0xeb, 0x5e, 0x55, 0x04, 0x3a, 0x7a, 0xe8, 0xee, 0x29,
+	0xc2, 0x88, 0xa0, 0xbb, 0xb0, 0x1f, 0xb1, 0x84, 0xfb, 0xc1, 0x6c, 0x2c, 0x36, 0x7b, 0x6c, 0x97,
+	0x64, 0x00, 0xd0, 0x17, 0xa3, 0xbe, 0xa0, 0xbb, 0x0d, 0x4d, 0x10, 0x83, 0x18, 0x7d, 0x17, 0x1a,
+	0x1e, 0xf6, 0xce, 0x29, 0xd1, 0xfc, 0xf2, 0x1a, 0xbf, 0xae, 0x70, 0x49, 0x17, 0x15, 0x32, 0x09,
+	0x98, 0x0a, 0x3d, 0x01, 0x94, 0x37, 0x66, 0x25, 0xe6, 0x8c, 0xe3, 0x79, 0x5a, 0x62, 0x39, 0x40,
+	0xd7, 0xa1, 0xec, 0x13, 0x15, 0x56, 0x63, 0x08, 0xb9, 0x1c, 0x84, 0xd9, 0x19, 0x40, 0xdb, 0x78,
+	0x4a, 0x4f, 0xcd, 0x4d, 0x28, 0x6d, 0x4d, 0xbc, 0xe4, 0x13, 0xe7, 0x87, 0xb9, 0x90, 0xcc, 0xc7,
+	0x2f, 0x98, 0x84, 0x8e, 0x61, 0x67, 0x5b, 0x7d, 0x14, 0xe0, 0xf4, 0x01, 0xb2, 0x75, 0xca, 0xf8,
+	0xd6, 0x36, 0xfe, 0xa7, 0xd0, 0x3a, 0xd5, 0x55, 0xbd, 0x64, 0xe4, 0xc8, 0x86, 0x5d, 0x4c, 0x48,
+	0x44, 0xe3, 0x58, 0x9e, 0xd7, 0x9a, 0x9b, 0x0e, 0x1d, 0x07, 0xda, 0x99, 0x33, 0x9d, 0x52, 0x13,
+	0x4a, 0xec, 0x85, 0xf4, 0xb6, 0xe7, 0x96, 0xd8, 0x0b, 0xe7, 0x07, 0xd0, 0xf9, 0x8c, 0xb1, 0x17,
+	0x49, 0x98, 0xff, 0x64, 0xd3, 0x7c, 0xb2, 0x76, 0xc1, 0x27, 0xbe, 0x04, 0x94, 0x9f, 0x6e, 0xea,
+	0x56, 0x11, 0xe9, 0x48, 0x0f, 0xc5, 0x34, 0xa5, 0x1d, 0x7d, 0x1b, 0x2a, 0x0b, 0xca, 0xb1, 0xe9,
+	0x2f, 0x06, 0xff, 0x9c, 0x72, 0x4c, 0x30, 0xc7, 0xae, 0xc4, 0x9d, 0x9f, 0x40, 0x4b, 0x26, 0x1a,
+	0x4c, 0xd9, 0x65, 0xab, 0x71, 0x52, 0x0c, 0xb5, 0x3e, 0xe8, 0x64, 0xde, 0xef, 0x2b, 0x20, 0x8b,
+	0xfe, 0xcf, 0x16, 0xb4, 0xb3, 0x0f, 0xe8, 0xe0, 0x1d, 0xa8, 0xf0, 0x65, 0xa8, 0x82, 0x6f, 0x0e,
+	0x9a, 0xd9, 0xf4, 0xe7, 0xcb, 0x90, 0xba, 0x12, 0x43, 0x7d, 0xd8, 0x63, 0x21, 0x8d, 0x30, 0x67,
+	0xd1, 0x7a, 0x12, 0xcf, 0x34, 0xe2, 0x1a, 0x8e, 0xe0, 0x7b, 0x38, 0xc4, 0x9e, 0xcf, 0x97, 0x76,
+	0x79, 0x95, 0xff, 0x40, 0x23, 0xae, 0xe1, 0x88, 0x2c, 0x5e, 0xd2, 0x28, 0xf6, 0x59, 0x60, 0x57,
+	0x56, 0xb3, 0xf8, 0x91, 0x02, 0xdc, 0x94, 0xe1, 0x2c, 0xa0, 0xf5, 0x89, 0x1f, 0x90, 0xa7, 0x14,
+	0x47, 0x97, 0xad, 0xd2, 0xbb, 0xb0, 0x13, 0x73, 0x1c, 0xa9, 0xcb, 0x62, 0x9d, 0xa2, 0xc0, 0xec,
+	0xa6, 0x29, 0xab, 0xb3, 0x27, 0x07, 0xce, 0x3d, 0x68, 0x67, 0x9f, 0xd3, 0x35, 0xbb, 0xf8, 0x20,
+	0x20, 0x68, 0x3f, 0x4c, 0x16, 0x61, 0xa1, 0x27, 0x7e, 0x0f, 0x3a, 0x39, 0xdb, 0xaa, 0xab, 0xad,
+	0x67, 0xa4, 0x09, 0x8d, 0x33, 0x8e, 0xb3, 0xc6, 0xf1, 0x1f, 0x0b, 0x0e, 0x84, 0xe1, 0x2c, 0x59,
+	0x2c, 0x70, 0xb4, 0x34, 0x9e, 0x6e, 0x00, 0x24, 0x31, 0x25, 0xe3, 0x38, 0xc4, 0x1e, 0xd5, 0xfd,
+	0xa3, 0x26, 0x2c, 0x67, 0xc2, 0x80, 0x6e, 0x43, 0x0b, 0xbf, 0xc4, 0xfe, 0x5c, 0x34, 0x7c, 0xcd,
+	0x29, 0x49, 0x4e, 0xd3, 0x98, 0x15, 0xf1, 0x1d, 0x68, 0x48, 0x3f, 0x7e, 0x30, 0x93, 0xfb, 0x4a,
+	0x55, 0xa3, 0x2e, 0x6c, 0x23, 0x65, 0x12, 0xf7, 0x9f, 0xa4, 0x50, 0xc5, 0x50, 0xd7, 0x9a, 0xfc,
+	0xfa, 0x23, 0x45, 0xf8, 0x16, 0x34, 0x25, 0x61, 0x82, 0x03, 0xf2, 0x73, 0x9f, 0xf0, 0x73, 0x7d,
+	0x93, 0xed, 0x0b, 0xeb, 0x30, 0x35, 0xa2, 0xbb, 0x70, 0x90, 0xc5, 0x94, 0x71, 0xab, 0xea, 0xd6,
+	0x33, 0x90, 0x99, 0x20, 0xcb, 0x8a, 0xe3, 0xf3, 0x09, 0xc3, 0x11, 0x49, 0xeb, 0xf1, 0x97, 0x0a,
+	0x74, 0x72, 0x46, 0x5d, 0x8d, 0xdb, 0xb0, 0x2b, 0xca, 0xb7, 0xbd, 0xfd, 0x57, 0x05, 0x3c, 0x22,
+	0xe8, 0x3d, 0x68, 0x4b, 0xa2, 0xc7, 0x82, 0x80, 0x7a, 0x42, 0xbb, 0xc4, 0xba, 0x30, 0x2d, 0x61,
+	0x7f, 0x90, 0x99, 0xd1, 0x09, 0x74, 0x26, 0x8c, 0xf1, 0x98, 0x47, 0x38, 0x1c, 0xa7, 0xc7, 0xae,
+	0x2c, 0x3b, 0x44, 0xdb, 0x00, 0xfa, 0xd4, 0x09, 0xbf, 0x52, 0x3b, 0x04, 0x78, 0x6e, 0xb8, 0x15,
+	0xc9, 0x6d, 0xa5, 0xf6, 0x1c, 0x95, 0xbe, 0x5a, 0xa1, 0xee, 0x28, 0x2a, 0x7d, 0x55, 0xa4, 0x9e,
+	0x40, 0x87, 0xa4, 0xb9, 0x1a, 0x6e, 0x55, 0x85, 0x60, 0x80, 0x94, 0x7c, 0x4f, 0x6e, 0x7b, 0x1e,
+	0xdb, 0xbb, 0xf2, 0x50, 0xdd, 0xcc, 0x5d, 0xa8, 0x1b, 0x36, 0x90, 0xab, 0xc8, 0xe8, 0x43, 0xa8,
+	0x26, 0xa1, 0xd0, 0x69, 0xf6, 0x9e, 0x9c, 0x76, 0xad, 0xaf, 0x44, 0x5c, 0x3f, 0x15, 0x71, 0xfd,
+	0x87, 0x5a, 0xe4, 0xb9, 0x9a, 0x88, 0x1e, 0x41, 0x5d, 0xca, 0x9d, 0xd0, 0x0f, 0x66, 0x94, 0xd8,
+	0x35, 0x39, 0xaf, 0xbb, 0x36, 0xef, 0x79, 0x2a, 0xfe, 0x86, 0x7b, 0x62, 0x31, 0x7e, 0xfb, 0x8f,
+	0x9e, 0xe5, 0x82, 0x98, 0x78, 0x2a, 0xe7, 0xa1, 0xc7, 0xd0, 0x90, 0x6e, 0xbe, 0x4a, 0x68, 0xe4,
+	0x53, 0x62, 0xc3, 0x1b, 0xf8, 0x91, 0x01, 0x7c, 0xa1, 0x26, 0xa2, 0x8f, 0x60, 0x37, 0xc4, 0x4b,
+	0x96, 0xf0, 0xd8, 0xae, 0xcb, 0x63, 0x75, 0x2d, 0x97, 0xfa, 0xa9, 0x44, 0x1e, 0xc5, 0xdc, 0x5f,
+	0x60, 0x4e, 0xdd, 0x94, 0xe9, 0xfc, 0xcd, 0x82, 0x66, 0x11, 0x43, 0x1f, 0x42, 0x23, 0xc6, 0x9c,
+	0xce, 0xe7, 0x3e, 0x7f, 0xcd, 0x4e, 0xaa, 0x1b, 0xce, 0x88, 0x08, 0x61, 0xaa, 0x4f, 0x85, 0xd8,
+	0x44, 0x96, 0xab, 0x47, 0xe8, 0x16, 0xec, 0x6b, 0x85, 0x47, 0xb3, 0x63, 0x65, 0xb9, 0x0d, 0x65,
+	0xd4, 0xc7, 0xe6, 0x1d, 0x68, 0xe0, 0x84, 0xf8, 0x3c, 0x7f, 0xb0, 0x2c, 0xb7, 0x2e, 0x6d, 0x9a,
+	0x62, 0xc3, 0x6e, 0xcc, 0x59, 0x84, 0x67, 0x54, 0x6e, 0x11, 0xcb, 0x4d, 0x87, 0x99, 0x74, 0xa8,
+	0x4a, 0xbb, 0x1a, 0x38, 0xbf, 0xb7, 0xe0, 0x50, 0xeb, 0xbb, 0x27, 0x14, 0xcf, 0xf9, 0x79, 0xda,
+	0x33, 0xaf, 0x42, 0x55, 0x09, 0x20, 0x2d, 0x8a, 0xf5, 0x48, 0x1c, 0x5d, 0x1a, 0x78, 0xd1, 0x32,
+	0xe4, 0x94, 0x8c, 0xa5, 0x68, 0x96, 0x4d, 0xd3, 0xdd, 0x37, 0xd6, 0x53, 0xa1, 0x9e, 0x6f, 0x41,
+	0xaa, 0x89, 0xc7, 0x7e, 0x40, 0xe8, 0x2b, 0xdd, 0x26, 0x1a, 0xda, 0x38, 0x12, 0x36, 0xd1, 0x92,
+	0xc2, 0x88, 0xfd, 0x8c, 0x7a, 0x52, 0x86, 0x55, 0xa4, 0x9f, 0x9a, 0xb6, 0x8c, 0x88, 0xf3, 0x47,
+	0x0b, 0xf6, 0x0b, 0xb1, 0xa1, 0x13, 0xa8, 0x9f, 0xcb, 0x5f, 0xcb, 0xb1, 0x4f, 0x54, 0x4f, 0x2c,
+	0x0a, 0x1e, 0xd0, 0xf0, 0x88, 0xc4, 0x42, 0xb6, 0x25, 0x41, 0x9e, 0xbe, 0xae, 0x8f, 0x1a, 0x49,
+	0x90, 0x9b, 0x70, 0x02, 0x75, 0x36, 0x9d, 0xce, 0xfd, 0x80, 0x4a, 0x7a, 0x79, 0xdd, 0xbb, 0x86,
+	0x47, 0x44, 0x15, 0x5a, 0xc5, 0xa6, 0x03, 0x4f, 0x87, 0xce, 0x2f, 0x2d, 0x78, 0x6b, 0xa5, 0xa4,
+	0xba, 0xe9, 0x7c, 0x00, 0x55, 0xf5, 0x39, 0x2d, 0x05, 0xec, 0xfc, 0x89, 0x2b, 0xcc, 0xd0, 0x3c,
+	0xf4, 0x7d, 0x80, 0x88, 0x92, 0x24, 0x20, 0x38, 0xf0, 0x96, 0xfa, 0x6e, 0x3d, 0xca, 0x3d, 0x40,
+	0x5c, 0x03, 0x9e, 0x79, 0xe7, 0x74, 0x41, 0xdd, 0x1c, 0xdd, 0xf9, 0x97, 0x05, 0x07, 0xcf, 0x26,
+	0xa2, 0x98, 0xc5, 0xa5, 0x5d, 0x5f, 0x42, 0x6b, 0xd3, 0x12, 0x66, 0x3b, 0xa0, 0x54, 0xd8, 0x01,
+	0xc5, 0x55, 0x2b, 0xaf, 0xac, 0x9a, 0x78, 0xdb, 0xc8, 0xfb, 0x72, 0x8c, 0xa7, 0x9c, 0x46, 0xe3,
+	0x7c, 0x91, 0xca, 0x6e, 0x47, 0x42, 0xf7, 0x05, 0x92, 0xbe, 0xbd, 0xbe, 0x03, 0x88, 0x06, 0x64,
+	0x3c, 0xa1, 0x53, 0x16, 0x51, 0x43, 0x57, 0xf7, 0x41, 0x9b, 0x06, 0x64, 0x28, 0x81, 0x94, 0x6d,
+	0x2e, 0xe1, 0x6a, 0xee, 0xb9, 0xe7, 0xfc, 0xda, 0x82, 0xc3, 0x62, 0xa6, 0xba, 0xe2, 0xf7, 0xd6,
+	0xde, 0x38, 0xdb, 0x6b, 0x6e, 0x98, 0xff, 0x5b, 0xd5, 0x3f, 0x07, 0xfb, 0x4c, 0x24, 0xf9, 0x38,
+	0xc2, 0x1e, 0x9d, 0x26, 0xf3, 0x47, 0xaf, 0x7c, 0x23, 0xbb, 0xdf, 0xbc, 0x61, 0x38, 0x47, 0x70,
+	0x6d, 0x83, 0x3b, 0x95, 0xde, 0xe0, 0x37, 0x15, 0x68, 0x7c, 0x8a, 0xc9, 0x28, 0xcd, 0x08, 0x8d,
+	0x00, 0xb2, 0xc7, 0x16, 0xba, 0x9e, 0xcb, 0x75, 0xed, 0x0d, 0xd6, 0xbd, 0xb1, 0x05, 0xd5, 0xa5,
+	0x7b, 0x00, 0x7b, 0xa9, 0x5c, 0x46, 0xdd, 0x7c, 0x7f, 0x2c, 0x0a, 0xf2, 0xee, 0xd1, 0x46, 0x4c,
+	0x3b, 0x19, 0x01, 0x64, 0x82, 0xb8, 0x10, 0xcf, 0x9a, 0xcc, 0xee, 0xde, 0xd8, 0x82, 0x66, 0xf1,
+	0xa4, 0xe2, 0xb4, 0x10, 0xcf, 0x8a, 0x24, 0xee, 0x1e, 0x6d, 0xc4, 0x32, 0x27, 0xa9, 0x5a, 0x2b,
+	0x38, 0x59, 0x51, 0x8c, 0xdd, 0xa3, 0x8d, 0x98, 0x76, 0xf2, 0x09, 0xd4, 0x8c, 0x50, 0x43, 0x79,
+	0xe6, 0xaa, 0xa4, 0xeb, 0x5e, 0xdf, 0x0c, 0x6a, 0x3f, 0x2e, 0xec, 0x17, 0x1e, 0xae, 0xa8, 0xb7,
+	0xfd, 0x49, 0xab, 0xfc, 0x1d, 0x5f, 0xf4, 0xe6, 0x1d, 0xfc, 0xc1, 0x82, 0xf6, 0xb3, 0x97, 0x34,
+	0x9a, 0xe3, 0xe5, 0x37, 0xb2, 0x2b, 0xfe, 0x4f, 0xb9, 0x0f, 0x7e, 0x51, 0x82, 0x03, 0xf9, 0x67,
+	0xc8, 0x19, 0x67, 0x11, 0xcd, 0x42, 0x1d, 0xc2, 0x8e, 0x54, 0xb3, 0xe8, 0xed, 0x15, 0x35, 0x62,
+	0xfc, 0x5e, 0x20, 0x53, 0x9c, 0x2b, 0xe8, 0x09, 0xd4, 0x8c, 0xe0, 0x2b, 0xc6, 0xb8, 0xa2, 0x0d,
+	0xbb, 0xd7, 0x37, 0x83, 0xc6, 0xd3, 0x4f, 0xa1, 0xb3, 0x76, 0xf8, 0xd0, 0xad, 0x62, 0x00, 0x1b,
+	0x4f, 0x7a, 0xf7, 0xdd, 0xd7, 0x93, 0xd2, 0x2f, 0x0c, 0x7e, 0x65, 0xc1, 0x61, 0xee, 0xaf, 0x96,
+	0xac, 0x10, 0x21, 0xbc, 0xbd, 0xe5, 0x0f, 0x1c, 0xf4, 0x5e, 0xfe, 0xa0, 0xbc, 0xf6, 0xdf, 0xb1,
+	0xee, 0xfb, 0x97, 0xa1, 0xea, 0x25, 0xf9, 0x93, 0x05, 0x2d, 0xd5, 0x0a, 0xb3, 0x28, 0xbe, 0x80,
+	0x46, 0xbe, 0xaf, 0xa2, 0x7c, 0xf1, 0x37, 0x5c, 0x2d, 0xdd, 0xde, 0x56, 0xdc, 0xd4, 0xf4, 0xf9,
+	0xea, 0xa5, 0xde, 0xdb, 0xda, 0x91, 0x37, 0xec, 0xfa, 0x8d, 0x17, 0xab, 0x73, 0x65, 0x58, 0xf9,
+	0x71, 0x29, 0x9c, 0x4c, 0xaa, 0x52, 0x03, 0x7e, 0xf4, 0xdf, 0x01, 0x00, 0xa7, 0x0f, 0x43, 0xab,
+	0xbd, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2015,6 +2169,8 @@ type PieceStoreInspectorClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
+	// StartGracefulExit starts gracefully exiting a satellite in the background
+	StartGracefulExit(ctx context.Context, in *StartGracefulExitRequest, opts ...grpc.CallOption) (*StartGracefulExitResponse, error)
 }
 
 type pieceStoreInspectorClient struct {
@@ -2043,12 +2199,23 @@ func (c *pieceStoreInspectorClient) Dashboard(ctx context.Context, in *Dashboard
 	return out, nil
 }
 
+func (c *pieceStoreInspectorClient) StartGracefulExit(ctx context.Context, in *StartGracefulExitRequest, opts ...grpc.CallOption) (*StartGracefulExitResponse, error) {
+	out := new(StartGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/StartGracefulExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreInspectorServer is the server API for PieceStoreInspector service.
 type PieceStoreInspectorServer interface {
 	// Stats return space and bandwidth stats for a storagenode
 	Stats(context.Context, *StatsRequest) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
+	// StartGracefulExit starts gracefully exiting a satellite in the background
+	StartGracefulExit(context.Context, *StartGracefulExitRequest) (*StartGracefulExitResponse, error)
 }
 
 func RegisterPieceStoreInspectorServer(s *grpc.Server, srv PieceStoreInspectorServer) {
@@ -2091,6 +2258,24 @@ func _PieceStoreInspector_Dashboard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreInspector_StartGracefulExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGracefulExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).StartGracefulExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/StartGracefulExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).StartGracefulExit(ctx, req.(*StartGracefulExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.PieceStoreInspector",
 	HandlerType: (*PieceStoreInspectorServer)(nil),
@@ -2103,6 +2288,10 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dashboard",
 			Handler:    _PieceStoreInspector_Dashboard_Handler,
 		},
+		{
+			MethodName: "StartGracefulExit",
+			Handler:    _PieceStoreInspector_StartGracefulExit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
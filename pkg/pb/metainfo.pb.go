@@ -26,6 +26,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type BucketVersioning int32
+
+const (
+	BucketVersioning_UNVERSIONED BucketVersioning = 0
+	BucketVersioning_ENABLED     BucketVersioning = 1
+	BucketVersioning_SUSPENDED   BucketVersioning = 2
+)
+
+var BucketVersioning_name = map[int32]string{
+	0: "UNVERSIONED",
+	1: "ENABLED",
+	2: "SUSPENDED",
+}
+
+var BucketVersioning_value = map[string]int32{
+	"UNVERSIONED": 0,
+	"ENABLED":     1,
+	"SUSPENDED":   2,
+}
+
+func (x BucketVersioning) String() string {
+	return proto.EnumName(BucketVersioning_name, int32(x))
+}
+
+func (BucketVersioning) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{0}
+}
+
 type Object_Status int32
 
 const (
@@ -57,7 +85,7 @@ func (x Object_Status) String() string {
 }
 
 func (Object_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{29, 0}
+	return fileDescriptor_631e2f30a93cd64e, []int{39, 0}
 }
 
 type Bucket struct {
@@ -67,9 +95,15 @@ type Bucket struct {
 	DefaultSegmentSize          int64                 `protobuf:"varint,4,opt,name=default_segment_size,json=defaultSegmentSize,proto3" json:"default_segment_size,omitempty"`
 	DefaultRedundancyScheme     *RedundancyScheme     `protobuf:"bytes,5,opt,name=default_redundancy_scheme,json=defaultRedundancyScheme,proto3" json:"default_redundancy_scheme,omitempty"`
 	DefaultEncryptionParameters *EncryptionParameters `protobuf:"bytes,6,opt,name=default_encryption_parameters,json=defaultEncryptionParameters,proto3" json:"default_encryption_parameters,omitempty"`
-	XXX_NoUnkeyedLiteral        struct{}              `json:"-"`
-	XXX_unrecognized            []byte                `json:"-"`
-	XXX_sizecache               int32                 `json:"-"`
+	Versioning                  BucketVersioning      `protobuf:"varint,7,opt,name=versioning,proto3,enum=metainfo.BucketVersioning" json:"versioning,omitempty"`
+	Lifecycle                   []*LifecycleRule      `protobuf:"bytes,8,rep,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	// redundancy policy enforced by the satellite for new segments of this bucket, if any
+	RedundancyPolicy *RedundancyScheme `protobuf:"bytes,9,opt,name=redundancy_policy,json=redundancyPolicy,proto3" json:"redundancy_policy,omitempty"`
+	// placement constraints for the storage nodes of this bucket, if any
+	Placement            *PlacementConstraints `protobuf:"bytes,10,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *Bucket) Reset()         { *m = Bucket{} }
@@ -138,6 +172,201 @@ func (m *Bucket) GetDefaultEncryptionParameters() *EncryptionParameters {
 	return nil
 }
 
+func (m *Bucket) GetVersioning() BucketVersioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return BucketVersioning_UNVERSIONED
+}
+
+func (m *Bucket) GetLifecycle() []*LifecycleRule {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+func (m *Bucket) GetRedundancyPolicy() *RedundancyScheme {
+	if m != nil {
+		return m.RedundancyPolicy
+	}
+	return nil
+}
+
+func (m *Bucket) GetPlacement() *PlacementConstraints {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+// PlacementConstraints restricts the storage nodes selected for a bucket by their location
+type PlacementConstraints struct {
+	// ISO 3166-1 alpha-2 country codes, all countries are allowed if empty
+	CountryCodes         []string `protobuf:"bytes,1,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
+	ExcludedCountryCodes []string `protobuf:"bytes,2,rep,name=excluded_country_codes,json=excludedCountryCodes,proto3" json:"excluded_country_codes,omitempty"`
+	DistinctAsn          bool     `protobuf:"varint,3,opt,name=distinct_asn,json=distinctAsn,proto3" json:"distinct_asn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementConstraints) Reset()         { *m = PlacementConstraints{} }
+func (m *PlacementConstraints) String() string { return proto.CompactTextString(m) }
+func (*PlacementConstraints) ProtoMessage()    {}
+func (*PlacementConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{1}
+}
+func (m *PlacementConstraints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementConstraints.Unmarshal(m, b)
+}
+func (m *PlacementConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementConstraints.Marshal(b, m, deterministic)
+}
+func (m *PlacementConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementConstraints.Merge(m, src)
+}
+func (m *PlacementConstraints) XXX_Size() int {
+	return xxx_messageInfo_PlacementConstraints.Size(m)
+}
+func (m *PlacementConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementConstraints proto.InternalMessageInfo
+
+func (m *PlacementConstraints) GetCountryCodes() []string {
+	if m != nil {
+		return m.CountryCodes
+	}
+	return nil
+}
+
+func (m *PlacementConstraints) GetExcludedCountryCodes() []string {
+	if m != nil {
+		return m.ExcludedCountryCodes
+	}
+	return nil
+}
+
+func (m *PlacementConstraints) GetDistinctAsn() bool {
+	if m != nil {
+		return m.DistinctAsn
+	}
+	return false
+}
+
+// LifecycleRule expires the objects below a prefix of a bucket
+type LifecycleRule struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// encrypted_prefix is matched against whole encrypted path components
+	EncryptedPrefix                []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	Disabled                       bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	ExpireAfterDays                int32    `protobuf:"varint,4,opt,name=expire_after_days,json=expireAfterDays,proto3" json:"expire_after_days,omitempty"`
+	AbortIncompleteUploadAfterDays int32    `protobuf:"varint,5,opt,name=abort_incomplete_upload_after_days,json=abortIncompleteUploadAfterDays,proto3" json:"abort_incomplete_upload_after_days,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
+	XXX_unrecognized               []byte   `json:"-"`
+	XXX_sizecache                  int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{2}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *LifecycleRule) GetExpireAfterDays() int32 {
+	if m != nil {
+		return m.ExpireAfterDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadAfterDays() int32 {
+	if m != nil {
+		return m.AbortIncompleteUploadAfterDays
+	}
+	return 0
+}
+
+// BucketLifecycle is used to store the lifecycle rules of a bucket
+type BucketLifecycle struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{3}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycle.Unmarshal(m, b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycle.Size(m)
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
 type BucketListItem struct {
 	Name                 []byte    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt            time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
@@ -150,7 +379,7 @@ func (m *BucketListItem) Reset()         { *m = BucketListItem{} }
 func (m *BucketListItem) String() string { return proto.CompactTextString(m) }
 func (*BucketListItem) ProtoMessage()    {}
 func (*BucketListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{1}
+	return fileDescriptor_631e2f30a93cd64e, []int{4}
 }
 func (m *BucketListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListItem.Unmarshal(m, b)
@@ -199,7 +428,7 @@ func (m *BucketCreateRequest) Reset()         { *m = BucketCreateRequest{} }
 func (m *BucketCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketCreateRequest) ProtoMessage()    {}
 func (*BucketCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{2}
+	return fileDescriptor_631e2f30a93cd64e, []int{5}
 }
 func (m *BucketCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketCreateRequest.Unmarshal(m, b)
@@ -265,7 +494,7 @@ func (m *BucketCreateResponse) Reset()         { *m = BucketCreateResponse{} }
 func (m *BucketCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketCreateResponse) ProtoMessage()    {}
 func (*BucketCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{3}
+	return fileDescriptor_631e2f30a93cd64e, []int{6}
 }
 func (m *BucketCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketCreateResponse.Unmarshal(m, b)
@@ -303,7 +532,7 @@ func (m *BucketGetRequest) Reset()         { *m = BucketGetRequest{} }
 func (m *BucketGetRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketGetRequest) ProtoMessage()    {}
 func (*BucketGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{4}
+	return fileDescriptor_631e2f30a93cd64e, []int{7}
 }
 func (m *BucketGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketGetRequest.Unmarshal(m, b)
@@ -341,7 +570,7 @@ func (m *BucketGetResponse) Reset()         { *m = BucketGetResponse{} }
 func (m *BucketGetResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketGetResponse) ProtoMessage()    {}
 func (*BucketGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{5}
+	return fileDescriptor_631e2f30a93cd64e, []int{8}
 }
 func (m *BucketGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketGetResponse.Unmarshal(m, b)
@@ -379,7 +608,7 @@ func (m *BucketDeleteRequest) Reset()         { *m = BucketDeleteRequest{} }
 func (m *BucketDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketDeleteRequest) ProtoMessage()    {}
 func (*BucketDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{6}
+	return fileDescriptor_631e2f30a93cd64e, []int{9}
 }
 func (m *BucketDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketDeleteRequest.Unmarshal(m, b)
@@ -416,7 +645,7 @@ func (m *BucketDeleteResponse) Reset()         { *m = BucketDeleteResponse{} }
 func (m *BucketDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketDeleteResponse) ProtoMessage()    {}
 func (*BucketDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{7}
+	return fileDescriptor_631e2f30a93cd64e, []int{10}
 }
 func (m *BucketDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketDeleteResponse.Unmarshal(m, b)
@@ -449,7 +678,7 @@ func (m *BucketListRequest) Reset()         { *m = BucketListRequest{} }
 func (m *BucketListRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketListRequest) ProtoMessage()    {}
 func (*BucketListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{8}
+	return fileDescriptor_631e2f30a93cd64e, []int{11}
 }
 func (m *BucketListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListRequest.Unmarshal(m, b)
@@ -502,7 +731,7 @@ func (m *BucketListResponse) Reset()         { *m = BucketListResponse{} }
 func (m *BucketListResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketListResponse) ProtoMessage()    {}
 func (*BucketListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{9}
+	return fileDescriptor_631e2f30a93cd64e, []int{12}
 }
 func (m *BucketListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListResponse.Unmarshal(m, b)
@@ -548,7 +777,7 @@ func (m *BucketSetAttributionRequest) Reset()         { *m = BucketSetAttributio
 func (m *BucketSetAttributionRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketSetAttributionRequest) ProtoMessage()    {}
 func (*BucketSetAttributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{10}
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
 }
 func (m *BucketSetAttributionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketSetAttributionRequest.Unmarshal(m, b)
@@ -592,7 +821,7 @@ func (m *BucketSetAttributionResponse) Reset()         { *m = BucketSetAttributi
 func (m *BucketSetAttributionResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketSetAttributionResponse) ProtoMessage()    {}
 func (*BucketSetAttributionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{11}
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
 }
 func (m *BucketSetAttributionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketSetAttributionResponse.Unmarshal(m, b)
@@ -612,6 +841,235 @@ func (m *BucketSetAttributionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BucketSetAttributionResponse proto.InternalMessageInfo
 
+type BucketSetVersioningRequest struct {
+	Name                 []byte           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versioning           BucketVersioning `protobuf:"varint,2,opt,name=versioning,proto3,enum=metainfo.BucketVersioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketSetVersioningRequest) Reset()         { *m = BucketSetVersioningRequest{} }
+func (m *BucketSetVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningRequest) ProtoMessage()    {}
+func (*BucketSetVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{15}
+}
+func (m *BucketSetVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningRequest.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningRequest.Merge(m, src)
+}
+func (m *BucketSetVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningRequest.Size(m)
+}
+func (m *BucketSetVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningRequest proto.InternalMessageInfo
+
+func (m *BucketSetVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetVersioning() BucketVersioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return BucketVersioning_UNVERSIONED
+}
+
+type BucketSetVersioningResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetVersioningResponse) Reset()         { *m = BucketSetVersioningResponse{} }
+func (m *BucketSetVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningResponse) ProtoMessage()    {}
+func (*BucketSetVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{16}
+}
+func (m *BucketSetVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningResponse.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningResponse.Merge(m, src)
+}
+func (m *BucketSetVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningResponse.Size(m)
+}
+func (m *BucketSetVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningResponse proto.InternalMessageInfo
+
+type BucketSetLifecycleRequest struct {
+	Name                 []byte           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules                []*LifecycleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketSetLifecycleRequest) Reset()         { *m = BucketSetLifecycleRequest{} }
+func (m *BucketSetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleRequest) ProtoMessage()    {}
+func (*BucketSetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{17}
+}
+func (m *BucketSetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleRequest.Merge(m, src)
+}
+func (m *BucketSetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Size(m)
+}
+func (m *BucketSetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketSetLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type BucketSetLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetLifecycleResponse) Reset()         { *m = BucketSetLifecycleResponse{} }
+func (m *BucketSetLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleResponse) ProtoMessage()    {}
+func (*BucketSetLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{18}
+}
+func (m *BucketSetLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleResponse.Merge(m, src)
+}
+func (m *BucketSetLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Size(m)
+}
+func (m *BucketSetLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleResponse proto.InternalMessageInfo
+
+type BucketSetPlacementRequest struct {
+	Name []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// clears the placement constraints if not set
+	Placement            *PlacementConstraints `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BucketSetPlacementRequest) Reset()         { *m = BucketSetPlacementRequest{} }
+func (m *BucketSetPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetPlacementRequest) ProtoMessage()    {}
+func (*BucketSetPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{19}
+}
+func (m *BucketSetPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetPlacementRequest.Unmarshal(m, b)
+}
+func (m *BucketSetPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetPlacementRequest.Merge(m, src)
+}
+func (m *BucketSetPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetPlacementRequest.Size(m)
+}
+func (m *BucketSetPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetPlacementRequest proto.InternalMessageInfo
+
+func (m *BucketSetPlacementRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetPlacementRequest) GetPlacement() *PlacementConstraints {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+type BucketSetPlacementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetPlacementResponse) Reset()         { *m = BucketSetPlacementResponse{} }
+func (m *BucketSetPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetPlacementResponse) ProtoMessage()    {}
+func (*BucketSetPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{20}
+}
+func (m *BucketSetPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetPlacementResponse.Unmarshal(m, b)
+}
+func (m *BucketSetPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetPlacementResponse.Merge(m, src)
+}
+func (m *BucketSetPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetPlacementResponse.Size(m)
+}
+func (m *BucketSetPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetPlacementResponse proto.InternalMessageInfo
+
 type AddressedOrderLimit struct {
 	Limit                *OrderLimit  `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	StorageNodeAddress   *NodeAddress `protobuf:"bytes,2,opt,name=storage_node_address,json=storageNodeAddress,proto3" json:"storage_node_address,omitempty"`
@@ -624,7 +1082,7 @@ func (m *AddressedOrderLimit) Reset()         { *m = AddressedOrderLimit{} }
 func (m *AddressedOrderLimit) String() string { return proto.CompactTextString(m) }
 func (*AddressedOrderLimit) ProtoMessage()    {}
 func (*AddressedOrderLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{12}
+	return fileDescriptor_631e2f30a93cd64e, []int{21}
 }
 func (m *AddressedOrderLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressedOrderLimit.Unmarshal(m, b)
@@ -674,7 +1132,7 @@ func (m *SegmentWriteRequestOld) Reset()         { *m = SegmentWriteRequestOld{}
 func (m *SegmentWriteRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentWriteRequestOld) ProtoMessage()    {}
 func (*SegmentWriteRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{13}
+	return fileDescriptor_631e2f30a93cd64e, []int{22}
 }
 func (m *SegmentWriteRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentWriteRequestOld.Unmarshal(m, b)
@@ -749,7 +1207,7 @@ func (m *SegmentWriteResponseOld) Reset()         { *m = SegmentWriteResponseOld
 func (m *SegmentWriteResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentWriteResponseOld) ProtoMessage()    {}
 func (*SegmentWriteResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{14}
+	return fileDescriptor_631e2f30a93cd64e, []int{23}
 }
 func (m *SegmentWriteResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentWriteResponseOld.Unmarshal(m, b)
@@ -791,7 +1249,7 @@ func (m *SegmentCommitRequestOld) Reset()         { *m = SegmentCommitRequestOld
 func (m *SegmentCommitRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitRequestOld) ProtoMessage()    {}
 func (*SegmentCommitRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{15}
+	return fileDescriptor_631e2f30a93cd64e, []int{24}
 }
 func (m *SegmentCommitRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitRequestOld.Unmarshal(m, b)
@@ -857,7 +1315,7 @@ func (m *SegmentCommitResponseOld) Reset()         { *m = SegmentCommitResponseO
 func (m *SegmentCommitResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitResponseOld) ProtoMessage()    {}
 func (*SegmentCommitResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{16}
+	return fileDescriptor_631e2f30a93cd64e, []int{25}
 }
 func (m *SegmentCommitResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitResponseOld.Unmarshal(m, b)
@@ -885,19 +1343,24 @@ func (m *SegmentCommitResponseOld) GetPointer() *Pointer {
 }
 
 type SegmentDownloadRequestOld struct {
-	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Bucket  []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path    []byte `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment int64  `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	// version_id selects a version of the object, the latest one is used when empty
+	VersionId string `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// range limits the order limits to the erasure shares, which are needed
+	// for reading the range of the segment. The whole segment can be read when empty.
+	Range                *SegmentRange `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *SegmentDownloadRequestOld) Reset()         { *m = SegmentDownloadRequestOld{} }
 func (m *SegmentDownloadRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadRequestOld) ProtoMessage()    {}
 func (*SegmentDownloadRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{17}
+	return fileDescriptor_631e2f30a93cd64e, []int{26}
 }
 func (m *SegmentDownloadRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadRequestOld.Unmarshal(m, b)
@@ -938,6 +1401,66 @@ func (m *SegmentDownloadRequestOld) GetSegment() int64 {
 	return 0
 }
 
+func (m *SegmentDownloadRequestOld) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *SegmentDownloadRequestOld) GetRange() *SegmentRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+type SegmentRange struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRange) Reset()         { *m = SegmentRange{} }
+func (m *SegmentRange) String() string { return proto.CompactTextString(m) }
+func (*SegmentRange) ProtoMessage()    {}
+func (*SegmentRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{27}
+}
+func (m *SegmentRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentRange.Unmarshal(m, b)
+}
+func (m *SegmentRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentRange.Marshal(b, m, deterministic)
+}
+func (m *SegmentRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRange.Merge(m, src)
+}
+func (m *SegmentRange) XXX_Size() int {
+	return xxx_messageInfo_SegmentRange.Size(m)
+}
+func (m *SegmentRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRange proto.InternalMessageInfo
+
+func (m *SegmentRange) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SegmentRange) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
 type SegmentDownloadResponseOld struct {
 	AddressedLimits      []*AddressedOrderLimit `protobuf:"bytes,1,rep,name=addressed_limits,json=addressedLimits,proto3" json:"addressed_limits,omitempty"`
 	Pointer              *Pointer               `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
@@ -951,7 +1474,7 @@ func (m *SegmentDownloadResponseOld) Reset()         { *m = SegmentDownloadRespo
 func (m *SegmentDownloadResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadResponseOld) ProtoMessage()    {}
 func (*SegmentDownloadResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{18}
+	return fileDescriptor_631e2f30a93cd64e, []int{28}
 }
 func (m *SegmentDownloadResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadResponseOld.Unmarshal(m, b)
@@ -986,9 +1509,11 @@ func (m *SegmentDownloadResponseOld) GetPointer() *Pointer {
 }
 
 type SegmentInfoRequestOld struct {
-	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	Bucket  []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path    []byte `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment int64  `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	// version_id selects a version of the object, the latest one is used when empty
+	VersionId            string   `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -998,7 +1523,7 @@ func (m *SegmentInfoRequestOld) Reset()         { *m = SegmentInfoRequestOld{} }
 func (m *SegmentInfoRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfoRequestOld) ProtoMessage()    {}
 func (*SegmentInfoRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{19}
+	return fileDescriptor_631e2f30a93cd64e, []int{29}
 }
 func (m *SegmentInfoRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentInfoRequestOld.Unmarshal(m, b)
@@ -1039,6 +1564,13 @@ func (m *SegmentInfoRequestOld) GetSegment() int64 {
 	return 0
 }
 
+func (m *SegmentInfoRequestOld) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 type SegmentInfoResponseOld struct {
 	Pointer              *Pointer `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1050,7 +1582,7 @@ func (m *SegmentInfoResponseOld) Reset()         { *m = SegmentInfoResponseOld{}
 func (m *SegmentInfoResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfoResponseOld) ProtoMessage()    {}
 func (*SegmentInfoResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{20}
+	return fileDescriptor_631e2f30a93cd64e, []int{30}
 }
 func (m *SegmentInfoResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentInfoResponseOld.Unmarshal(m, b)
@@ -1090,7 +1622,7 @@ func (m *SegmentDeleteRequestOld) Reset()         { *m = SegmentDeleteRequestOld
 func (m *SegmentDeleteRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDeleteRequestOld) ProtoMessage()    {}
 func (*SegmentDeleteRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{21}
+	return fileDescriptor_631e2f30a93cd64e, []int{31}
 }
 func (m *SegmentDeleteRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDeleteRequestOld.Unmarshal(m, b)
@@ -1143,7 +1675,7 @@ func (m *SegmentDeleteResponseOld) Reset()         { *m = SegmentDeleteResponseO
 func (m *SegmentDeleteResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDeleteResponseOld) ProtoMessage()    {}
 func (*SegmentDeleteResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{22}
+	return fileDescriptor_631e2f30a93cd64e, []int{32}
 }
 func (m *SegmentDeleteResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDeleteResponseOld.Unmarshal(m, b)
@@ -1187,7 +1719,7 @@ func (m *ListSegmentsRequestOld) Reset()         { *m = ListSegmentsRequestOld{}
 func (m *ListSegmentsRequestOld) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsRequestOld) ProtoMessage()    {}
 func (*ListSegmentsRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{23}
+	return fileDescriptor_631e2f30a93cd64e, []int{33}
 }
 func (m *ListSegmentsRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsRequestOld.Unmarshal(m, b)
@@ -1268,7 +1800,7 @@ func (m *ListSegmentsResponseOld) Reset()         { *m = ListSegmentsResponseOld
 func (m *ListSegmentsResponseOld) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsResponseOld) ProtoMessage()    {}
 func (*ListSegmentsResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{24}
+	return fileDescriptor_631e2f30a93cd64e, []int{34}
 }
 func (m *ListSegmentsResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsResponseOld.Unmarshal(m, b)
@@ -1315,7 +1847,7 @@ func (m *ListSegmentsResponseOld_Item) Reset()         { *m = ListSegmentsRespon
 func (m *ListSegmentsResponseOld_Item) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsResponseOld_Item) ProtoMessage()    {}
 func (*ListSegmentsResponseOld_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{24, 0}
+	return fileDescriptor_631e2f30a93cd64e, []int{34, 0}
 }
 func (m *ListSegmentsResponseOld_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsResponseOld_Item.Unmarshal(m, b)
@@ -1368,7 +1900,7 @@ func (m *SetAttributionRequestOld) Reset()         { *m = SetAttributionRequestO
 func (m *SetAttributionRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionRequestOld) ProtoMessage()    {}
 func (*SetAttributionRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{25}
+	return fileDescriptor_631e2f30a93cd64e, []int{35}
 }
 func (m *SetAttributionRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionRequestOld.Unmarshal(m, b)
@@ -1412,7 +1944,7 @@ func (m *SetAttributionResponseOld) Reset()         { *m = SetAttributionRespons
 func (m *SetAttributionResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionResponseOld) ProtoMessage()    {}
 func (*SetAttributionResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{26}
+	return fileDescriptor_631e2f30a93cd64e, []int{36}
 }
 func (m *SetAttributionResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionResponseOld.Unmarshal(m, b)
@@ -1442,7 +1974,7 @@ func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
 func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoRequest) ProtoMessage()    {}
 func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{27}
+	return fileDescriptor_631e2f30a93cd64e, []int{37}
 }
 func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
@@ -1463,17 +1995,19 @@ func (m *ProjectInfoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_ProjectInfoRequest proto.InternalMessageInfo
 
 type ProjectInfoResponse struct {
-	ProjectSalt          []byte   `protobuf:"bytes,1,opt,name=project_salt,json=projectSalt,proto3" json:"project_salt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ProjectSalt []byte `protobuf:"bytes,1,opt,name=project_salt,json=projectSalt,proto3" json:"project_salt,omitempty"`
+	// redundancy policy enforced by the satellite for new segments of the project, if any
+	RedundancyPolicy     *RedundancyScheme `protobuf:"bytes,2,opt,name=redundancy_policy,json=redundancyPolicy,proto3" json:"redundancy_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
 func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoResponse) ProtoMessage()    {}
 func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{28}
+	return fileDescriptor_631e2f30a93cd64e, []int{38}
 }
 func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
@@ -1500,6 +2034,13 @@ func (m *ProjectInfoResponse) GetProjectSalt() []byte {
 	return nil
 }
 
+func (m *ProjectInfoResponse) GetRedundancyPolicy() *RedundancyScheme {
+	if m != nil {
+		return m.RedundancyPolicy
+	}
+	return nil
+}
+
 type Object struct {
 	Bucket                 []byte                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	EncryptedPath          []byte                `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
@@ -1526,7 +2067,7 @@ func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{29}
+	return fileDescriptor_631e2f30a93cd64e, []int{39}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Object.Unmarshal(m, b)
@@ -1662,7 +2203,7 @@ func (m *ObjectBeginRequest) Reset()         { *m = ObjectBeginRequest{} }
 func (m *ObjectBeginRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginRequest) ProtoMessage()    {}
 func (*ObjectBeginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{30}
+	return fileDescriptor_631e2f30a93cd64e, []int{40}
 }
 func (m *ObjectBeginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginRequest.Unmarshal(m, b)
@@ -1747,7 +2288,7 @@ func (m *ObjectBeginResponse) Reset()         { *m = ObjectBeginResponse{} }
 func (m *ObjectBeginResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginResponse) ProtoMessage()    {}
 func (*ObjectBeginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{31}
+	return fileDescriptor_631e2f30a93cd64e, []int{41}
 }
 func (m *ObjectBeginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginResponse.Unmarshal(m, b)
@@ -1813,7 +2354,7 @@ func (m *ObjectCommitRequest) Reset()         { *m = ObjectCommitRequest{} }
 func (m *ObjectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectCommitRequest) ProtoMessage()    {}
 func (*ObjectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{32}
+	return fileDescriptor_631e2f30a93cd64e, []int{42}
 }
 func (m *ObjectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectCommitRequest.Unmarshal(m, b)
@@ -1843,7 +2384,7 @@ func (m *ObjectCommitResponse) Reset()         { *m = ObjectCommitResponse{} }
 func (m *ObjectCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectCommitResponse) ProtoMessage()    {}
 func (*ObjectCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{33}
+	return fileDescriptor_631e2f30a93cd64e, []int{43}
 }
 func (m *ObjectCommitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectCommitResponse.Unmarshal(m, b)
@@ -1878,7 +2419,7 @@ func (m *ObjectListRequest) Reset()         { *m = ObjectListRequest{} }
 func (m *ObjectListRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectListRequest) ProtoMessage()    {}
 func (*ObjectListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{34}
+	return fileDescriptor_631e2f30a93cd64e, []int{44}
 }
 func (m *ObjectListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListRequest.Unmarshal(m, b)
@@ -1945,7 +2486,7 @@ func (m *ObjectListResponse) Reset()         { *m = ObjectListResponse{} }
 func (m *ObjectListResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectListResponse) ProtoMessage()    {}
 func (*ObjectListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{35}
+	return fileDescriptor_631e2f30a93cd64e, []int{45}
 }
 func (m *ObjectListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListResponse.Unmarshal(m, b)
@@ -1997,7 +2538,7 @@ func (m *ObjectListItem) Reset()         { *m = ObjectListItem{} }
 func (m *ObjectListItem) String() string { return proto.CompactTextString(m) }
 func (*ObjectListItem) ProtoMessage()    {}
 func (*ObjectListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{36}
+	return fileDescriptor_631e2f30a93cd64e, []int{46}
 }
 func (m *ObjectListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListItem.Unmarshal(m, b)
@@ -2077,7 +2618,7 @@ func (m *ObjectListItemIncludes) Reset()         { *m = ObjectListItemIncludes{}
 func (m *ObjectListItemIncludes) String() string { return proto.CompactTextString(m) }
 func (*ObjectListItemIncludes) ProtoMessage()    {}
 func (*ObjectListItemIncludes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{37}
+	return fileDescriptor_631e2f30a93cd64e, []int{47}
 }
 func (m *ObjectListItemIncludes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListItemIncludes.Unmarshal(m, b)
@@ -2117,7 +2658,7 @@ func (m *ObjectBeginDeleteRequest) Reset()         { *m = ObjectBeginDeleteReque
 func (m *ObjectBeginDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginDeleteRequest) ProtoMessage()    {}
 func (*ObjectBeginDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{38}
+	return fileDescriptor_631e2f30a93cd64e, []int{48}
 }
 func (m *ObjectBeginDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginDeleteRequest.Unmarshal(m, b)
@@ -2169,86 +2710,797 @@ func (m *ObjectBeginDeleteResponse) Reset()         { *m = ObjectBeginDeleteResp
 func (m *ObjectBeginDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginDeleteResponse) ProtoMessage()    {}
 func (*ObjectBeginDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{39}
+	return fileDescriptor_631e2f30a93cd64e, []int{49}
+}
+func (m *ObjectBeginDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginDeleteResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginDeleteResponse.Merge(m, src)
+}
+func (m *ObjectBeginDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginDeleteResponse.Size(m)
+}
+func (m *ObjectBeginDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginDeleteResponse proto.InternalMessageInfo
+
+type ObjectFinishDeleteRequest struct {
+	StreamId             StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishDeleteRequest) Reset()         { *m = ObjectFinishDeleteRequest{} }
+func (m *ObjectFinishDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishDeleteRequest) ProtoMessage()    {}
+func (*ObjectFinishDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{50}
+}
+func (m *ObjectFinishDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishDeleteRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishDeleteRequest.Merge(m, src)
+}
+func (m *ObjectFinishDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishDeleteRequest.Size(m)
+}
+func (m *ObjectFinishDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishDeleteRequest proto.InternalMessageInfo
+
+type ObjectFinishDeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishDeleteResponse) Reset()         { *m = ObjectFinishDeleteResponse{} }
+func (m *ObjectFinishDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishDeleteResponse) ProtoMessage()    {}
+func (*ObjectFinishDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{51}
+}
+func (m *ObjectFinishDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishDeleteResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishDeleteResponse.Merge(m, src)
+}
+func (m *ObjectFinishDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishDeleteResponse.Size(m)
+}
+func (m *ObjectFinishDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishDeleteResponse proto.InternalMessageInfo
+
+type SegmentMetadata struct {
+	SegmentIndex         int64    `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMetadata) Reset()         { *m = SegmentMetadata{} }
+func (m *SegmentMetadata) String() string { return proto.CompactTextString(m) }
+func (*SegmentMetadata) ProtoMessage()    {}
+func (*SegmentMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{52}
+}
+func (m *SegmentMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMetadata.Unmarshal(m, b)
+}
+func (m *SegmentMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMetadata.Marshal(b, m, deterministic)
+}
+func (m *SegmentMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMetadata.Merge(m, src)
+}
+func (m *SegmentMetadata) XXX_Size() int {
+	return xxx_messageInfo_SegmentMetadata.Size(m)
+}
+func (m *SegmentMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMetadata proto.InternalMessageInfo
+
+func (m *SegmentMetadata) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SegmentMetadata) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ObjectCopyRequest struct {
+	Bucket           []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath    []byte `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket        []byte `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath []byte `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	// metadata of every segment, re-encrypted by the uplink for the new path
+	Segments             []*SegmentMetadata `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ObjectCopyRequest) Reset()         { *m = ObjectCopyRequest{} }
+func (m *ObjectCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectCopyRequest) ProtoMessage()    {}
+func (*ObjectCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{53}
+}
+func (m *ObjectCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCopyRequest.Merge(m, src)
+}
+func (m *ObjectCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectCopyRequest.Size(m)
+}
+func (m *ObjectCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectCopyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetSegments() []*SegmentMetadata {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ObjectCopyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectCopyResponse) Reset()         { *m = ObjectCopyResponse{} }
+func (m *ObjectCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectCopyResponse) ProtoMessage()    {}
+func (*ObjectCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{54}
+}
+func (m *ObjectCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCopyResponse.Merge(m, src)
+}
+func (m *ObjectCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectCopyResponse.Size(m)
+}
+func (m *ObjectCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCopyResponse proto.InternalMessageInfo
+
+type ObjectMoveRequest struct {
+	Bucket           []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath    []byte `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket        []byte `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath []byte `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	// metadata of every segment, re-encrypted by the uplink for the new path
+	Segments             []*SegmentMetadata `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ObjectMoveRequest) Reset()         { *m = ObjectMoveRequest{} }
+func (m *ObjectMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectMoveRequest) ProtoMessage()    {}
+func (*ObjectMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{55}
+}
+func (m *ObjectMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMoveRequest.Merge(m, src)
+}
+func (m *ObjectMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectMoveRequest.Size(m)
+}
+func (m *ObjectMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetSegments() []*SegmentMetadata {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ObjectMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectMoveResponse) Reset()         { *m = ObjectMoveResponse{} }
+func (m *ObjectMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectMoveResponse) ProtoMessage()    {}
+func (*ObjectMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{56}
+}
+func (m *ObjectMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMoveResponse.Merge(m, src)
+}
+func (m *ObjectMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectMoveResponse.Size(m)
+}
+func (m *ObjectMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectMoveResponse proto.InternalMessageInfo
+
+type ObjectRekeyRequest struct {
+	Bucket        []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath []byte `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	// equal to encrypted_path, when the path isn't encrypted
+	NewEncryptedPath []byte `protobuf:"bytes,3,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	// metadata of every segment, with the content keys encrypted under the new root key
+	Segments []*SegmentMetadata `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	// the version of the object, the latest version is re-encrypted when it's empty
+	VersionId            string   `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectRekeyRequest) Reset()         { *m = ObjectRekeyRequest{} }
+func (m *ObjectRekeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectRekeyRequest) ProtoMessage()    {}
+func (*ObjectRekeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{57}
+}
+func (m *ObjectRekeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectRekeyRequest.Unmarshal(m, b)
+}
+func (m *ObjectRekeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectRekeyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectRekeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectRekeyRequest.Merge(m, src)
+}
+func (m *ObjectRekeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectRekeyRequest.Size(m)
+}
+func (m *ObjectRekeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectRekeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectRekeyRequest proto.InternalMessageInfo
+
+func (m *ObjectRekeyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectRekeyRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectRekeyRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectRekeyRequest) GetSegments() []*SegmentMetadata {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectRekeyRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+type ObjectRekeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectRekeyResponse) Reset()         { *m = ObjectRekeyResponse{} }
+func (m *ObjectRekeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectRekeyResponse) ProtoMessage()    {}
+func (*ObjectRekeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{58}
+}
+func (m *ObjectRekeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectRekeyResponse.Unmarshal(m, b)
+}
+func (m *ObjectRekeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectRekeyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectRekeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectRekeyResponse.Merge(m, src)
+}
+func (m *ObjectRekeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectRekeyResponse.Size(m)
+}
+func (m *ObjectRekeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectRekeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectRekeyResponse proto.InternalMessageInfo
+
+type ObjectVersion struct {
+	EncryptedPath  []byte    `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	VersionId      string    `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	IsLatest       bool      `protobuf:"varint,3,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	IsDeleteMarker bool      `protobuf:"varint,4,opt,name=is_delete_marker,json=isDeleteMarker,proto3" json:"is_delete_marker,omitempty"`
+	CreatedAt      time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// pointer of the last segment, it isn't set for delete markers
+	Pointer              *Pointer `protobuf:"bytes,6,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectVersion) Reset()         { *m = ObjectVersion{} }
+func (m *ObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*ObjectVersion) ProtoMessage()    {}
+func (*ObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{59}
+}
+func (m *ObjectVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectVersion.Unmarshal(m, b)
+}
+func (m *ObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectVersion.Marshal(b, m, deterministic)
+}
+func (m *ObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectVersion.Merge(m, src)
+}
+func (m *ObjectVersion) XXX_Size() int {
+	return xxx_messageInfo_ObjectVersion.Size(m)
+}
+func (m *ObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectVersion proto.InternalMessageInfo
+
+func (m *ObjectVersion) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectVersion) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *ObjectVersion) GetIsLatest() bool {
+	if m != nil {
+		return m.IsLatest
+	}
+	return false
+}
+
+func (m *ObjectVersion) GetIsDeleteMarker() bool {
+	if m != nil {
+		return m.IsDeleteMarker
+	}
+	return false
+}
+
+func (m *ObjectVersion) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ObjectVersion) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+type ObjectListVersionsRequest struct {
+	Bucket          []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPrefix []byte `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	// encrypted_cursor is relative to the prefix, all versions of the
+	// objects up to and including the cursor are skipped
+	EncryptedCursor []byte `protobuf:"bytes,3,opt,name=encrypted_cursor,json=encryptedCursor,proto3" json:"encrypted_cursor,omitempty"`
+	// limit is the maximum number of objects, all versions of an object are
+	// returned together
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectListVersionsRequest) Reset()         { *m = ObjectListVersionsRequest{} }
+func (m *ObjectListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsRequest) ProtoMessage()    {}
+func (*ObjectListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{60}
+}
+func (m *ObjectListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsRequest.Merge(m, src)
+}
+func (m *ObjectListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsRequest.Size(m)
+}
+func (m *ObjectListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsRequest proto.InternalMessageInfo
+
+func (m *ObjectListVersionsRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedCursor() []byte {
+	if m != nil {
+		return m.EncryptedCursor
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectListVersionsResponse struct {
+	// the paths are relative to the prefix, the versions of an object are ordered newest first
+	Items                []*ObjectVersion `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ObjectListVersionsResponse) Reset()         { *m = ObjectListVersionsResponse{} }
+func (m *ObjectListVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsResponse) ProtoMessage()    {}
+func (*ObjectListVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{61}
+}
+func (m *ObjectListVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsResponse.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsResponse.Merge(m, src)
+}
+func (m *ObjectListVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsResponse.Size(m)
+}
+func (m *ObjectListVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsResponse proto.InternalMessageInfo
+
+func (m *ObjectListVersionsResponse) GetItems() []*ObjectVersion {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ObjectDeleteVersionRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	VersionId            string   `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectDeleteVersionRequest) Reset()         { *m = ObjectDeleteVersionRequest{} }
+func (m *ObjectDeleteVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionRequest) ProtoMessage()    {}
+func (*ObjectDeleteVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{62}
+}
+func (m *ObjectDeleteVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Unmarshal(m, b)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionRequest.Merge(m, src)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Size(m)
+}
+func (m *ObjectDeleteVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteVersionRequest proto.InternalMessageInfo
+
+func (m *ObjectDeleteVersionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+type ObjectDeleteVersionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ObjectBeginDeleteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ObjectBeginDeleteResponse.Unmarshal(m, b)
+
+func (m *ObjectDeleteVersionResponse) Reset()         { *m = ObjectDeleteVersionResponse{} }
+func (m *ObjectDeleteVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionResponse) ProtoMessage()    {}
+func (*ObjectDeleteVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{63}
 }
-func (m *ObjectBeginDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ObjectBeginDeleteResponse.Marshal(b, m, deterministic)
+func (m *ObjectDeleteVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Unmarshal(m, b)
 }
-func (m *ObjectBeginDeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectBeginDeleteResponse.Merge(m, src)
+func (m *ObjectDeleteVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Marshal(b, m, deterministic)
 }
-func (m *ObjectBeginDeleteResponse) XXX_Size() int {
-	return xxx_messageInfo_ObjectBeginDeleteResponse.Size(m)
+func (m *ObjectDeleteVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionResponse.Merge(m, src)
 }
-func (m *ObjectBeginDeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectBeginDeleteResponse.DiscardUnknown(m)
+func (m *ObjectDeleteVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Size(m)
+}
+func (m *ObjectDeleteVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectBeginDeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_ObjectDeleteVersionResponse proto.InternalMessageInfo
 
-type ObjectFinishDeleteRequest struct {
-	StreamId             StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+type ObjectPutDeleteMarkerRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ObjectFinishDeleteRequest) Reset()         { *m = ObjectFinishDeleteRequest{} }
-func (m *ObjectFinishDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*ObjectFinishDeleteRequest) ProtoMessage()    {}
-func (*ObjectFinishDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{40}
+func (m *ObjectPutDeleteMarkerRequest) Reset()         { *m = ObjectPutDeleteMarkerRequest{} }
+func (m *ObjectPutDeleteMarkerRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectPutDeleteMarkerRequest) ProtoMessage()    {}
+func (*ObjectPutDeleteMarkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{64}
 }
-func (m *ObjectFinishDeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ObjectFinishDeleteRequest.Unmarshal(m, b)
+func (m *ObjectPutDeleteMarkerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectPutDeleteMarkerRequest.Unmarshal(m, b)
 }
-func (m *ObjectFinishDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ObjectFinishDeleteRequest.Marshal(b, m, deterministic)
+func (m *ObjectPutDeleteMarkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectPutDeleteMarkerRequest.Marshal(b, m, deterministic)
 }
-func (m *ObjectFinishDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectFinishDeleteRequest.Merge(m, src)
+func (m *ObjectPutDeleteMarkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectPutDeleteMarkerRequest.Merge(m, src)
 }
-func (m *ObjectFinishDeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_ObjectFinishDeleteRequest.Size(m)
+func (m *ObjectPutDeleteMarkerRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectPutDeleteMarkerRequest.Size(m)
 }
-func (m *ObjectFinishDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectFinishDeleteRequest.DiscardUnknown(m)
+func (m *ObjectPutDeleteMarkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectPutDeleteMarkerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectFinishDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ObjectPutDeleteMarkerRequest proto.InternalMessageInfo
 
-type ObjectFinishDeleteResponse struct {
+func (m *ObjectPutDeleteMarkerRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectPutDeleteMarkerRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+type ObjectPutDeleteMarkerResponse struct {
+	VersionId            string   `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ObjectFinishDeleteResponse) Reset()         { *m = ObjectFinishDeleteResponse{} }
-func (m *ObjectFinishDeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*ObjectFinishDeleteResponse) ProtoMessage()    {}
-func (*ObjectFinishDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{41}
+func (m *ObjectPutDeleteMarkerResponse) Reset()         { *m = ObjectPutDeleteMarkerResponse{} }
+func (m *ObjectPutDeleteMarkerResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectPutDeleteMarkerResponse) ProtoMessage()    {}
+func (*ObjectPutDeleteMarkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{65}
 }
-func (m *ObjectFinishDeleteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ObjectFinishDeleteResponse.Unmarshal(m, b)
+func (m *ObjectPutDeleteMarkerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectPutDeleteMarkerResponse.Unmarshal(m, b)
 }
-func (m *ObjectFinishDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ObjectFinishDeleteResponse.Marshal(b, m, deterministic)
+func (m *ObjectPutDeleteMarkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectPutDeleteMarkerResponse.Marshal(b, m, deterministic)
 }
-func (m *ObjectFinishDeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectFinishDeleteResponse.Merge(m, src)
+func (m *ObjectPutDeleteMarkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectPutDeleteMarkerResponse.Merge(m, src)
 }
-func (m *ObjectFinishDeleteResponse) XXX_Size() int {
-	return xxx_messageInfo_ObjectFinishDeleteResponse.Size(m)
+func (m *ObjectPutDeleteMarkerResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectPutDeleteMarkerResponse.Size(m)
 }
-func (m *ObjectFinishDeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectFinishDeleteResponse.DiscardUnknown(m)
+func (m *ObjectPutDeleteMarkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectPutDeleteMarkerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectFinishDeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_ObjectPutDeleteMarkerResponse proto.InternalMessageInfo
+
+func (m *ObjectPutDeleteMarkerResponse) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
 
 // only for satellite use
 type SatStreamID struct {
@@ -2268,7 +3520,7 @@ func (m *SatStreamID) Reset()         { *m = SatStreamID{} }
 func (m *SatStreamID) String() string { return proto.CompactTextString(m) }
 func (*SatStreamID) ProtoMessage()    {}
 func (*SatStreamID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{42}
+	return fileDescriptor_631e2f30a93cd64e, []int{66}
 }
 func (m *SatStreamID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatStreamID.Unmarshal(m, b)
@@ -2338,8 +3590,12 @@ func (m *SatStreamID) GetSatelliteSignature() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("metainfo.BucketVersioning", BucketVersioning_name, BucketVersioning_value)
 	proto.RegisterEnum("metainfo.Object_Status", Object_Status_name, Object_Status_value)
 	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
+	proto.RegisterType((*PlacementConstraints)(nil), "metainfo.PlacementConstraints")
+	proto.RegisterType((*LifecycleRule)(nil), "metainfo.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "metainfo.BucketLifecycle")
 	proto.RegisterType((*BucketListItem)(nil), "metainfo.BucketListItem")
 	proto.RegisterType((*BucketCreateRequest)(nil), "metainfo.BucketCreateRequest")
 	proto.RegisterType((*BucketCreateResponse)(nil), "metainfo.BucketCreateResponse")
@@ -2351,12 +3607,19 @@ func init() {
 	proto.RegisterType((*BucketListResponse)(nil), "metainfo.BucketListResponse")
 	proto.RegisterType((*BucketSetAttributionRequest)(nil), "metainfo.BucketSetAttributionRequest")
 	proto.RegisterType((*BucketSetAttributionResponse)(nil), "metainfo.BucketSetAttributionResponse")
+	proto.RegisterType((*BucketSetVersioningRequest)(nil), "metainfo.BucketSetVersioningRequest")
+	proto.RegisterType((*BucketSetVersioningResponse)(nil), "metainfo.BucketSetVersioningResponse")
+	proto.RegisterType((*BucketSetLifecycleRequest)(nil), "metainfo.BucketSetLifecycleRequest")
+	proto.RegisterType((*BucketSetLifecycleResponse)(nil), "metainfo.BucketSetLifecycleResponse")
+	proto.RegisterType((*BucketSetPlacementRequest)(nil), "metainfo.BucketSetPlacementRequest")
+	proto.RegisterType((*BucketSetPlacementResponse)(nil), "metainfo.BucketSetPlacementResponse")
 	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
 	proto.RegisterType((*SegmentWriteRequestOld)(nil), "metainfo.SegmentWriteRequestOld")
 	proto.RegisterType((*SegmentWriteResponseOld)(nil), "metainfo.SegmentWriteResponseOld")
 	proto.RegisterType((*SegmentCommitRequestOld)(nil), "metainfo.SegmentCommitRequestOld")
 	proto.RegisterType((*SegmentCommitResponseOld)(nil), "metainfo.SegmentCommitResponseOld")
 	proto.RegisterType((*SegmentDownloadRequestOld)(nil), "metainfo.SegmentDownloadRequestOld")
+	proto.RegisterType((*SegmentRange)(nil), "metainfo.SegmentRange")
 	proto.RegisterType((*SegmentDownloadResponseOld)(nil), "metainfo.SegmentDownloadResponseOld")
 	proto.RegisterType((*SegmentInfoRequestOld)(nil), "metainfo.SegmentInfoRequestOld")
 	proto.RegisterType((*SegmentInfoResponseOld)(nil), "metainfo.SegmentInfoResponseOld")
@@ -2382,150 +3645,220 @@ func init() {
 	proto.RegisterType((*ObjectBeginDeleteResponse)(nil), "metainfo.ObjectBeginDeleteResponse")
 	proto.RegisterType((*ObjectFinishDeleteRequest)(nil), "metainfo.ObjectFinishDeleteRequest")
 	proto.RegisterType((*ObjectFinishDeleteResponse)(nil), "metainfo.ObjectFinishDeleteResponse")
+	proto.RegisterType((*SegmentMetadata)(nil), "metainfo.SegmentMetadata")
+	proto.RegisterType((*ObjectCopyRequest)(nil), "metainfo.ObjectCopyRequest")
+	proto.RegisterType((*ObjectCopyResponse)(nil), "metainfo.ObjectCopyResponse")
+	proto.RegisterType((*ObjectMoveRequest)(nil), "metainfo.ObjectMoveRequest")
+	proto.RegisterType((*ObjectMoveResponse)(nil), "metainfo.ObjectMoveResponse")
+	proto.RegisterType((*ObjectRekeyRequest)(nil), "metainfo.ObjectRekeyRequest")
+	proto.RegisterType((*ObjectRekeyResponse)(nil), "metainfo.ObjectRekeyResponse")
+	proto.RegisterType((*ObjectVersion)(nil), "metainfo.ObjectVersion")
+	proto.RegisterType((*ObjectListVersionsRequest)(nil), "metainfo.ObjectListVersionsRequest")
+	proto.RegisterType((*ObjectListVersionsResponse)(nil), "metainfo.ObjectListVersionsResponse")
+	proto.RegisterType((*ObjectDeleteVersionRequest)(nil), "metainfo.ObjectDeleteVersionRequest")
+	proto.RegisterType((*ObjectDeleteVersionResponse)(nil), "metainfo.ObjectDeleteVersionResponse")
+	proto.RegisterType((*ObjectPutDeleteMarkerRequest)(nil), "metainfo.ObjectPutDeleteMarkerRequest")
+	proto.RegisterType((*ObjectPutDeleteMarkerResponse)(nil), "metainfo.ObjectPutDeleteMarkerResponse")
 	proto.RegisterType((*SatStreamID)(nil), "metainfo.SatStreamID")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 2181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5b, 0x6f, 0xe3, 0xc6,
-	0x15, 0x0e, 0x75, 0xd7, 0x91, 0x2c, 0xc9, 0x63, 0xc7, 0xd6, 0xd2, 0x76, 0xec, 0x70, 0xbb, 0x0b,
-	0x07, 0x48, 0xb4, 0x85, 0xd3, 0x87, 0x00, 0xdb, 0x02, 0xb5, 0x2d, 0xef, 0xae, 0xb6, 0xbe, 0x95,
-	0xda, 0x64, 0x37, 0x41, 0x0a, 0x82, 0x16, 0xc7, 0x5a, 0x36, 0x12, 0xa9, 0x92, 0xa3, 0xad, 0x37,
-	0xcf, 0x7d, 0x69, 0xd1, 0x87, 0xfe, 0x82, 0xfc, 0x94, 0xbe, 0xb6, 0x68, 0x81, 0x3e, 0x14, 0x7d,
-	0x6a, 0x81, 0x14, 0xe8, 0x0f, 0xe8, 0x6f, 0x08, 0xe6, 0x26, 0x0e, 0x29, 0xca, 0x97, 0xb5, 0xfc,
-	0xc6, 0x39, 0xe7, 0xcc, 0x99, 0x99, 0xef, 0x5c, 0xe6, 0xcc, 0x21, 0xd4, 0x86, 0x98, 0xd8, 0xae,
-	0x77, 0xee, 0xb7, 0x46, 0x81, 0x4f, 0x7c, 0x54, 0x92, 0x63, 0xbd, 0x81, 0xbd, 0x5e, 0xf0, 0x76,
-	0x44, 0x5c, 0xdf, 0xe3, 0x3c, 0x1d, 0xfa, 0x7e, 0x5f, 0xc8, 0xe9, 0x9b, 0x7d, 0xdf, 0xef, 0x0f,
-	0xf0, 0x23, 0x36, 0x3a, 0x1b, 0x9f, 0x3f, 0x22, 0xee, 0x10, 0x87, 0xc4, 0x1e, 0x8e, 0xa4, 0xb0,
-	0xe7, 0x3b, 0x58, 0x7c, 0xd7, 0x47, 0xbe, 0xeb, 0x11, 0x1c, 0x38, 0x67, 0x82, 0x50, 0xf5, 0x03,
-	0x07, 0x07, 0x21, 0x1f, 0x19, 0x7f, 0xcc, 0x42, 0x61, 0x6f, 0xdc, 0xfb, 0x06, 0x13, 0x84, 0x20,
-	0xe7, 0xd9, 0x43, 0xdc, 0xd4, 0xb6, 0xb4, 0xed, 0xaa, 0xc9, 0xbe, 0xd1, 0x67, 0x50, 0x19, 0xd9,
-	0xe4, 0xb5, 0xd5, 0x73, 0x47, 0xaf, 0x71, 0xd0, 0xcc, 0x6c, 0x69, 0xdb, 0xb5, 0x9d, 0xd5, 0x96,
-	0xb2, 0xbd, 0x7d, 0xc6, 0xe9, 0x8e, 0x5d, 0x82, 0x4d, 0xa0, 0xb2, 0x9c, 0x80, 0xf6, 0x01, 0x7a,
-	0x01, 0xb6, 0x09, 0x76, 0x2c, 0x9b, 0x34, 0xb3, 0x5b, 0xda, 0x76, 0x65, 0x47, 0x6f, 0xf1, 0x9d,
-	0xb7, 0xe4, 0xce, 0x5b, 0x2f, 0xe4, 0xce, 0xf7, 0x4a, 0x7f, 0xfd, 0x7e, 0xf3, 0xbd, 0x3f, 0xfd,
-	0x77, 0x53, 0x33, 0xcb, 0x62, 0xde, 0x2e, 0x41, 0x3f, 0x86, 0x65, 0x07, 0x9f, 0xdb, 0xe3, 0x01,
-	0xb1, 0x42, 0xdc, 0x1f, 0x62, 0x8f, 0x58, 0xa1, 0xfb, 0x2d, 0x6e, 0xe6, 0xb6, 0xb4, 0xed, 0xac,
-	0x89, 0x04, 0xaf, 0xcb, 0x59, 0x5d, 0xf7, 0x5b, 0x8c, 0x5e, 0xc2, 0x3d, 0x39, 0x23, 0xc0, 0xce,
-	0xd8, 0x73, 0x6c, 0xaf, 0xf7, 0xd6, 0x0a, 0x7b, 0xaf, 0xf1, 0x10, 0x37, 0xf3, 0x6c, 0x17, 0x6b,
-	0xad, 0x08, 0x12, 0x73, 0x22, 0xd3, 0x65, 0x22, 0xe6, 0xaa, 0x98, 0x9d, 0x64, 0x20, 0x07, 0x36,
-	0xa4, 0xe2, 0xe8, 0xf4, 0xd6, 0xc8, 0x0e, 0xec, 0x21, 0x26, 0x38, 0x08, 0x9b, 0x05, 0xa6, 0x7c,
-	0x4b, 0xc5, 0xe6, 0x60, 0xf2, 0x79, 0x3a, 0x91, 0x33, 0xd7, 0x84, 0x9a, 0x34, 0xa6, 0xe1, 0x42,
-	0x8d, 0x5b, 0xe3, 0xd0, 0x0d, 0x49, 0x87, 0xe0, 0x61, 0xaa, 0x55, 0xe2, 0xd8, 0x66, 0xde, 0x09,
-	0x5b, 0xe3, 0x5f, 0x19, 0x58, 0xe2, 0x6b, 0xed, 0x33, 0x9a, 0x89, 0x7f, 0x33, 0xc6, 0xe1, 0xbc,
-	0xdd, 0x60, 0x96, 0x05, 0xb3, 0xef, 0x66, 0xc1, 0xdc, 0x5d, 0x5a, 0x30, 0x3f, 0x0f, 0x0b, 0xfe,
-	0x1c, 0x96, 0xe3, 0xa8, 0x86, 0x23, 0xdf, 0x0b, 0x31, 0xda, 0x86, 0xc2, 0x19, 0xa3, 0x33, 0x60,
-	0x2b, 0x3b, 0x8d, 0xd6, 0x24, 0xfa, 0xb9, 0xbc, 0x29, 0xf8, 0xc6, 0x43, 0x68, 0x70, 0xca, 0x53,
-	0x4c, 0x2e, 0x31, 0x8a, 0xf1, 0x33, 0x58, 0x54, 0xe4, 0x6e, 0xbc, 0xcc, 0x47, 0xd2, 0xfc, 0x6d,
-	0x3c, 0xc0, 0x97, 0x9a, 0xdf, 0x58, 0x91, 0x67, 0x92, 0xa2, 0x7c, 0x31, 0xc3, 0x92, 0x3b, 0xa0,
-	0xde, 0x2a, 0x15, 0xac, 0x40, 0xa1, 0x37, 0x0e, 0x42, 0x3f, 0x10, 0x2a, 0xc4, 0x08, 0x2d, 0x43,
-	0x7e, 0xe0, 0x0e, 0x5d, 0xee, 0xaf, 0x79, 0x93, 0x0f, 0xd0, 0x3a, 0x94, 0x1d, 0x37, 0xc0, 0x3d,
-	0x8a, 0x22, 0x73, 0x8a, 0xbc, 0x19, 0x11, 0x8c, 0x57, 0x80, 0xd4, 0x05, 0xc4, 0x19, 0x5b, 0x90,
-	0x77, 0x09, 0x1e, 0x86, 0x4d, 0x6d, 0x2b, 0xbb, 0x5d, 0xd9, 0x69, 0x26, 0x8f, 0x28, 0x63, 0xc7,
-	0xe4, 0x62, 0xf4, 0x48, 0x43, 0x3f, 0xc0, 0x6c, 0xe1, 0x92, 0xc9, 0xbe, 0x8d, 0x57, 0xb0, 0xc6,
-	0x85, 0xbb, 0x98, 0xec, 0x12, 0x12, 0xb8, 0x67, 0x63, 0xba, 0xe2, 0x65, 0x41, 0xf0, 0x00, 0x6a,
-	0x76, 0x24, 0x69, 0xb9, 0x0e, 0x53, 0x58, 0x35, 0x17, 0x14, 0x6a, 0xc7, 0x31, 0x3e, 0x80, 0xf5,
-	0x74, 0xcd, 0x02, 0xb4, 0xdf, 0x69, 0xb0, 0xb4, 0xeb, 0x38, 0x01, 0x0e, 0x43, 0xec, 0x9c, 0xd0,
-	0x5c, 0x7c, 0xc8, 0x90, 0xd8, 0x96, 0xf8, 0x70, 0xc3, 0xa1, 0x96, 0xc8, 0xd3, 0x91, 0x88, 0xc4,
-	0x6c, 0x1f, 0x96, 0x43, 0xe2, 0x07, 0x76, 0x1f, 0x5b, 0x34, 0xd1, 0x5b, 0x36, 0xd7, 0x26, 0x12,
-	0xc1, 0x62, 0x8b, 0x65, 0xff, 0x63, 0xdf, 0xc1, 0x62, 0x19, 0x13, 0x09, 0x71, 0x85, 0x66, 0x7c,
-	0x97, 0x81, 0x15, 0x11, 0x76, 0x2f, 0x03, 0x77, 0x62, 0xff, 0x93, 0x81, 0x43, 0x2d, 0xa8, 0xf8,
-	0x50, 0x55, 0x7a, 0x0c, 0x05, 0x85, 0x46, 0xb6, 0x38, 0x36, 0xfb, 0x46, 0x4d, 0x28, 0x8a, 0xb8,
-	0x16, 0x21, 0x2d, 0x87, 0xe8, 0x31, 0x40, 0x14, 0xbf, 0xd7, 0x09, 0x5c, 0x45, 0x1c, 0x3d, 0x06,
-	0x7d, 0x68, 0x5f, 0xc8, 0x38, 0xc5, 0x4e, 0x3c, 0x79, 0xe4, 0xd9, 0x4a, 0xab, 0x43, 0xfb, 0xe2,
-	0x40, 0x0a, 0xa8, 0x19, 0xa4, 0x0d, 0x80, 0x2f, 0x46, 0x6e, 0x60, 0x33, 0xa7, 0x2a, 0xdc, 0x20,
-	0x3d, 0x2a, 0xf3, 0x8c, 0x7f, 0x6a, 0xb0, 0x1a, 0x07, 0x88, 0x1b, 0x90, 0x22, 0xf4, 0x0c, 0x1a,
-	0xb6, 0x34, 0xa1, 0xc5, 0x8c, 0x22, 0x9d, 0x71, 0x23, 0x72, 0xc6, 0x14, 0x23, 0x9b, 0xf5, 0xc9,
-	0x34, 0x36, 0x0e, 0xd1, 0xa7, 0xb0, 0x10, 0xf8, 0x3e, 0xb1, 0x46, 0x2e, 0xee, 0xe1, 0x89, 0x4f,
-	0xed, 0xd5, 0xe9, 0x96, 0xfe, 0xfd, 0xfd, 0x66, 0xf1, 0x94, 0xd2, 0x3b, 0x6d, 0xb3, 0x42, 0xa5,
-	0xf8, 0xc0, 0x61, 0xe9, 0x38, 0x70, 0xdf, 0xd8, 0x04, 0x5b, 0xdf, 0xe0, 0xb7, 0x0c, 0xf8, 0xea,
-	0xde, 0xaa, 0x98, 0x52, 0x67, 0x52, 0xa7, 0x9c, 0xff, 0x0b, 0xfc, 0xd6, 0x84, 0xd1, 0xe4, 0xdb,
-	0xf8, 0x5b, 0x74, 0xa8, 0x7d, 0x7f, 0x48, 0x77, 0x34, 0x6f, 0xb3, 0x7f, 0x0c, 0x45, 0x61, 0x63,
-	0x61, 0x73, 0xa4, 0xd8, 0xfc, 0x94, 0x7f, 0x99, 0x52, 0x04, 0x3d, 0x86, 0xba, 0x1f, 0xb8, 0x7d,
-	0xd7, 0xb3, 0x07, 0x12, 0xc7, 0x3c, 0xc3, 0x31, 0xcd, 0xfd, 0x6b, 0x52, 0x94, 0x63, 0x67, 0x3c,
-	0x83, 0x66, 0xe2, 0x2c, 0x91, 0x85, 0x94, 0x6d, 0x68, 0x57, 0x6e, 0xc3, 0xb0, 0xe1, 0x9e, 0xd0,
-	0xd4, 0xf6, 0x7f, 0xeb, 0x0d, 0x7c, 0xdb, 0x99, 0x37, 0x2e, 0xc6, 0x3f, 0x34, 0xd0, 0xa7, 0xd6,
-	0xb8, 0x0b, 0x8f, 0x52, 0x4e, 0x9e, 0xb9, 0xda, 0x00, 0xef, 0xee, 0x4a, 0xbf, 0x82, 0xf7, 0xc5,
-	0x79, 0x3a, 0xde, 0xb9, 0x3f, 0x77, 0xbc, 0x9e, 0x4c, 0xd2, 0x13, 0x57, 0x9f, 0x6a, 0xda, 0xab,
-	0x0f, 0x68, 0x58, 0x13, 0x87, 0x8f, 0xdd, 0x73, 0xf3, 0xdb, 0xe8, 0x77, 0xda, 0xc4, 0x0d, 0xe3,
-	0xd7, 0xe3, 0x7c, 0xcd, 0x9a, 0x30, 0x54, 0xe6, 0xfa, 0x86, 0xfa, 0x8f, 0x06, 0x2b, 0xf4, 0x4a,
-	0x14, 0x9b, 0x0c, 0xaf, 0x81, 0xc0, 0x0a, 0x14, 0x46, 0x01, 0x3e, 0x77, 0x2f, 0x04, 0x06, 0x62,
-	0x84, 0x36, 0xa1, 0x12, 0x12, 0x3b, 0x20, 0x96, 0x7d, 0x4e, 0xe1, 0x67, 0xde, 0x62, 0x02, 0x23,
-	0xed, 0x52, 0x0a, 0xda, 0x00, 0xc0, 0x9e, 0x63, 0x9d, 0xe1, 0x73, 0x7a, 0xe1, 0xe6, 0x18, 0xbf,
-	0x8c, 0x3d, 0x67, 0x8f, 0x11, 0xe8, 0x6d, 0x1f, 0x60, 0x5a, 0x0f, 0xb8, 0x6f, 0x78, 0x16, 0x2f,
-	0x99, 0x11, 0x21, 0xaa, 0x10, 0x0a, 0x6a, 0x85, 0xb0, 0x01, 0x40, 0x91, 0xb2, 0xce, 0x07, 0x76,
-	0x3f, 0x6c, 0x16, 0xb7, 0xb4, 0xed, 0xa2, 0x59, 0xa6, 0x94, 0x27, 0x94, 0xc0, 0xd2, 0x74, 0xfc,
-	0x74, 0x11, 0xfa, 0x3f, 0x8d, 0x17, 0x0a, 0x0f, 0x23, 0xc8, 0x67, 0xcc, 0x68, 0x5d, 0x51, 0x36,
-	0xe8, 0x18, 0x72, 0xb2, 0x2a, 0x67, 0x2e, 0xa2, 0x29, 0x2e, 0x72, 0xb3, 0xc0, 0x5b, 0x83, 0xb2,
-	0x1b, 0x5a, 0x02, 0xe5, 0x2c, 0x5b, 0xa2, 0xe4, 0x86, 0xa7, 0x6c, 0x6c, 0x7c, 0x45, 0x5d, 0x2a,
-	0xa5, 0x2e, 0xa1, 0x87, 0xda, 0x84, 0x0a, 0xb7, 0x92, 0xa5, 0x54, 0x28, 0xc0, 0x49, 0xc7, 0xb4,
-	0x4e, 0xd9, 0x00, 0x18, 0xd9, 0x01, 0xf1, 0x70, 0x10, 0xd5, 0x28, 0x65, 0x41, 0xe9, 0x38, 0xc6,
-	0x1a, 0xcd, 0x75, 0x69, 0x95, 0xc9, 0xc9, 0xc0, 0x31, 0x96, 0x01, 0x9d, 0x06, 0xfe, 0xaf, 0x71,
-	0x4f, 0x0d, 0x6a, 0xe3, 0x33, 0x58, 0x8a, 0x51, 0x45, 0x1d, 0xf6, 0x21, 0x54, 0x47, 0x9c, 0x6c,
-	0x85, 0xf6, 0x40, 0xfa, 0x50, 0x45, 0xd0, 0xba, 0xf6, 0x80, 0x18, 0xbf, 0x2f, 0x42, 0xe1, 0xe4,
-	0x8c, 0x0e, 0x67, 0xfa, 0xda, 0x03, 0xa8, 0x45, 0xd7, 0xbc, 0x12, 0x77, 0x0b, 0x13, 0xea, 0xa9,
-	0x08, 0xc0, 0x37, 0x38, 0x08, 0xa3, 0x32, 0x51, 0x0e, 0xd1, 0x23, 0x28, 0x84, 0xc4, 0x26, 0xe3,
-	0x90, 0xf9, 0x1b, 0x7d, 0x97, 0x4c, 0xcc, 0xcc, 0x97, 0x6e, 0x75, 0x19, 0xdb, 0x14, 0x62, 0xe8,
-	0x13, 0x28, 0x87, 0x24, 0xc0, 0xf6, 0x90, 0xe2, 0x93, 0x67, 0x81, 0xd4, 0x10, 0x81, 0x54, 0xea,
-	0x32, 0x46, 0xa7, 0x6d, 0x96, 0xb8, 0x48, 0xc7, 0x49, 0xbc, 0xb6, 0x0a, 0xef, 0xf6, 0x92, 0xdd,
-	0xa5, 0x6b, 0xd2, 0xd5, 0xa9, 0x8e, 0xe2, 0x0d, 0x74, 0x94, 0xf8, 0xb4, 0x5d, 0x5a, 0xf6, 0xf1,
-	0xf2, 0x04, 0x33, 0x1d, 0xa5, 0x9b, 0xec, 0x43, 0xcc, 0xdb, 0x25, 0xe8, 0x29, 0x34, 0x23, 0xb4,
-	0x29, 0x4e, 0x8e, 0x4d, 0x6c, 0xcb, 0xf3, 0xbd, 0x1e, 0x6e, 0x96, 0x19, 0x14, 0x0b, 0x02, 0x8a,
-	0xfc, 0x31, 0x25, 0x9a, 0x2b, 0x13, 0xf1, 0x23, 0x21, 0xcd, 0xe8, 0xe8, 0x13, 0x40, 0xd3, 0x8a,
-	0x9a, 0xc0, 0x4c, 0xb7, 0x38, 0x35, 0x07, 0x7d, 0x0c, 0xe8, 0xdc, 0xbd, 0x48, 0x16, 0x72, 0x15,
-	0x96, 0x4a, 0x1b, 0x8c, 0xa3, 0x56, 0x70, 0xcf, 0x60, 0x71, 0xfa, 0xed, 0x57, 0xbd, 0xba, 0x84,
-	0x6c, 0x04, 0xc9, 0x47, 0xdf, 0xe7, 0xf0, 0x7e, 0xfa, 0x63, 0x6f, 0xe1, 0x9a, 0x8f, 0xbd, 0x65,
-	0x9c, 0x42, 0xa5, 0x31, 0x46, 0x7c, 0x62, 0x0f, 0xf8, 0x31, 0x6a, 0xec, 0x18, 0x65, 0x46, 0x61,
-	0xfb, 0xdf, 0x84, 0x8a, 0xeb, 0x0d, 0x5c, 0x0f, 0x73, 0x7e, 0x9d, 0xf1, 0x81, 0x93, 0xa4, 0x40,
-	0x80, 0x87, 0x3e, 0x11, 0x02, 0x0d, 0x2e, 0xc0, 0x49, 0x54, 0xc0, 0xf8, 0x25, 0x14, 0xb8, 0xd7,
-	0xa2, 0x0a, 0x14, 0x3b, 0xc7, 0x5f, 0xec, 0x1e, 0x76, 0xda, 0x8d, 0xf7, 0xd0, 0x02, 0x94, 0x3f,
-	0x3f, 0x3d, 0x3c, 0xd9, 0x6d, 0x77, 0x8e, 0x9f, 0x36, 0x34, 0x54, 0x03, 0xd8, 0x3f, 0x39, 0x3a,
-	0xea, 0xbc, 0x78, 0x41, 0xc7, 0x19, 0xca, 0x16, 0xe3, 0x83, 0x76, 0x23, 0x8b, 0xaa, 0x50, 0x6a,
-	0x1f, 0x1c, 0x1e, 0x30, 0x66, 0xce, 0xf8, 0x7b, 0x16, 0x10, 0x0f, 0x88, 0x3d, 0xdc, 0x77, 0x3d,
-	0xe5, 0xbd, 0x76, 0x37, 0x71, 0x19, 0xf7, 0xd7, 0xdc, 0xfc, 0xfd, 0x35, 0x7f, 0x7b, 0x7f, 0x2d,
-	0xcc, 0xf2, 0xd7, 0x54, 0x0f, 0x2c, 0xce, 0xd5, 0x03, 0x4b, 0xb7, 0xf1, 0x40, 0xe3, 0xcf, 0x19,
-	0x58, 0x8a, 0x59, 0x53, 0x24, 0xe5, 0x3b, 0x33, 0x67, 0x2c, 0x6b, 0xe6, 0xae, 0xcc, 0x9a, 0xa9,
-	0x00, 0xe6, 0xe7, 0x0a, 0x60, 0xe1, 0x56, 0x00, 0xb6, 0x25, 0x7e, 0xb1, 0x87, 0x50, 0xfc, 0x98,
-	0xda, 0x55, 0xc7, 0x34, 0x56, 0x60, 0x39, 0xae, 0x45, 0xbc, 0xf2, 0xff, 0xa7, 0xc1, 0x22, 0x67,
-	0x24, 0x7a, 0x23, 0xa9, 0xc6, 0xf9, 0x08, 0x1a, 0x8a, 0x71, 0xd4, 0xca, 0xab, 0x1e, 0x99, 0x87,
-	0x97, 0x60, 0x31, 0x51, 0xd1, 0x68, 0xc9, 0x26, 0x44, 0xf7, 0x13, 0x1d, 0x97, 0x9c, 0x5a, 0x4f,
-	0x75, 0xa0, 0xee, 0xb3, 0x8d, 0x59, 0xae, 0xd7, 0x1b, 0x8c, 0x1d, 0x1c, 0x35, 0xbe, 0x12, 0xf7,
-	0xa6, 0xec, 0xa3, 0x74, 0x84, 0x9c, 0x59, 0xe3, 0x13, 0xe5, 0xd8, 0x78, 0x25, 0x13, 0xca, 0x35,
-	0xdb, 0x33, 0x71, 0xb5, 0x97, 0xb5, 0x67, 0xfe, 0x92, 0x85, 0x5a, 0x5c, 0x3a, 0xc5, 0x81, 0xb5,
-	0x2b, 0x1c, 0x38, 0x33, 0xab, 0x4e, 0xc8, 0x5e, 0xaf, 0x4e, 0x88, 0x5f, 0xfc, 0xb9, 0x39, 0x5c,
-	0xfc, 0xf9, 0x39, 0x5c, 0xfc, 0x85, 0xf9, 0x27, 0xd2, 0xe2, 0xed, 0x13, 0x69, 0x69, 0x46, 0x22,
-	0x35, 0x7e, 0x02, 0x2b, 0xe9, 0xde, 0x84, 0x74, 0x28, 0x4d, 0xa6, 0x6b, 0xbc, 0x00, 0x96, 0x63,
-	0x23, 0x84, 0xa6, 0x92, 0xdc, 0xe2, 0x1d, 0xca, 0xbb, 0xca, 0x70, 0xc6, 0x73, 0xb8, 0x97, 0xb2,
-	0xa8, 0xf0, 0xea, 0x1b, 0xe6, 0x85, 0x89, 0xae, 0x27, 0xae, 0xe7, 0x86, 0xaf, 0xe3, 0x27, 0xb8,
-	0xa1, 0xae, 0x75, 0xd0, 0xd3, 0x74, 0x89, 0x4c, 0xf3, 0xff, 0x0c, 0x54, 0xba, 0x36, 0x91, 0xf3,
-	0xee, 0xee, 0x02, 0xb8, 0x55, 0x43, 0xaf, 0x03, 0x0b, 0x2c, 0x26, 0x68, 0x0a, 0x77, 0x6c, 0x82,
-	0x6f, 0x14, 0x0a, 0x55, 0x39, 0xb5, 0x6d, 0x13, 0x8c, 0x8e, 0xa0, 0x1e, 0xb5, 0xe9, 0xb8, 0xb2,
-	0x9b, 0xc4, 0x44, 0x2d, 0x9a, 0xcc, 0xd4, 0x3d, 0x82, 0xa5, 0xd0, 0x26, 0x78, 0x30, 0x70, 0x59,
-	0x35, 0xd6, 0xf7, 0x6c, 0x32, 0x0e, 0x44, 0x4c, 0x98, 0x68, 0xc2, 0xea, 0x4a, 0xce, 0xce, 0x1f,
-	0xaa, 0x50, 0x3a, 0x12, 0x99, 0x03, 0x1d, 0x41, 0x95, 0x37, 0xfa, 0xc5, 0x4f, 0xb4, 0x8d, 0x64,
-	0x33, 0x3a, 0xf6, 0x73, 0x45, 0xff, 0x60, 0x16, 0x5b, 0x78, 0x59, 0x1b, 0xca, 0x4f, 0x31, 0x11,
-	0xba, 0xf4, 0xa4, 0x70, 0xf4, 0x43, 0x40, 0x5f, 0x4b, 0xe5, 0x09, 0x2d, 0x47, 0x50, 0xe5, 0x4e,
-	0x32, 0x6b, 0x53, 0x31, 0x77, 0x9c, 0xde, 0x54, 0xc2, 0xf5, 0x9f, 0x41, 0x85, 0x06, 0x2f, 0xe7,
-	0x85, 0x68, 0x2d, 0xad, 0xdf, 0x2e, 0x75, 0xad, 0xa7, 0x33, 0x85, 0x26, 0x0c, 0xcb, 0x5d, 0x79,
-	0x3c, 0xe5, 0x05, 0x8a, 0x1e, 0x24, 0x67, 0xa5, 0xbe, 0x7e, 0xf5, 0x87, 0x57, 0x89, 0x89, 0x65,
-	0x9e, 0x43, 0x85, 0x85, 0xb0, 0x78, 0x79, 0xae, 0x27, 0x13, 0xbd, 0x5a, 0xff, 0xea, 0x1b, 0x33,
-	0xb8, 0x11, 0x96, 0xfc, 0x6a, 0x17, 0xca, 0xa6, 0xc4, 0x63, 0xe5, 0x83, 0x8a, 0x65, 0x5a, 0x5d,
-	0x20, 0xb1, 0xe4, 0xbc, 0x18, 0x96, 0x53, 0xd5, 0x82, 0xbe, 0x9e, 0xce, 0x14, 0x9a, 0xbe, 0x86,
-	0x45, 0x25, 0x4f, 0x89, 0xdd, 0x19, 0xa9, 0x87, 0x89, 0x9b, 0xfb, 0xfe, 0xa5, 0x32, 0x42, 0xbb,
-	0x05, 0x48, 0xcd, 0x36, 0x42, 0xfd, 0xd4, 0xd4, 0x94, 0xec, 0xa6, 0xff, 0xe8, 0x72, 0x21, 0xb1,
-	0xc0, 0x4b, 0x68, 0x70, 0xdf, 0x17, 0xef, 0xbe, 0x93, 0x81, 0x83, 0x94, 0x0a, 0x24, 0xfd, 0xd7,
-	0x84, 0xfe, 0xe1, 0x2c, 0x89, 0xa8, 0xe9, 0xf3, 0x25, 0x34, 0x38, 0xe6, 0x8a, 0xe2, 0xe9, 0x69,
-	0xc9, 0xee, 0xb7, 0x6e, 0xcc, 0x14, 0x89, 0x54, 0x77, 0xa1, 0xa6, 0xf4, 0x24, 0x59, 0x33, 0x66,
-	0x6a, 0x56, 0xbc, 0x19, 0xaa, 0x6f, 0xcd, 0x10, 0x88, 0x94, 0x5a, 0x80, 0x64, 0x43, 0x58, 0xd9,
-	0xf1, 0xfd, 0xa9, 0x79, 0xd3, 0x9d, 0x69, 0x15, 0xe9, 0x4b, 0x5a, 0xcb, 0x5f, 0x42, 0x83, 0x63,
-	0x7f, 0x29, 0x20, 0xc9, 0xee, 0x68, 0x0a, 0x20, 0xd3, 0xed, 0xcd, 0x2f, 0xa0, 0xae, 0x76, 0xd2,
-	0x12, 0x36, 0x4c, 0x6f, 0x3a, 0xaa, 0x36, 0x9c, 0xd5, 0xb8, 0xfb, 0x1a, 0x16, 0xe3, 0xa1, 0x4d,
-	0x89, 0xb1, 0x0d, 0xa5, 0x37, 0xc7, 0xf4, 0xfb, 0xb3, 0x65, 0x22, 0xed, 0xcf, 0xa1, 0xa2, 0xb4,
-	0xb3, 0xd4, 0xf4, 0x30, 0xdd, 0xfb, 0x52, 0xd3, 0x43, 0x4a, 0x0f, 0x6c, 0x2f, 0xf7, 0x55, 0x66,
-	0x74, 0x76, 0x56, 0x60, 0x37, 0xce, 0xa7, 0x3f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x19, 0x04,
-	0xf0, 0xd2, 0x21, 0x00, 0x00,
+	// 3079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x5f, 0x6f, 0xe3, 0xc6,
+	0xf1, 0xa1, 0x64, 0xc9, 0xd2, 0x48, 0x96, 0xe4, 0xb5, 0xe3, 0x93, 0x69, 0xfb, 0xec, 0xf0, 0xfe,
+	0xfc, 0x9c, 0x20, 0xf1, 0xfd, 0x70, 0x49, 0x80, 0xa2, 0x49, 0xd3, 0xd8, 0x96, 0x73, 0xa7, 0xab,
+	0xed, 0x73, 0xa9, 0xdc, 0x25, 0x0d, 0xd2, 0x12, 0xb4, 0xb8, 0xd6, 0xb1, 0x27, 0x91, 0x2a, 0xb9,
+	0xba, 0xb3, 0x83, 0x3e, 0x15, 0x05, 0x8a, 0x3e, 0xb5, 0x0f, 0x7d, 0x4e, 0xbf, 0x41, 0x5f, 0xfb,
+	0xd6, 0xd7, 0x16, 0x2d, 0xd0, 0x87, 0xa2, 0x68, 0x81, 0x16, 0x48, 0x81, 0x7e, 0x80, 0x7e, 0x86,
+	0x62, 0xff, 0x90, 0x5c, 0x52, 0x94, 0x64, 0xdd, 0xc9, 0x45, 0xd1, 0x37, 0xee, 0xcc, 0xec, 0xcc,
+	0xce, 0xec, 0xcc, 0xec, 0xec, 0x2c, 0xa1, 0xd2, 0xc3, 0xc4, 0xb4, 0x9d, 0x33, 0x77, 0xa7, 0xef,
+	0xb9, 0xc4, 0x45, 0x85, 0x60, 0xac, 0xd6, 0xb0, 0xd3, 0xf6, 0x2e, 0xfa, 0xc4, 0x76, 0x1d, 0x8e,
+	0x53, 0xa1, 0xe3, 0x76, 0x04, 0x9d, 0xba, 0xd9, 0x71, 0xdd, 0x4e, 0x17, 0xdf, 0x61, 0xa3, 0xd3,
+	0xc1, 0xd9, 0x1d, 0x62, 0xf7, 0xb0, 0x4f, 0xcc, 0x5e, 0x3f, 0x20, 0x76, 0x5c, 0x0b, 0x8b, 0xef,
+	0x6a, 0xdf, 0xb5, 0x1d, 0x82, 0x3d, 0xeb, 0x54, 0x00, 0xca, 0xae, 0x67, 0x61, 0xcf, 0xe7, 0x23,
+	0xed, 0x27, 0x39, 0xc8, 0xef, 0x0d, 0xda, 0x4f, 0x31, 0x41, 0x08, 0xe6, 0x1c, 0xb3, 0x87, 0xeb,
+	0xca, 0x96, 0xb2, 0x5d, 0xd6, 0xd9, 0x37, 0xfa, 0x1a, 0x94, 0xfa, 0x26, 0x79, 0x62, 0xb4, 0xed,
+	0xfe, 0x13, 0xec, 0xd5, 0x33, 0x5b, 0xca, 0x76, 0xe5, 0xee, 0xb5, 0x1d, 0x69, 0x79, 0xfb, 0x0c,
+	0xd3, 0x1a, 0xd8, 0x04, 0xeb, 0x40, 0x69, 0x39, 0x00, 0xed, 0x03, 0xb4, 0x3d, 0x6c, 0x12, 0x6c,
+	0x19, 0x26, 0xa9, 0x67, 0xb7, 0x94, 0xed, 0xd2, 0x5d, 0x75, 0x87, 0xaf, 0x7c, 0x27, 0x58, 0xf9,
+	0xce, 0xc7, 0xc1, 0xca, 0xf7, 0x0a, 0xbf, 0xfb, 0x6a, 0xf3, 0x95, 0x9f, 0xff, 0x63, 0x53, 0xd1,
+	0x8b, 0x62, 0xde, 0x2e, 0x41, 0xff, 0x0f, 0xcb, 0x16, 0x3e, 0x33, 0x07, 0x5d, 0x62, 0xf8, 0xb8,
+	0xd3, 0xc3, 0x0e, 0x31, 0x7c, 0xfb, 0x0b, 0x5c, 0x9f, 0xdb, 0x52, 0xb6, 0xb3, 0x3a, 0x12, 0xb8,
+	0x16, 0x47, 0xb5, 0xec, 0x2f, 0x30, 0xfa, 0x04, 0x56, 0x83, 0x19, 0x1e, 0xb6, 0x06, 0x8e, 0x65,
+	0x3a, 0xed, 0x0b, 0xc3, 0x6f, 0x3f, 0xc1, 0x3d, 0x5c, 0xcf, 0xb1, 0x55, 0xac, 0xed, 0x44, 0x26,
+	0xd1, 0x43, 0x9a, 0x16, 0x23, 0xd1, 0xaf, 0x89, 0xd9, 0x49, 0x04, 0xb2, 0x60, 0x23, 0x60, 0x1c,
+	0x69, 0x6f, 0xf4, 0x4d, 0xcf, 0xec, 0x61, 0x82, 0x3d, 0xbf, 0x9e, 0x67, 0xcc, 0xb7, 0x64, 0xdb,
+	0x1c, 0x84, 0x9f, 0x27, 0x21, 0x9d, 0xbe, 0x26, 0xd8, 0xa4, 0x21, 0xd1, 0xd7, 0x01, 0x9e, 0x61,
+	0xcf, 0xb7, 0x5d, 0xc7, 0x76, 0x3a, 0xf5, 0x79, 0x66, 0x6e, 0x75, 0x27, 0xf4, 0x13, 0xbe, 0x53,
+	0x8f, 0x43, 0x0a, 0x5d, 0xa2, 0x46, 0xef, 0x42, 0xb1, 0x6b, 0x9f, 0xe1, 0xf6, 0x45, 0xbb, 0x8b,
+	0xeb, 0x85, 0xad, 0xec, 0x76, 0xe9, 0xee, 0xb5, 0x68, 0xea, 0x61, 0x80, 0xd2, 0x07, 0x5d, 0xac,
+	0x47, 0x94, 0xe8, 0x3e, 0x2c, 0x4a, 0x96, 0xea, 0xbb, 0x5d, 0xbb, 0x7d, 0x51, 0x2f, 0x4e, 0xb6,
+	0x54, 0x2d, 0x9a, 0x75, 0xc2, 0x26, 0xa1, 0xf7, 0xa1, 0xd8, 0xef, 0x9a, 0x6d, 0x4c, 0x37, 0xa3,
+	0x0e, 0x8c, 0xc3, 0xf5, 0x68, 0x01, 0x27, 0x01, 0x6a, 0xdf, 0x75, 0x7c, 0xe2, 0x99, 0xb6, 0x43,
+	0x7c, 0x3d, 0x9a, 0xa0, 0xfd, 0x42, 0x81, 0xe5, 0x34, 0x1a, 0x74, 0x03, 0x16, 0xda, 0xee, 0xc0,
+	0x21, 0xde, 0x85, 0xd1, 0x76, 0x2d, 0xec, 0xd7, 0x95, 0xad, 0xec, 0x76, 0x51, 0x2f, 0x0b, 0xe0,
+	0x3e, 0x85, 0xa1, 0x77, 0x60, 0x05, 0x9f, 0xb7, 0xbb, 0x03, 0x0b, 0x5b, 0x46, 0x9c, 0x3a, 0xc3,
+	0xa8, 0x97, 0x03, 0xec, 0xbe, 0x3c, 0xeb, 0x35, 0x28, 0x5b, 0xb6, 0x4f, 0x6c, 0xa7, 0x4d, 0x0c,
+	0xd3, 0x77, 0x98, 0x9b, 0x16, 0xf4, 0x52, 0x00, 0xdb, 0xf5, 0x1d, 0xed, 0x2b, 0x05, 0x16, 0x62,
+	0xb6, 0x43, 0x15, 0xc8, 0xd8, 0x16, 0x8b, 0x92, 0xa2, 0x9e, 0xb1, 0x2d, 0xf4, 0x3a, 0x04, 0xe1,
+	0x8a, 0x2d, 0xa3, 0xef, 0xe1, 0x33, 0xfb, 0x9c, 0x05, 0x4a, 0x59, 0xaf, 0x86, 0xf0, 0x13, 0x06,
+	0x46, 0x2a, 0x14, 0x2c, 0xdb, 0x37, 0x4f, 0xbb, 0xd8, 0x12, 0xb2, 0xc2, 0x31, 0x7a, 0x03, 0x16,
+	0xf1, 0x79, 0xdf, 0xf6, 0xb0, 0x61, 0x9e, 0x11, 0xec, 0x19, 0x96, 0x79, 0xe1, 0x33, 0x47, 0xcf,
+	0xe9, 0x55, 0x8e, 0xd8, 0xa5, 0xf0, 0x86, 0x79, 0xe1, 0xa3, 0x07, 0xa0, 0x99, 0xa7, 0xae, 0x47,
+	0x0c, 0xdb, 0x69, 0xbb, 0xbd, 0x7e, 0x17, 0x13, 0x6c, 0x0c, 0xfa, 0x5d, 0xd7, 0xb4, 0xe4, 0xc9,
+	0x39, 0x36, 0xf9, 0x3a, 0xa3, 0x6c, 0x86, 0x84, 0x8f, 0x18, 0x5d, 0xc8, 0x4b, 0xfb, 0x10, 0xaa,
+	0xdc, 0xad, 0x42, 0x2d, 0xd1, 0x5b, 0x90, 0xf3, 0x06, 0x5d, 0x61, 0xe9, 0x31, 0x5e, 0xc4, 0xa9,
+	0x34, 0x1b, 0x2a, 0x01, 0x07, 0x9f, 0x34, 0x09, 0xee, 0xa5, 0xa6, 0x92, 0x78, 0x42, 0xc8, 0xbc,
+	0x50, 0x42, 0xd0, 0xfe, 0x9c, 0x81, 0x25, 0x2e, 0x6b, 0x9f, 0xc1, 0x74, 0xfc, 0x83, 0x01, 0xf6,
+	0x67, 0x9d, 0xbb, 0x46, 0xa5, 0x9d, 0xec, 0x8b, 0xa5, 0x9d, 0xb9, 0xab, 0x4c, 0x3b, 0xb9, 0x19,
+	0xa4, 0x1d, 0xed, 0x43, 0x58, 0x8e, 0x5b, 0xd5, 0xef, 0xbb, 0x8e, 0x8f, 0xd1, 0x36, 0xe4, 0x4f,
+	0x19, 0x9c, 0x19, 0xb6, 0x74, 0xb7, 0x96, 0x4c, 0x45, 0xba, 0xc0, 0x6b, 0xb7, 0xa1, 0xc6, 0x21,
+	0xf7, 0x30, 0x19, 0xb3, 0x29, 0xda, 0x37, 0x60, 0x51, 0xa2, 0x9b, 0x5a, 0xcc, 0xeb, 0xc1, 0xf6,
+	0x37, 0x30, 0xf5, 0xe5, 0x71, 0x92, 0x56, 0x60, 0x39, 0x4e, 0xca, 0x85, 0x69, 0x06, 0x2c, 0x46,
+	0xde, 0x1a, 0x30, 0x58, 0x81, 0x7c, 0x7b, 0xe0, 0xf9, 0xae, 0x27, 0x58, 0x88, 0x11, 0x5a, 0x86,
+	0x5c, 0xd7, 0xee, 0xd9, 0xdc, 0x5f, 0x73, 0x3a, 0x1f, 0xa0, 0x75, 0x28, 0x5a, 0xb6, 0x87, 0xdb,
+	0xd4, 0x8a, 0xcc, 0x29, 0x72, 0x7a, 0x04, 0xd0, 0x3e, 0x05, 0x24, 0x0b, 0x10, 0x3a, 0xee, 0x40,
+	0xce, 0x26, 0xb8, 0x17, 0xc4, 0x54, 0x3d, 0xa9, 0x62, 0x10, 0x3b, 0x3a, 0x27, 0xa3, 0x2a, 0xf5,
+	0x5c, 0x0f, 0x33, 0xc1, 0x05, 0x9d, 0x7d, 0x6b, 0x9f, 0xc2, 0x1a, 0x27, 0x6e, 0x61, 0xb2, 0x4b,
+	0x88, 0x67, 0x9f, 0x0e, 0xa8, 0xc4, 0x71, 0x41, 0x70, 0x0b, 0x2a, 0x66, 0x44, 0x69, 0xd8, 0x96,
+	0x48, 0x4d, 0x0b, 0x12, 0xb4, 0x69, 0x69, 0xd7, 0x61, 0x3d, 0x9d, 0xb3, 0x30, 0x5a, 0x17, 0xd4,
+	0x10, 0x2f, 0x1d, 0x3f, 0x63, 0x04, 0xc7, 0x4f, 0xb2, 0xcc, 0x34, 0x27, 0x99, 0xb6, 0x21, 0xe9,
+	0x29, 0x4b, 0x13, 0x8b, 0xf9, 0x1e, 0xac, 0x86, 0xe8, 0x28, 0x21, 0x8d, 0x59, 0x4b, 0x98, 0xcf,
+	0x32, 0x97, 0xca, 0x67, 0xeb, 0x92, 0xb2, 0x12, 0x7f, 0x21, 0xbd, 0x27, 0x49, 0x0f, 0xcf, 0xab,
+	0x71, 0xd2, 0x63, 0xc7, 0x62, 0x66, 0xda, 0x63, 0x51, 0x5e, 0x8c, 0x24, 0x4e, 0x2c, 0xe6, 0xc7,
+	0x0a, 0x2c, 0xed, 0x5a, 0x96, 0x87, 0x7d, 0x1f, 0x5b, 0x0f, 0x69, 0x61, 0x77, 0xc8, 0x3c, 0x74,
+	0x3b, 0xf0, 0x5b, 0x1e, 0x50, 0x68, 0x47, 0x14, 0x7d, 0x11, 0x49, 0xe0, 0xcb, 0xfb, 0xb0, 0xec,
+	0x13, 0xd7, 0x33, 0x3b, 0xd8, 0x70, 0x5c, 0x0b, 0x1b, 0x26, 0xe7, 0x26, 0x16, 0xba, 0xb8, 0x43,
+	0x81, 0x3b, 0xc7, 0xae, 0x85, 0x85, 0x18, 0x1d, 0x09, 0x72, 0x09, 0xa6, 0x7d, 0x99, 0x81, 0x15,
+	0x91, 0x0e, 0x3f, 0xf1, 0xec, 0x30, 0x2e, 0x1f, 0x76, 0x2d, 0x1a, 0x59, 0x52, 0x6c, 0x97, 0x83,
+	0x48, 0xa6, 0x96, 0xa2, 0x19, 0x57, 0xb8, 0x23, 0xfb, 0x46, 0x75, 0x98, 0x17, 0xf9, 0x56, 0xa4,
+	0xda, 0x60, 0x88, 0xde, 0x03, 0x88, 0xf2, 0xea, 0x65, 0x12, 0xaa, 0x44, 0x8e, 0xde, 0x03, 0xb5,
+	0x67, 0x9e, 0x1b, 0xd1, 0x21, 0x1d, 0x4b, 0xea, 0x39, 0x26, 0xe9, 0x5a, 0xcf, 0x3c, 0x3f, 0x08,
+	0x08, 0xe4, 0xcc, 0xde, 0x00, 0x60, 0xa7, 0xaf, 0xc9, 0x82, 0x3d, 0x3f, 0xc5, 0xb1, 0x25, 0xcd,
+	0xd3, 0xfe, 0xa4, 0xc0, 0xb5, 0xb8, 0x81, 0xf8, 0x06, 0x52, 0x0b, 0xdd, 0x87, 0x9a, 0x19, 0x6c,
+	0xa1, 0xc1, 0x36, 0x25, 0x48, 0x12, 0x1b, 0x91, 0x9b, 0xa4, 0x6c, 0xb2, 0x5e, 0x0d, 0xa7, 0xb1,
+	0xb1, 0x8f, 0xde, 0x86, 0x05, 0xcf, 0x75, 0x89, 0xd1, 0xb7, 0x71, 0x1b, 0x87, 0xb1, 0xbe, 0x57,
+	0xa5, 0x4b, 0xfa, 0xdb, 0x57, 0x9b, 0xf3, 0x27, 0x14, 0xde, 0x6c, 0xe8, 0x25, 0x4a, 0xc5, 0x07,
+	0x16, 0x3b, 0x26, 0x3d, 0xfb, 0x99, 0x49, 0xb0, 0xf1, 0x14, 0x5f, 0x30, 0xc3, 0x97, 0xf7, 0xae,
+	0x89, 0x29, 0x55, 0x46, 0x75, 0xc2, 0xf1, 0xdf, 0xc2, 0x17, 0x3a, 0xf4, 0xc3, 0x6f, 0xed, 0xf7,
+	0x91, 0x52, 0xfb, 0x6e, 0x8f, 0xae, 0x68, 0xd6, 0xdb, 0xfe, 0x26, 0xcc, 0x8b, 0x3d, 0x16, 0x7b,
+	0x8e, 0xa4, 0x3d, 0x3f, 0xe1, 0x5f, 0x7a, 0x40, 0x82, 0xde, 0x83, 0xaa, 0xeb, 0xd9, 0x1d, 0xdb,
+	0x31, 0xbb, 0x81, 0x1d, 0x73, 0x5b, 0xd9, 0x11, 0xee, 0x5f, 0x09, 0x48, 0xd9, 0xd0, 0xd7, 0xee,
+	0x43, 0x3d, 0xa1, 0x4b, 0xb4, 0x43, 0xd2, 0x32, 0x94, 0x89, 0xcb, 0xd0, 0x7e, 0xa5, 0xc0, 0xaa,
+	0x60, 0xd5, 0x70, 0x9f, 0x3b, 0xb4, 0xda, 0x9a, 0xb9, 0x61, 0x36, 0xc2, 0xec, 0x4a, 0xb7, 0x79,
+	0x8e, 0xd5, 0xa2, 0x45, 0x01, 0x69, 0xd2, 0x05, 0xe7, 0x3c, 0xd3, 0xe9, 0x04, 0x37, 0x9e, 0x95,
+	0xc8, 0x8f, 0xc4, 0xc2, 0x74, 0x8a, 0xd5, 0x39, 0x91, 0xf6, 0x01, 0x94, 0x65, 0x30, 0x5d, 0xa2,
+	0x7b, 0x76, 0xe6, 0x8b, 0x25, 0x66, 0x75, 0x31, 0xa2, 0xf0, 0x2e, 0x76, 0x3a, 0x62, 0x91, 0x59,
+	0x5d, 0x8c, 0xb4, 0x3f, 0x2a, 0xa0, 0x0e, 0x29, 0x7c, 0x15, 0xfe, 0x2d, 0xed, 0x43, 0x66, 0xb2,
+	0x3b, 0xbc, 0xb8, 0x63, 0xff, 0x10, 0x5e, 0x15, 0xfa, 0x34, 0x9d, 0x33, 0xf7, 0x3f, 0xbc, 0x79,
+	0xda, 0x47, 0xb0, 0x12, 0x93, 0x9e, 0xea, 0x87, 0x93, 0xf5, 0xd7, 0x8c, 0x30, 0x3a, 0x63, 0xc5,
+	0xd2, 0xcc, 0xf4, 0xd0, 0xbe, 0x54, 0xa0, 0x9e, 0x90, 0x70, 0x15, 0xbb, 0x9e, 0xd8, 0xc7, 0xcc,
+	0xe5, 0xf7, 0xf1, 0xef, 0x0a, 0xac, 0xd0, 0xba, 0x4a, 0x2c, 0xd2, 0xbf, 0x84, 0x05, 0x56, 0x20,
+	0x1f, 0xbb, 0xc2, 0x89, 0x11, 0xda, 0x84, 0x92, 0x4f, 0x4c, 0x8f, 0xf0, 0xfb, 0x15, 0x77, 0x26,
+	0x1d, 0x18, 0x88, 0x5d, 0xa5, 0xe8, 0xa6, 0x62, 0xc7, 0x32, 0x4e, 0xf1, 0x19, 0xad, 0xda, 0xe6,
+	0x18, 0xbe, 0x88, 0x1d, 0x6b, 0x8f, 0x01, 0x68, 0xc9, 0xe8, 0x61, 0x5a, 0x54, 0xda, 0xcf, 0x78,
+	0x54, 0x16, 0xf4, 0x08, 0x10, 0x95, 0x99, 0x79, 0xb9, 0xcc, 0xdc, 0x00, 0xa0, 0x96, 0x32, 0xce,
+	0xba, 0x66, 0xc7, 0x67, 0xcd, 0x80, 0x79, 0xbd, 0x48, 0x21, 0x1f, 0x51, 0x00, 0x3b, 0x53, 0xe2,
+	0xda, 0x45, 0xd6, 0x7f, 0x3f, 0x5e, 0x6d, 0xde, 0x96, 0x2b, 0x9e, 0xd4, 0x19, 0x3b, 0x13, 0x6a,
+	0x4f, 0x15, 0xc3, 0x5c, 0x70, 0xb5, 0x63, 0x2e, 0xa2, 0x48, 0x2e, 0x32, 0x5d, 0x5c, 0xae, 0x41,
+	0xd1, 0xf6, 0x83, 0x8b, 0xb2, 0xb8, 0x05, 0xdb, 0x3e, 0xbf, 0x21, 0x6b, 0x9f, 0x41, 0x3d, 0x59,
+	0x82, 0x86, 0x7b, 0xb6, 0x09, 0x25, 0xbe, 0x4b, 0x86, 0x54, 0x63, 0x01, 0x07, 0x1d, 0xd3, 0x4a,
+	0x6b, 0x03, 0xa0, 0x6f, 0x7a, 0xc4, 0xc1, 0x5e, 0x54, 0xe8, 0x16, 0x05, 0xa4, 0x69, 0x69, 0x6b,
+	0xb0, 0x9a, 0xe4, 0x1d, 0xea, 0xaf, 0x2d, 0x03, 0x3a, 0xf1, 0xdc, 0xef, 0xe3, 0xb6, 0x1c, 0xf3,
+	0xda, 0x8f, 0x14, 0x58, 0x8a, 0x81, 0xf9, 0x04, 0xda, 0x38, 0xe8, 0x73, 0xb0, 0xe1, 0x9b, 0xdd,
+	0xc0, 0x89, 0x4a, 0x02, 0xd6, 0x32, 0xbb, 0x24, 0xbd, 0xaf, 0x92, 0x79, 0x81, 0xbe, 0x8a, 0xf6,
+	0xd3, 0x79, 0xc8, 0x3f, 0x3c, 0xa5, 0x8c, 0x47, 0xba, 0xed, 0x2d, 0xa8, 0x48, 0x3d, 0x88, 0x28,
+	0x84, 0x17, 0x42, 0xe8, 0x89, 0x88, 0x65, 0x91, 0x67, 0xc4, 0xb5, 0x25, 0x18, 0xa2, 0x3b, 0x90,
+	0xf7, 0x89, 0x49, 0x06, 0xbc, 0xe5, 0x50, 0x91, 0x6b, 0x64, 0x2e, 0x7a, 0xa7, 0xc5, 0xd0, 0xba,
+	0x20, 0x43, 0x6f, 0x41, 0xd1, 0x27, 0x1e, 0x36, 0x7b, 0xd4, 0xd4, 0x39, 0x16, 0x93, 0x35, 0x11,
+	0x93, 0x85, 0x16, 0x43, 0x34, 0x1b, 0x7a, 0x81, 0x93, 0x34, 0xad, 0xc4, 0xed, 0x3f, 0xff, 0x62,
+	0xed, 0xc0, 0x5d, 0x28, 0x72, 0xe9, 0x94, 0xc7, 0xfc, 0x14, 0x3c, 0x0a, 0x7c, 0xda, 0x2e, 0x2d,
+	0x77, 0x79, 0x59, 0x86, 0x19, 0x8f, 0xc2, 0x34, 0xeb, 0x10, 0xf3, 0x76, 0x09, 0xba, 0x07, 0xf5,
+	0xc8, 0xda, 0xd4, 0x4e, 0x96, 0x49, 0x4c, 0xc3, 0x71, 0x9d, 0x36, 0x66, 0x9d, 0xb3, 0xf2, 0xde,
+	0x82, 0x30, 0x45, 0xee, 0x98, 0x02, 0xf5, 0x95, 0x90, 0xfc, 0x48, 0x50, 0x33, 0x38, 0x7a, 0x0b,
+	0xd0, 0x30, 0x23, 0xd6, 0x3a, 0x2b, 0xeb, 0x8b, 0x43, 0x73, 0xd0, 0x9b, 0x80, 0xce, 0xec, 0xf3,
+	0x64, 0x01, 0x5b, 0x62, 0x59, 0xb9, 0xc6, 0x30, 0x72, 0xe5, 0x1a, 0x77, 0x40, 0xd1, 0x8b, 0x28,
+	0x4f, 0xe5, 0x80, 0x1c, 0x82, 0x1e, 0xc1, 0xab, 0xe9, 0xcd, 0x87, 0x85, 0x4b, 0x36, 0x1f, 0x96,
+	0x71, 0x0a, 0x94, 0x86, 0x2b, 0x71, 0x89, 0xd9, 0xe5, 0x6a, 0x54, 0x98, 0x1a, 0x45, 0x06, 0x61,
+	0xeb, 0xdf, 0x84, 0x92, 0xed, 0x74, 0x6d, 0x07, 0x73, 0x7c, 0x95, 0xe1, 0x81, 0x83, 0x02, 0x02,
+	0x0f, 0xf7, 0x5c, 0x22, 0x08, 0x6a, 0x9c, 0x80, 0x83, 0x28, 0x81, 0xf6, 0x6d, 0xc8, 0x73, 0xaf,
+	0x45, 0x25, 0x98, 0x6f, 0x1e, 0x3f, 0xde, 0x3d, 0x6c, 0x36, 0x6a, 0xaf, 0xa0, 0x05, 0x28, 0x3e,
+	0x3a, 0x39, 0x7c, 0xb8, 0xdb, 0x68, 0x1e, 0xdf, 0xab, 0x29, 0xa8, 0x02, 0xb0, 0xff, 0xf0, 0xe8,
+	0xa8, 0xf9, 0xf1, 0xc7, 0x74, 0x9c, 0xa1, 0x68, 0x31, 0x3e, 0x68, 0xd4, 0xb2, 0xa8, 0x0c, 0x85,
+	0xc6, 0xc1, 0xe1, 0x01, 0x43, 0xce, 0x69, 0x7f, 0xc8, 0x02, 0xe2, 0x01, 0xb1, 0x87, 0x3b, 0xb6,
+	0x23, 0xf5, 0x0f, 0xae, 0x26, 0x2e, 0xe3, 0xfe, 0x3a, 0x37, 0x7b, 0x7f, 0xcd, 0xbd, 0xbc, 0xbf,
+	0xe6, 0x47, 0xf9, 0x6b, 0xaa, 0x07, 0xce, 0xcf, 0xd4, 0x03, 0x0b, 0x2f, 0xe3, 0x81, 0xda, 0x6f,
+	0x32, 0xb0, 0x14, 0xdb, 0x4d, 0x91, 0xde, 0xaf, 0x6c, 0x3b, 0x63, 0x59, 0x73, 0x6e, 0x62, 0xd6,
+	0x4c, 0x35, 0x60, 0x6e, 0xa6, 0x06, 0xcc, 0xbf, 0x94, 0x01, 0x1b, 0x81, 0xfd, 0x62, 0x17, 0xc0,
+	0xb8, 0x9a, 0xca, 0x24, 0x35, 0x69, 0xab, 0x2e, 0xce, 0x45, 0x74, 0x37, 0xfe, 0xa9, 0xc0, 0x22,
+	0x47, 0x24, 0x7a, 0x75, 0xa9, 0x9b, 0x33, 0x45, 0x1f, 0x3e, 0x46, 0x2a, 0x1a, 0x7f, 0xd9, 0x04,
+	0xe9, 0x7e, 0xa2, 0x03, 0x38, 0x27, 0x97, 0x66, 0x4d, 0xa8, 0xba, 0x6c, 0x61, 0xb4, 0x03, 0x4f,
+	0xdf, 0x15, 0xa2, 0x46, 0x6c, 0xe2, 0xdc, 0x0c, 0xfa, 0x7a, 0x4d, 0x41, 0xa7, 0x57, 0xf8, 0xc4,
+	0x60, 0x4c, 0xdb, 0x85, 0xb2, 0x8e, 0x13, 0xdb, 0x85, 0x71, 0xb6, 0xe3, 0xda, 0x85, 0xbf, 0xcd,
+	0x42, 0x25, 0x4e, 0x9d, 0xe2, 0xc0, 0xca, 0x04, 0x07, 0xce, 0x8c, 0xaa, 0x13, 0xb2, 0x97, 0xab,
+	0x13, 0xe2, 0x07, 0xff, 0xdc, 0x0c, 0x0e, 0xfe, 0xdc, 0x0c, 0x0e, 0xfe, 0xfc, 0xec, 0x13, 0xe9,
+	0xfc, 0xcb, 0x27, 0xd2, 0xc2, 0x88, 0x44, 0xaa, 0xbd, 0x03, 0x2b, 0xe9, 0xde, 0x44, 0x5f, 0x94,
+	0xc2, 0xe9, 0x0a, 0xaf, 0xa5, 0x83, 0xb1, 0xe6, 0x43, 0x5d, 0x4a, 0x6e, 0xf1, 0x8e, 0xf9, 0x55,
+	0x65, 0x38, 0xed, 0x01, 0xac, 0xa6, 0x08, 0x15, 0x5e, 0x3d, 0x65, 0x5e, 0x08, 0x79, 0x7d, 0x64,
+	0x3b, 0xb6, 0xff, 0x24, 0xae, 0xc1, 0x94, 0xbc, 0xd6, 0x41, 0x4d, 0xe3, 0x25, 0x32, 0x8d, 0x0e,
+	0x55, 0x51, 0x3a, 0x85, 0x87, 0xd7, 0x0d, 0x58, 0x08, 0xca, 0x2c, 0xdb, 0xb1, 0xf0, 0xb9, 0x68,
+	0x86, 0x94, 0xfd, 0xe0, 0x6e, 0x6e, 0xe1, 0xf3, 0x98, 0xf9, 0xb9, 0xa1, 0x22, 0xf3, 0xff, 0x25,
+	0xcc, 0x5e, 0xfb, 0x6e, 0xff, 0x62, 0x46, 0x86, 0xdf, 0x00, 0x70, 0xf0, 0x73, 0x43, 0xb0, 0xe0,
+	0x39, 0xab, 0xe8, 0xe0, 0xe7, 0xe2, 0x0d, 0xff, 0x4d, 0x40, 0x14, 0x9d, 0xe0, 0xc4, 0x6f, 0xa3,
+	0x35, 0x07, 0x3f, 0x3f, 0x88, 0x31, 0x7b, 0x17, 0x0a, 0x42, 0x9b, 0xa0, 0x53, 0xb6, 0x3a, 0xd4,
+	0x29, 0x0a, 0xec, 0xa1, 0x87, 0xa4, 0xf4, 0xaa, 0x24, 0xeb, 0x25, 0x4c, 0x18, 0xa9, 0x7b, 0xe4,
+	0x3e, 0xc3, 0xff, 0x8b, 0xea, 0x72, 0xbd, 0x84, 0xba, 0x7f, 0x55, 0x02, 0xb0, 0x8e, 0x9f, 0xe2,
+	0x59, 0x6d, 0x6f, 0xba, 0x42, 0xd9, 0x4b, 0x28, 0x34, 0x77, 0x69, 0x85, 0x12, 0xfd, 0xa7, 0x5c,
+	0xb2, 0xff, 0xf4, 0x2a, 0x2c, 0xc5, 0x14, 0x13, 0x0a, 0xff, 0x2c, 0x03, 0x0b, 0x1c, 0x2e, 0x9e,
+	0x64, 0x2e, 0x7b, 0x98, 0xc4, 0xc5, 0x65, 0x12, 0xe2, 0x44, 0x3b, 0xa0, 0x6b, 0x12, 0xec, 0x93,
+	0xa8, 0x1d, 0x70, 0xc8, 0xc6, 0x68, 0x1b, 0x6a, 0xb6, 0x6f, 0x58, 0x2c, 0x58, 0x8d, 0x9e, 0xe9,
+	0x3d, 0x15, 0x9d, 0xe0, 0x82, 0x5e, 0xb1, 0x7d, 0x1e, 0xc3, 0x47, 0x0c, 0x9a, 0x38, 0x67, 0x72,
+	0x2f, 0x76, 0xce, 0x48, 0x8d, 0x8c, 0xfc, 0xe4, 0x06, 0xdb, 0x2f, 0x15, 0x58, 0x8d, 0xd2, 0xb2,
+	0xb0, 0x8a, 0xff, 0x5f, 0x54, 0xa6, 0x68, 0x06, 0xa8, 0x69, 0x0b, 0x0c, 0xb3, 0x71, 0xac, 0xc6,
+	0x18, 0x3a, 0xca, 0xc5, 0x84, 0x71, 0x25, 0xc6, 0x17, 0x81, 0x00, 0xbe, 0x17, 0xc1, 0x8c, 0x99,
+	0x05, 0xbf, 0xe4, 0x38, 0xd9, 0xa4, 0x9f, 0x6e, 0xc0, 0x5a, 0xaa, 0x6c, 0xe1, 0xaf, 0xdf, 0x85,
+	0x75, 0x8e, 0x3e, 0x19, 0x10, 0xd9, 0x53, 0x66, 0xb3, 0x38, 0xed, 0x03, 0xd8, 0x18, 0xc1, 0x5e,
+	0x58, 0x37, 0xbe, 0x7a, 0x25, 0xb9, 0xfa, 0x7f, 0x65, 0xa0, 0xd4, 0x32, 0x49, 0x70, 0x52, 0x5d,
+	0xdd, 0x95, 0xe3, 0xa5, 0x9e, 0xce, 0x9a, 0xb0, 0xc0, 0xa2, 0x83, 0x6a, 0x61, 0x99, 0x04, 0x4f,
+	0x15, 0x58, 0xe5, 0x60, 0x6a, 0xc3, 0x24, 0x18, 0x1d, 0x41, 0x35, 0x7a, 0x10, 0xe3, 0xcc, 0xa6,
+	0xa9, 0xc2, 0x2a, 0xd1, 0x64, 0xc6, 0xee, 0x0e, 0x2c, 0xf9, 0x26, 0xc1, 0xdd, 0xae, 0xcd, 0xee,
+	0xff, 0x1d, 0xc7, 0x24, 0x03, 0x4f, 0x54, 0x61, 0x3a, 0x0a, 0x51, 0xad, 0x00, 0xf3, 0xc6, 0x37,
+	0x83, 0x3f, 0x14, 0xa2, 0x17, 0x65, 0x54, 0x85, 0xd2, 0xa3, 0xe3, 0xc7, 0x07, 0x7a, 0xab, 0xf9,
+	0xf0, 0xf8, 0x80, 0xb6, 0x06, 0x4a, 0x30, 0x7f, 0x70, 0xbc, 0xbb, 0x77, 0x78, 0xd0, 0xa8, 0x29,
+	0xb4, 0x11, 0xd0, 0x7a, 0xd4, 0x3a, 0x39, 0x38, 0x6e, 0x1c, 0x34, 0x6a, 0x99, 0xbb, 0xbf, 0x46,
+	0x50, 0x38, 0x12, 0x11, 0x82, 0x8e, 0xa0, 0xcc, 0xff, 0x95, 0x10, 0x27, 0xd1, 0x46, 0xf2, 0x69,
+	0x3b, 0xf6, 0x7f, 0x8a, 0x7a, 0x7d, 0x14, 0x5a, 0x38, 0x4b, 0x03, 0x8a, 0xf7, 0x30, 0x11, 0xbc,
+	0x86, 0x9e, 0xc9, 0xa3, 0x7f, 0x2a, 0xd4, 0xb5, 0x54, 0x9c, 0xe0, 0x72, 0x04, 0x65, 0xee, 0x8a,
+	0xa3, 0x16, 0x15, 0xab, 0xa0, 0xd4, 0xeb, 0xa3, 0xd0, 0x82, 0xdd, 0x7d, 0x28, 0xd1, 0xbc, 0xc1,
+	0x71, 0x3e, 0x5a, 0x4b, 0xfb, 0x65, 0x21, 0xe0, 0xb5, 0x9e, 0x8e, 0x14, 0x9c, 0x30, 0x2c, 0xb7,
+	0x02, 0xf5, 0xa4, 0xfe, 0x2b, 0xba, 0x95, 0x9c, 0x95, 0xda, 0xfb, 0x55, 0x6f, 0x4f, 0x22, 0x13,
+	0x62, 0x4e, 0x61, 0x29, 0x14, 0x23, 0xed, 0xf2, 0xcd, 0x94, 0xe9, 0x43, 0x3f, 0x31, 0xa8, 0xb7,
+	0x26, 0x50, 0x09, 0x19, 0x06, 0xa0, 0x50, 0x46, 0xf4, 0xc7, 0xd4, 0x8d, 0x94, 0xc9, 0xc9, 0x5f,
+	0x13, 0xd4, 0x9b, 0xe3, 0x89, 0x52, 0x04, 0x84, 0x0f, 0xfe, 0xa9, 0x02, 0x92, 0x7f, 0x1f, 0xa8,
+	0x37, 0xc7, 0x13, 0x09, 0x01, 0x0f, 0xa0, 0xc4, 0x6a, 0x73, 0xd1, 0x52, 0x5e, 0x4f, 0xa6, 0x7d,
+	0xb9, 0xb1, 0xa5, 0x6e, 0x8c, 0xc0, 0x46, 0x1e, 0xc7, 0xef, 0xec, 0x82, 0xd9, 0x10, 0x79, 0xac,
+	0x2f, 0xa0, 0x5e, 0x1f, 0x85, 0x8e, 0x7b, 0x1c, 0xc7, 0xc5, 0x3c, 0x6e, 0xa8, 0x0d, 0xa0, 0xae,
+	0xa7, 0x23, 0x05, 0xa7, 0xcf, 0x61, 0x51, 0xba, 0x80, 0x88, 0xd5, 0x69, 0xa9, 0xca, 0xc4, 0x83,
+	0xe2, 0xc6, 0x58, 0x9a, 0x68, 0x8f, 0xe4, 0x6b, 0x84, 0x60, 0x3f, 0x34, 0x35, 0xe5, 0xda, 0xa2,
+	0xde, 0x1c, 0x4f, 0x24, 0x04, 0xdc, 0x03, 0xa0, 0xc5, 0xb5, 0x60, 0xbc, 0x36, 0x6c, 0xb6, 0xfe,
+	0xc5, 0x48, 0x3b, 0xc8, 0x55, 0x39, 0x65, 0x44, 0xcb, 0xd6, 0x51, 0x8c, 0xa4, 0x52, 0x5d, 0x5d,
+	0x4f, 0x47, 0x46, 0x5e, 0xc3, 0xea, 0xc1, 0x51, 0x5e, 0x23, 0x57, 0xc1, 0xea, 0xc6, 0x08, 0x6c,
+	0x64, 0xbe, 0x68, 0x9b, 0x83, 0xb2, 0x64, 0xd8, 0x7c, 0x29, 0x55, 0x95, 0x7a, 0x73, 0x3c, 0x51,
+	0x94, 0x08, 0xe4, 0x9d, 0x11, 0x78, 0x34, 0x34, 0x39, 0xad, 0x6a, 0x51, 0x6f, 0x4d, 0xa0, 0x0a,
+	0x65, 0x54, 0x13, 0x47, 0x3f, 0xba, 0x9d, 0x9c, 0x99, 0x5e, 0x7a, 0xa8, 0xff, 0x37, 0x91, 0x4e,
+	0xc8, 0xf8, 0x04, 0x6a, 0xfc, 0xa0, 0x10, 0xc5, 0x3c, 0x7d, 0x05, 0xdb, 0x1a, 0x2a, 0xf1, 0x13,
+	0xbf, 0xdc, 0xa8, 0xaf, 0x8d, 0xa2, 0x88, 0xde, 0x07, 0xbf, 0x03, 0x35, 0x1e, 0x7a, 0x12, 0xe3,
+	0xe1, 0x69, 0xc9, 0xbf, 0x3a, 0x54, 0x6d, 0x24, 0x49, 0xc4, 0xba, 0x05, 0x15, 0xe9, 0xf9, 0x9a,
+	0x42, 0x36, 0x87, 0x66, 0xc5, 0x9f, 0xd5, 0xd5, 0xad, 0x11, 0x04, 0x11, 0x53, 0x03, 0x50, 0xf0,
+	0x6b, 0x81, 0xb4, 0xe2, 0x1b, 0x43, 0xf3, 0x86, 0x7f, 0xb8, 0x50, 0x6f, 0x8e, 0x21, 0x8a, 0x19,
+	0x84, 0xef, 0xc0, 0x58, 0x83, 0x24, 0x1f, 0xd2, 0x55, 0x6d, 0x24, 0x49, 0xc4, 0xfa, 0x31, 0x54,
+	0xe5, 0x47, 0xd7, 0xc4, 0x1e, 0xa6, 0xbf, 0x4f, 0xab, 0xaf, 0x8d, 0xa2, 0x88, 0xf8, 0x7e, 0x0e,
+	0x8b, 0xf1, 0x73, 0x90, 0x02, 0x63, 0x0b, 0x4a, 0x7f, 0x47, 0x55, 0x6f, 0x8c, 0xa6, 0x89, 0xb8,
+	0x3f, 0x80, 0x92, 0xf4, 0xf0, 0x29, 0xc7, 0xfb, 0xf0, 0x33, 0xa9, 0xba, 0x31, 0x02, 0xcb, 0xd9,
+	0xed, 0xcd, 0x7d, 0x96, 0xe9, 0x9f, 0x9e, 0xe6, 0x59, 0x7d, 0xf7, 0xf6, 0xbf, 0x07, 0x00, 0x12,
+	0xdc, 0xb0, 0x00, 0xf7, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2546,12 +3879,21 @@ type MetainfoClient interface {
 	DeleteBucket(ctx context.Context, in *BucketDeleteRequest, opts ...grpc.CallOption) (*BucketDeleteResponse, error)
 	ListBuckets(ctx context.Context, in *BucketListRequest, opts ...grpc.CallOption) (*BucketListResponse, error)
 	SetBucketAttribution(ctx context.Context, in *BucketSetAttributionRequest, opts ...grpc.CallOption) (*BucketSetAttributionResponse, error)
+	SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest, opts ...grpc.CallOption) (*BucketSetVersioningResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest, opts ...grpc.CallOption) (*BucketSetLifecycleResponse, error)
+	SetBucketPlacement(ctx context.Context, in *BucketSetPlacementRequest, opts ...grpc.CallOption) (*BucketSetPlacementResponse, error)
 	// Object
 	BeginObject(ctx context.Context, in *ObjectBeginRequest, opts ...grpc.CallOption) (*ObjectBeginResponse, error)
 	CommitObject(ctx context.Context, in *ObjectCommitRequest, opts ...grpc.CallOption) (*ObjectCommitResponse, error)
 	ListObjects(ctx context.Context, in *ObjectListRequest, opts ...grpc.CallOption) (*ObjectListResponse, error)
 	BeginDeleteObject(ctx context.Context, in *ObjectBeginDeleteRequest, opts ...grpc.CallOption) (*ObjectBeginDeleteResponse, error)
 	FinishDeleteObject(ctx context.Context, in *ObjectFinishDeleteRequest, opts ...grpc.CallOption) (*ObjectFinishDeleteResponse, error)
+	CopyObject(ctx context.Context, in *ObjectCopyRequest, opts ...grpc.CallOption) (*ObjectCopyResponse, error)
+	MoveObject(ctx context.Context, in *ObjectMoveRequest, opts ...grpc.CallOption) (*ObjectMoveResponse, error)
+	RekeyObject(ctx context.Context, in *ObjectRekeyRequest, opts ...grpc.CallOption) (*ObjectRekeyResponse, error)
+	ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest, opts ...grpc.CallOption) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest, opts ...grpc.CallOption) (*ObjectDeleteVersionResponse, error)
+	PutDeleteMarker(ctx context.Context, in *ObjectPutDeleteMarkerRequest, opts ...grpc.CallOption) (*ObjectPutDeleteMarkerResponse, error)
 	CreateSegmentOld(ctx context.Context, in *SegmentWriteRequestOld, opts ...grpc.CallOption) (*SegmentWriteResponseOld, error)
 	CommitSegmentOld(ctx context.Context, in *SegmentCommitRequestOld, opts ...grpc.CallOption) (*SegmentCommitResponseOld, error)
 	SegmentInfoOld(ctx context.Context, in *SegmentInfoRequestOld, opts ...grpc.CallOption) (*SegmentInfoResponseOld, error)
@@ -2615,6 +3957,33 @@ func (c *metainfoClient) SetBucketAttribution(ctx context.Context, in *BucketSet
 	return out, nil
 }
 
+func (c *metainfoClient) SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest, opts ...grpc.CallOption) (*BucketSetVersioningResponse, error) {
+	out := new(BucketSetVersioningResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketVersioning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest, opts ...grpc.CallOption) (*BucketSetLifecycleResponse, error) {
+	out := new(BucketSetLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketPlacement(ctx context.Context, in *BucketSetPlacementRequest, opts ...grpc.CallOption) (*BucketSetPlacementResponse, error) {
+	out := new(BucketSetPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metainfoClient) BeginObject(ctx context.Context, in *ObjectBeginRequest, opts ...grpc.CallOption) (*ObjectBeginResponse, error) {
 	out := new(ObjectBeginResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginObject", in, out, opts...)
@@ -2660,6 +4029,60 @@ func (c *metainfoClient) FinishDeleteObject(ctx context.Context, in *ObjectFinis
 	return out, nil
 }
 
+func (c *metainfoClient) CopyObject(ctx context.Context, in *ObjectCopyRequest, opts ...grpc.CallOption) (*ObjectCopyResponse, error) {
+	out := new(ObjectCopyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CopyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) MoveObject(ctx context.Context, in *ObjectMoveRequest, opts ...grpc.CallOption) (*ObjectMoveResponse, error) {
+	out := new(ObjectMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/MoveObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) RekeyObject(ctx context.Context, in *ObjectRekeyRequest, opts ...grpc.CallOption) (*ObjectRekeyResponse, error) {
+	out := new(ObjectRekeyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/RekeyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest, opts ...grpc.CallOption) (*ObjectListVersionsResponse, error) {
+	out := new(ObjectListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ListObjectVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest, opts ...grpc.CallOption) (*ObjectDeleteVersionResponse, error) {
+	out := new(ObjectDeleteVersionResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DeleteObjectVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) PutDeleteMarker(ctx context.Context, in *ObjectPutDeleteMarkerRequest, opts ...grpc.CallOption) (*ObjectPutDeleteMarkerResponse, error) {
+	out := new(ObjectPutDeleteMarkerResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/PutDeleteMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metainfoClient) CreateSegmentOld(ctx context.Context, in *SegmentWriteRequestOld, opts ...grpc.CallOption) (*SegmentWriteResponseOld, error) {
 	out := new(SegmentWriteResponseOld)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CreateSegmentOld", in, out, opts...)
@@ -2740,12 +4163,21 @@ type MetainfoServer interface {
 	DeleteBucket(context.Context, *BucketDeleteRequest) (*BucketDeleteResponse, error)
 	ListBuckets(context.Context, *BucketListRequest) (*BucketListResponse, error)
 	SetBucketAttribution(context.Context, *BucketSetAttributionRequest) (*BucketSetAttributionResponse, error)
+	SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
+	SetBucketLifecycle(context.Context, *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error)
+	SetBucketPlacement(context.Context, *BucketSetPlacementRequest) (*BucketSetPlacementResponse, error)
 	// Object
 	BeginObject(context.Context, *ObjectBeginRequest) (*ObjectBeginResponse, error)
 	CommitObject(context.Context, *ObjectCommitRequest) (*ObjectCommitResponse, error)
 	ListObjects(context.Context, *ObjectListRequest) (*ObjectListResponse, error)
 	BeginDeleteObject(context.Context, *ObjectBeginDeleteRequest) (*ObjectBeginDeleteResponse, error)
 	FinishDeleteObject(context.Context, *ObjectFinishDeleteRequest) (*ObjectFinishDeleteResponse, error)
+	CopyObject(context.Context, *ObjectCopyRequest) (*ObjectCopyResponse, error)
+	MoveObject(context.Context, *ObjectMoveRequest) (*ObjectMoveResponse, error)
+	RekeyObject(context.Context, *ObjectRekeyRequest) (*ObjectRekeyResponse, error)
+	ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(context.Context, *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error)
+	PutDeleteMarker(context.Context, *ObjectPutDeleteMarkerRequest) (*ObjectPutDeleteMarkerResponse, error)
 	CreateSegmentOld(context.Context, *SegmentWriteRequestOld) (*SegmentWriteResponseOld, error)
 	CommitSegmentOld(context.Context, *SegmentCommitRequestOld) (*SegmentCommitResponseOld, error)
 	SegmentInfoOld(context.Context, *SegmentInfoRequestOld) (*SegmentInfoResponseOld, error)
@@ -2850,6 +4282,60 @@ func _Metainfo_SetBucketAttribution_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_SetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketSetVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketVersioning(ctx, req.(*BucketSetVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketSetLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, req.(*BucketSetLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketSetPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, req.(*BucketSetPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metainfo_BeginObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ObjectBeginRequest)
 	if err := dec(in); err != nil {
@@ -2940,6 +4426,114 @@ func _Metainfo_FinishDeleteObject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CopyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CopyObject(ctx, req.(*ObjectCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_MoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).MoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/MoveObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).MoveObject(ctx, req.(*ObjectMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_RekeyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectRekeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).RekeyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/RekeyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).RekeyObject(ctx, req.(*ObjectRekeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).ListObjectVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/ListObjectVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).ListObjectVersions(ctx, req.(*ObjectListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_DeleteObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectDeleteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).DeleteObjectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/DeleteObjectVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).DeleteObjectVersion(ctx, req.(*ObjectDeleteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_PutDeleteMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectPutDeleteMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).PutDeleteMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/PutDeleteMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).PutDeleteMarker(ctx, req.(*ObjectPutDeleteMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metainfo_CreateSegmentOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SegmentWriteRequestOld)
 	if err := dec(in); err != nil {
@@ -3108,6 +4702,18 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBucketAttribution",
 			Handler:    _Metainfo_SetBucketAttribution_Handler,
 		},
+		{
+			MethodName: "SetBucketVersioning",
+			Handler:    _Metainfo_SetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Metainfo_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetBucketPlacement",
+			Handler:    _Metainfo_SetBucketPlacement_Handler,
+		},
 		{
 			MethodName: "BeginObject",
 			Handler:    _Metainfo_BeginObject_Handler,
@@ -3128,6 +4734,30 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinishDeleteObject",
 			Handler:    _Metainfo_FinishDeleteObject_Handler,
 		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Metainfo_CopyObject_Handler,
+		},
+		{
+			MethodName: "MoveObject",
+			Handler:    _Metainfo_MoveObject_Handler,
+		},
+		{
+			MethodName: "RekeyObject",
+			Handler:    _Metainfo_RekeyObject_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _Metainfo_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "DeleteObjectVersion",
+			Handler:    _Metainfo_DeleteObjectVersion_Handler,
+		},
+		{
+			MethodName: "PutDeleteMarker",
+			Handler:    _Metainfo_PutDeleteMarker_Handler,
+		},
 		{
 			MethodName: "CreateSegmentOld",
 			Handler:    _Metainfo_CreateSegmentOld_Handler,
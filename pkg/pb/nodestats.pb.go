@@ -26,6 +26,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// DisqualificationReason is the reason a satellite disqualified a node.
+type DisqualificationReason int32
+
+const (
+	DisqualificationReason_UNKNOWN       DisqualificationReason = 0
+	DisqualificationReason_AUDIT_FAILURE DisqualificationReason = 1
+	DisqualificationReason_OFFLINE       DisqualificationReason = 2
+	DisqualificationReason_OPERATOR      DisqualificationReason = 3
+)
+
+var DisqualificationReason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "AUDIT_FAILURE",
+	2: "OFFLINE",
+	3: "OPERATOR",
+}
+
+var DisqualificationReason_value = map[string]int32{
+	"UNKNOWN":       0,
+	"AUDIT_FAILURE": 1,
+	"OFFLINE":       2,
+	"OPERATOR":      3,
+}
+
+func (x DisqualificationReason) String() string {
+	return proto.EnumName(DisqualificationReason_name, int32(x))
+}
+
+func (DisqualificationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0b184ee117142aa, []int{0}
+}
+
 type ReputationStats struct {
 	TotalCount           int64    `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	SuccessCount         int64    `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
@@ -127,11 +159,16 @@ func (m *GetStatsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
 
 type GetStatsResponse struct {
-	UptimeCheck          *ReputationStats `protobuf:"bytes,1,opt,name=uptime_check,json=uptimeCheck,proto3" json:"uptime_check,omitempty"`
-	AuditCheck           *ReputationStats `protobuf:"bytes,2,opt,name=audit_check,json=auditCheck,proto3" json:"audit_check,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	UptimeCheck *ReputationStats `protobuf:"bytes,1,opt,name=uptime_check,json=uptimeCheck,proto3" json:"uptime_check,omitempty"`
+	AuditCheck  *ReputationStats `protobuf:"bytes,2,opt,name=audit_check,json=auditCheck,proto3" json:"audit_check,omitempty"`
+	// disqualified is not set when the node is in good standing.
+	Disqualified           *time.Time             `protobuf:"bytes,3,opt,name=disqualified,proto3,stdtime" json:"disqualified,omitempty"`
+	DisqualificationReason DisqualificationReason `protobuf:"varint,4,opt,name=disqualification_reason,json=disqualificationReason,proto3,enum=nodestats.DisqualificationReason" json:"disqualification_reason,omitempty"`
+	// suspended is set while the node isn't selected for uploads because of its low reputation.
+	Suspended            *time.Time `protobuf:"bytes,5,opt,name=suspended,proto3,stdtime" json:"suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
@@ -172,6 +209,27 @@ func (m *GetStatsResponse) GetAuditCheck() *ReputationStats {
 	return nil
 }
 
+func (m *GetStatsResponse) GetDisqualified() *time.Time {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
+func (m *GetStatsResponse) GetDisqualificationReason() DisqualificationReason {
+	if m != nil {
+		return m.DisqualificationReason
+	}
+	return DisqualificationReason_UNKNOWN
+}
+
+func (m *GetStatsResponse) GetSuspended() *time.Time {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
+}
+
 type DailyStorageUsageRequest struct {
 	From                 time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from"`
 	To                   time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to"`
@@ -306,6 +364,7 @@ func (m *DailyStorageUsageResponse_StorageUsage) GetTimeStamp() time.Time {
 }
 
 func init() {
+	proto.RegisterEnum("nodestats.DisqualificationReason", DisqualificationReason_name, DisqualificationReason_value)
 	proto.RegisterType((*ReputationStats)(nil), "nodestats.ReputationStats")
 	proto.RegisterType((*GetStatsRequest)(nil), "nodestats.GetStatsRequest")
 	proto.RegisterType((*GetStatsResponse)(nil), "nodestats.GetStatsResponse")
@@ -317,39 +376,47 @@ func init() {
 func init() { proto.RegisterFile("nodestats.proto", fileDescriptor_e0b184ee117142aa) }
 
 var fileDescriptor_e0b184ee117142aa = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x59, 0x27, 0x84, 0x66, 0x9c, 0x36, 0xcd, 0x72, 0x31, 0xe1, 0xe0, 0xc8, 0x45, 0x6a,
-	0xb8, 0xb8, 0x22, 0x70, 0x40, 0x42, 0x1c, 0x48, 0x2a, 0xa1, 0x5e, 0x38, 0x6c, 0xca, 0x85, 0x03,
-	0xab, 0x8d, 0xbd, 0x75, 0x2d, 0x92, 0xac, 0xf1, 0x8e, 0x85, 0x78, 0x05, 0x0e, 0x88, 0x47, 0xe0,
-	0x11, 0x78, 0x0c, 0x9e, 0x80, 0x03, 0x87, 0xf2, 0x2a, 0x68, 0xd7, 0xce, 0x1f, 0x4c, 0xa1, 0x39,
-	0xfa, 0xb7, 0xdf, 0x7c, 0x99, 0xf9, 0x66, 0x02, 0xdd, 0xa5, 0x8a, 0xa5, 0x46, 0x81, 0x3a, 0xcc,
-	0x72, 0x85, 0x8a, 0xb6, 0xd7, 0xa0, 0x0f, 0x89, 0x4a, 0x54, 0x89, 0xfb, 0x7e, 0xa2, 0x54, 0x32,
-	0x97, 0x27, 0xf6, 0x6b, 0x56, 0x5c, 0x9c, 0x60, 0xba, 0x30, 0xb2, 0x45, 0x56, 0x0a, 0x82, 0x1f,
-	0x04, 0xba, 0x4c, 0x66, 0x05, 0x0a, 0x4c, 0xd5, 0x72, 0x6a, 0x0c, 0xa8, 0x0f, 0x2e, 0x2a, 0x14,
-	0x73, 0x1e, 0xa9, 0x62, 0x89, 0x1e, 0x19, 0x90, 0x61, 0x83, 0x81, 0x45, 0x13, 0x43, 0xe8, 0x11,
-	0xec, 0xeb, 0x22, 0x8a, 0xa4, 0xd6, 0x95, 0xc4, 0xb1, 0x92, 0x4e, 0x05, 0x4b, 0xd1, 0x43, 0x38,
-	0xcc, 0xd7, 0xc6, 0x5c, 0xcc, 0xb3, 0x4b, 0xe1, 0x35, 0x06, 0x64, 0x48, 0x58, 0x77, 0xc3, 0x5f,
-	0x18, 0x4c, 0x8f, 0x61, 0x0b, 0xf1, 0x99, 0x44, 0xe1, 0x35, 0xad, 0xf2, 0x60, 0x83, 0xc7, 0x12,
-	0x45, 0xcd, 0x53, 0x47, 0x2a, 0x97, 0xde, 0xed, 0xba, 0xe7, 0xd4, 0xe0, 0xa0, 0x07, 0xdd, 0x97,
-	0x12, 0xed, 0x40, 0x4c, 0xbe, 0x2f, 0xa4, 0xc6, 0xe0, 0x33, 0x81, 0xc3, 0x0d, 0xd3, 0x99, 0x5a,
-	0x6a, 0x49, 0x9f, 0x43, 0xa7, 0xc8, 0x4c, 0x2a, 0x3c, 0xba, 0x94, 0xd1, 0x3b, 0x3b, 0xad, 0x3b,
-	0xea, 0x87, 0x9b, 0x80, 0x6b, 0xf1, 0x30, 0xb7, 0xd4, 0x4f, 0x8c, 0x9c, 0x3e, 0x03, 0x57, 0x14,
-	0x71, 0x8a, 0x55, 0xb5, 0x73, 0x63, 0x35, 0x58, 0xb9, 0x2d, 0x0e, 0x3e, 0x11, 0xf0, 0x4e, 0x45,
-	0x3a, 0xff, 0x38, 0x45, 0x95, 0x8b, 0x44, 0xbe, 0xd6, 0x22, 0x91, 0x55, 0xb7, 0xf4, 0x29, 0x34,
-	0x2f, 0x72, 0xb5, 0x58, 0x37, 0x54, 0x6e, 0x32, 0x5c, 0x6d, 0x32, 0x3c, 0x5f, 0x6d, 0x72, 0xbc,
-	0xf7, 0xfd, 0xca, 0xbf, 0xf5, 0xe5, 0x97, 0x4f, 0x98, 0xad, 0xa0, 0x4f, 0xc0, 0x41, 0xb5, 0x6e,
-	0x65, 0x97, 0x3a, 0x07, 0x55, 0xf0, 0xd5, 0x81, 0x7b, 0xd7, 0x34, 0x53, 0xc5, 0x74, 0x0c, 0x77,
-	0xcc, 0x4c, 0x3c, 0x8d, 0x6d, 0x43, 0x9d, 0xf1, 0x81, 0x29, 0xfe, 0x79, 0xe5, 0xb7, 0x5e, 0xa9,
-	0x58, 0x9e, 0x9d, 0xb2, 0x96, 0x79, 0x3e, 0x8b, 0xa9, 0x80, 0xbb, 0xb1, 0x71, 0xe1, 0xba, 0xb4,
-	0xe1, 0x85, 0xf1, 0xf1, 0x9c, 0x41, 0x63, 0xe8, 0x8e, 0x1e, 0x6d, 0x05, 0xf3, 0xcf, 0xdf, 0x0a,
-	0xff, 0x80, 0xbd, 0xb8, 0xae, 0xeb, 0x7f, 0x80, 0xce, 0xf6, 0x37, 0x0d, 0x60, 0x5f, 0x20, 0xcf,
-	0xa5, 0x46, 0x6e, 0x8f, 0xd4, 0x8e, 0x4e, 0x98, 0x2b, 0x90, 0x49, 0x8d, 0xe7, 0x06, 0xd1, 0x09,
-	0x80, 0x5d, 0xb2, 0x9d, 0xdc, 0xde, 0xe1, 0xae, 0xd9, 0xb4, 0x4d, 0xdd, 0xd4, 0xc0, 0xd1, 0x37,
-	0x02, 0x6d, 0x33, 0x6e, 0xf9, 0x37, 0x99, 0xc0, 0xde, 0xea, 0x9a, 0xe8, 0xf6, 0xc6, 0x6b, 0x67,
-	0xd7, 0xbf, 0x7f, 0xed, 0x5b, 0x95, 0xeb, 0x5b, 0xe8, 0xfd, 0x15, 0x04, 0x3d, 0xfa, 0x7f, 0x4c,
-	0xa5, 0xed, 0x83, 0x5d, 0xb2, 0x1c, 0x37, 0xdf, 0x38, 0xd9, 0x6c, 0xd6, 0xb2, 0x13, 0x3e, 0xfe,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xc8, 0x68, 0x4c, 0x37, 0x04, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xed, 0x38, 0xfd, 0xcb, 0x75, 0xda, 0x24, 0xf3, 0x49, 0xfd, 0x4c, 0x58, 0xa4, 0xa4, 0x48,
+	0x2d, 0x2c, 0x52, 0x11, 0x58, 0x20, 0x21, 0x16, 0xf9, 0x69, 0x21, 0xa2, 0x4a, 0xd0, 0x24, 0x11,
+	0x52, 0x17, 0x58, 0x13, 0xcf, 0x34, 0xb5, 0x48, 0x33, 0xae, 0x67, 0x2c, 0xc4, 0x2b, 0xb0, 0xe2,
+	0x11, 0x78, 0x04, 0x1e, 0x83, 0x27, 0x60, 0xc1, 0xa2, 0xbc, 0x06, 0x4b, 0x34, 0x63, 0xb7, 0x4e,
+	0x4d, 0x80, 0x76, 0x39, 0x67, 0xce, 0x39, 0xbe, 0xf7, 0xdc, 0xb9, 0x86, 0xe2, 0x4c, 0x30, 0x2e,
+	0x15, 0x55, 0xb2, 0x1e, 0x84, 0x42, 0x09, 0x9c, 0xbf, 0x02, 0x2a, 0x30, 0x11, 0x13, 0x11, 0xc3,
+	0x95, 0xea, 0x44, 0x88, 0xc9, 0x94, 0xef, 0x9b, 0xd3, 0x38, 0x3a, 0xd9, 0x57, 0xfe, 0x99, 0xa6,
+	0x9d, 0x05, 0x31, 0xa1, 0xf6, 0x0d, 0x41, 0x91, 0xf0, 0x20, 0x52, 0x54, 0xf9, 0x62, 0x36, 0xd0,
+	0x06, 0xb8, 0x0a, 0xb6, 0x12, 0x8a, 0x4e, 0x5d, 0x4f, 0x44, 0x33, 0xe5, 0xa0, 0x6d, 0xb4, 0x97,
+	0x23, 0x60, 0xa0, 0xb6, 0x46, 0xf0, 0x0e, 0x6c, 0xc8, 0xc8, 0xf3, 0xb8, 0x94, 0x09, 0xc5, 0x32,
+	0x94, 0x42, 0x02, 0xc6, 0xa4, 0x07, 0x50, 0x0a, 0xaf, 0x8c, 0x5d, 0x3a, 0x0d, 0x4e, 0xa9, 0x93,
+	0xdb, 0x46, 0x7b, 0x88, 0x14, 0x53, 0xbc, 0xa9, 0x61, 0xbc, 0x0b, 0x73, 0x90, 0x3b, 0xe6, 0x8a,
+	0x3a, 0xcb, 0x86, 0xb9, 0x99, 0xc2, 0x2d, 0xae, 0x68, 0xc6, 0x53, 0x7a, 0x22, 0xe4, 0xce, 0x4a,
+	0xd6, 0x73, 0xa0, 0xe1, 0x5a, 0x19, 0x8a, 0x2f, 0xb8, 0x32, 0x0d, 0x11, 0x7e, 0x1e, 0x71, 0xa9,
+	0x6a, 0x3f, 0x2d, 0x28, 0xa5, 0x98, 0x0c, 0xc4, 0x4c, 0x72, 0xfc, 0x1c, 0x0a, 0x51, 0xa0, 0x53,
+	0x71, 0xbd, 0x53, 0xee, 0xbd, 0x33, 0xdd, 0xda, 0x8d, 0x4a, 0x3d, 0x0d, 0x38, 0x13, 0x0f, 0xb1,
+	0x63, 0x7e, 0x5b, 0xd3, 0xf1, 0x33, 0xb0, 0x69, 0xc4, 0x7c, 0x95, 0xa8, 0xad, 0x7f, 0xaa, 0xc1,
+	0xd0, 0x63, 0xf1, 0x4b, 0x28, 0x30, 0x5f, 0x9e, 0x47, 0x74, 0xea, 0x9f, 0xf8, 0x9c, 0x39, 0xb9,
+	0x44, 0x1d, 0x0f, 0xad, 0x7e, 0x39, 0xb4, 0xfa, 0xf0, 0x72, 0x68, 0xad, 0xf5, 0xaf, 0x17, 0x55,
+	0xf4, 0xe9, 0x47, 0x15, 0x91, 0x6b, 0x4a, 0x7c, 0x0c, 0xff, 0xa7, 0x67, 0x2f, 0x8e, 0x27, 0xe4,
+	0x54, 0x8a, 0x99, 0x49, 0x72, 0xb3, 0x71, 0x6f, 0xae, 0xa4, 0x4e, 0x86, 0x49, 0x0c, 0x91, 0x6c,
+	0xb1, 0x85, 0x38, 0x6e, 0x41, 0x5e, 0x46, 0x32, 0xe0, 0x33, 0xc6, 0x99, 0xb3, 0x72, 0x8b, 0x12,
+	0x53, 0x59, 0xed, 0x23, 0x02, 0xa7, 0x43, 0xfd, 0xe9, 0x87, 0x81, 0x12, 0x21, 0x9d, 0xf0, 0x91,
+	0xa4, 0x13, 0x9e, 0xcc, 0x05, 0x3f, 0x85, 0xe5, 0x93, 0x50, 0x9c, 0x39, 0xe8, 0x46, 0xde, 0x4b,
+	0xc6, 0xdb, 0x28, 0xf0, 0x13, 0xb0, 0x94, 0x70, 0xac, 0x5b, 0xe8, 0x2c, 0x25, 0x6a, 0x9f, 0x2d,
+	0xb8, 0xb3, 0xa0, 0x98, 0xe4, 0x41, 0xec, 0xc2, 0x9a, 0x8e, 0xca, 0xf5, 0x99, 0x29, 0xa8, 0xd0,
+	0xda, 0xd4, 0xe2, 0xef, 0x17, 0xd5, 0xd5, 0x9e, 0x60, 0xbc, 0xdb, 0x21, 0xab, 0xfa, 0xba, 0xcb,
+	0x30, 0x85, 0xff, 0x98, 0x76, 0x71, 0x65, 0x6c, 0xe3, 0x46, 0xda, 0xc7, 0xb1, 0xb6, 0x73, 0x7b,
+	0x76, 0xe3, 0xd1, 0x7c, 0xde, 0x7f, 0xfa, 0x56, 0xfd, 0x1a, 0x58, 0x66, 0x59, 0x5e, 0xe5, 0x3d,
+	0x14, 0xe6, 0xcf, 0xb8, 0x06, 0x1b, 0x54, 0xb9, 0x21, 0x97, 0xca, 0x35, 0xeb, 0x68, 0x5a, 0x47,
+	0xc4, 0xa6, 0x8a, 0x70, 0xa9, 0x86, 0x1a, 0xc2, 0x6d, 0x00, 0xf3, 0x9c, 0x4d, 0xe7, 0x4e, 0xee,
+	0x16, 0xd9, 0xe4, 0xb5, 0x6e, 0xa0, 0xc1, 0x87, 0x03, 0xd8, 0x5a, 0xfc, 0x4a, 0xb0, 0x0d, 0x6b,
+	0xa3, 0xde, 0xab, 0x5e, 0xff, 0x4d, 0xaf, 0xb4, 0x84, 0xcb, 0xb0, 0xd1, 0x1c, 0x75, 0xba, 0x43,
+	0xf7, 0xb0, 0xd9, 0x3d, 0x1a, 0x91, 0x83, 0x12, 0xd2, 0xf7, 0xfd, 0xc3, 0xc3, 0xa3, 0x6e, 0xef,
+	0xa0, 0x64, 0xe1, 0x02, 0xac, 0xf7, 0x5f, 0x1f, 0x90, 0xe6, 0xb0, 0x4f, 0x4a, 0xb9, 0xc6, 0x17,
+	0x04, 0x79, 0x9d, 0x61, 0xfc, 0x97, 0x69, 0xc3, 0xfa, 0xe5, 0x32, 0xe2, 0xf9, 0x85, 0xc9, 0x6c,
+	0x6d, 0xe5, 0xee, 0xc2, 0xbb, 0x64, 0x58, 0x6f, 0xa1, 0xfc, 0x5b, 0xba, 0x78, 0xe7, 0xef, 0xd9,
+	0xc7, 0xb6, 0xf7, 0x6f, 0x32, 0xa0, 0xd6, 0xf2, 0xb1, 0x15, 0x8c, 0xc7, 0xab, 0x26, 0xb6, 0xc7,
+	0xbf, 0x06, 0x00, 0x3e, 0x1f, 0x5d, 0xcc, 0x76, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
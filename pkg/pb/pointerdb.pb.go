@@ -256,16 +256,22 @@ func (m *RemoteSegment) GetMerkleRoot() []byte {
 }
 
 type Pointer struct {
-	Type                 Pointer_DataType `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
-	InlineSegment        []byte           `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
-	Remote               *RemoteSegment   `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
-	SegmentSize          int64            `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	CreationDate         time.Time        `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
-	ExpirationDate       time.Time        `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date"`
-	Metadata             []byte           `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Type           Pointer_DataType `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
+	InlineSegment  []byte           `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
+	Remote         *RemoteSegment   `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
+	SegmentSize    int64            `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	CreationDate   time.Time        `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
+	ExpirationDate time.Time        `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date"`
+	Metadata       []byte           `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// pieces_shared is set when the pieces are referenced by more than one pointer,
+	// e.g. after a server-side copy. Such pieces are removed by garbage collection.
+	PiecesShared bool `protobuf:"varint,9,opt,name=pieces_shared,json=piecesShared,proto3" json:"pieces_shared,omitempty"`
+	// version_id identifies the object version, it is set only on the last segment
+	// of objects stored while versioning is enabled for the bucket
+	VersionId            string   `protobuf:"bytes,10,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pointer) Reset()         { *m = Pointer{} }
@@ -341,6 +347,20 @@ func (m *Pointer) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Pointer) GetPiecesShared() bool {
+	if m != nil {
+		return m.PiecesShared
+	}
+	return false
+}
+
+func (m *Pointer) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 // ListResponse is a response message for the List rpc call
 type ListResponse struct {
 	Items                []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -456,51 +476,54 @@ func init() {
 func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_75fef806d28fc810) }
 
 var fileDescriptor_75fef806d28fc810 = []byte{
-	// 735 bytes of a gzipped FileDescriptorProto
+	// 772 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x2d, 0x89, 0xa2, 0x87, 0x94, 0xad, 0x2c, 0x8a, 0x96, 0x50, 0x0a, 0xc8, 0x21, 0x90,
-	0xd6, 0x45, 0x03, 0xba, 0x60, 0x6e, 0xcd, 0xa9, 0x86, 0x0c, 0x94, 0x80, 0xa3, 0x1a, 0x2b, 0xa1,
-	0x87, 0x5e, 0x88, 0x95, 0x38, 0x11, 0x17, 0x15, 0xb9, 0xcc, 0xee, 0x0a, 0x88, 0xfd, 0x14, 0x79,
-	0x8a, 0xbe, 0x45, 0xef, 0x7d, 0x86, 0x1e, 0x92, 0x57, 0x29, 0xb8, 0x4b, 0x4a, 0x4a, 0x03, 0x14,
-	0xe8, 0x85, 0x9c, 0x9f, 0x6f, 0x7e, 0xf6, 0x9b, 0x19, 0xb8, 0xa8, 0x05, 0xaf, 0x34, 0xca, 0x7c,
-	0x15, 0xd7, 0x52, 0x68, 0x41, 0xce, 0xf6, 0x86, 0xc9, 0x74, 0x23, 0xc4, 0x66, 0x8b, 0xd7, 0xc6,
-	0xb1, 0xda, 0xbd, 0xb9, 0xd6, 0xbc, 0x44, 0xa5, 0x59, 0x59, 0x5b, 0xec, 0x04, 0x36, 0x62, 0x23,
-	0x3a, 0xb9, 0x12, 0x39, 0xb6, 0x72, 0x20, 0x64, 0x8e, 0x52, 0x59, 0x2d, 0xfa, 0xe3, 0x14, 0xc6,
-	0x14, 0xf3, 0x5d, 0x95, 0xb3, 0x6a, 0xfd, 0xb0, 0x58, 0x17, 0x58, 0x22, 0xf9, 0x11, 0xfa, 0xfa,
-	0xa1, 0xc6, 0xd0, 0xb9, 0x74, 0xae, 0xce, 0x93, 0x6f, 0xe2, 0x43, 0x1b, 0xff, 0x86, 0xc6, 0xf6,
-	0xb7, 0x7c, 0xa8, 0x91, 0x9a, 0x18, 0xf2, 0x15, 0x0c, 0x4b, 0x5e, 0x65, 0x12, 0xdf, 0x86, 0xa7,
-	0x97, 0xce, 0xd5, 0x80, 0xba, 0x25, 0xaf, 0x28, 0xbe, 0x25, 0x5f, 0xc0, 0x40, 0x0b, 0xcd, 0xb6,
-	0x61, 0xcf, 0x98, 0xad, 0x42, 0xbe, 0x83, 0xb1, 0xc4, 0x9a, 0x71, 0x99, 0xe9, 0x42, 0xa2, 0x2a,
-	0xc4, 0x36, 0x0f, 0xfb, 0x06, 0x70, 0x61, 0xed, 0xcb, 0xce, 0x4c, 0xbe, 0x87, 0x27, 0x6a, 0xb7,
-	0x5e, 0xa3, 0x52, 0x47, 0xd8, 0x81, 0xc1, 0x8e, 0x5b, 0xc7, 0x01, 0xfc, 0x02, 0x08, 0x4a, 0xa6,
-	0x76, 0x12, 0x33, 0x55, 0xb0, 0xe6, 0xcb, 0x1f, 0x31, 0x74, 0x2d, 0xba, 0xf5, 0x2c, 0x1a, 0xc7,
-	0x82, 0x3f, 0x62, 0xf4, 0x0c, 0xe0, 0xf0, 0x10, 0xe2, 0xc3, 0x30, 0x9d, 0xff, 0xfa, 0xd3, 0x5d,
-	0x3a, 0x1b, 0x9f, 0x10, 0x17, 0x4e, 0xe9, 0x62, 0xec, 0x44, 0x8f, 0xe0, 0x53, 0x2c, 0x85, 0xc6,
-	0x7b, 0x8e, 0x6b, 0x24, 0x4f, 0xe1, 0xac, 0x6e, 0x84, 0xac, 0xda, 0x95, 0x86, 0xa7, 0x01, 0xf5,
-	0x8c, 0x61, 0xbe, 0x2b, 0xc9, 0xb7, 0x30, 0x6c, 0x08, 0xcf, 0x78, 0x6e, 0x38, 0x08, 0x6e, 0xce,
-	0xff, 0xfa, 0x30, 0x3d, 0xf9, 0xfb, 0xc3, 0xd4, 0x9d, 0x8b, 0x1c, 0xd3, 0x19, 0x75, 0x1b, 0x77,
-	0x9a, 0x93, 0xe7, 0xd0, 0x2f, 0x98, 0x2a, 0x0c, 0x25, 0x7e, 0xf2, 0x24, 0x6e, 0x47, 0x63, 0x4a,
-	0xfc, 0xcc, 0x54, 0x41, 0x8d, 0x3b, 0xfa, 0xe8, 0xc0, 0xc8, 0x16, 0x5f, 0xe0, 0xa6, 0xc4, 0x4a,
-	0x93, 0x57, 0x00, 0x72, 0x3f, 0x0a, 0x53, 0xdf, 0x4f, 0x9e, 0xfe, 0xc7, 0x9c, 0xe8, 0x11, 0x9c,
-	0xbc, 0x84, 0x91, 0x14, 0x42, 0x67, 0xf6, 0x01, 0xfb, 0x26, 0x2f, 0xda, 0x26, 0x87, 0xa6, 0x7c,
-	0x3a, 0xa3, 0x7e, 0x83, 0xb2, 0x4a, 0x4e, 0x5e, 0xc1, 0x48, 0x9a, 0x16, 0x6c, 0x98, 0x0a, 0x7b,
-	0x97, 0xbd, 0x2b, 0x3f, 0xf9, 0xf2, 0x93, 0xa2, 0x7b, 0x7e, 0x68, 0x20, 0x0f, 0x8a, 0x22, 0x53,
-	0xf0, 0x4b, 0x94, 0xbf, 0x6f, 0x31, 0x6b, 0x52, 0x9a, 0x01, 0x07, 0x14, 0xac, 0x89, 0x0a, 0xa1,
-	0xa3, 0xf7, 0x3d, 0x18, 0xde, 0xdb, 0x44, 0xe4, 0xfa, 0x93, 0xed, 0x3b, 0x7e, 0x55, 0x8b, 0x88,
-	0x67, 0x4c, 0xb3, 0xa3, 0x95, 0x7b, 0x0e, 0xe7, 0xbc, 0xda, 0xf2, 0x0a, 0x33, 0x65, 0xe9, 0x31,
-	0x7c, 0x06, 0x74, 0x64, 0xad, 0x1d, 0x67, 0x3f, 0x80, 0x6b, 0x9b, 0x32, 0xf5, 0xfd, 0x24, 0xfc,
-	0xac, 0xf5, 0x16, 0x49, 0x5b, 0x1c, 0x79, 0x06, 0x41, 0x9b, 0xd1, 0xae, 0x4f, 0xb3, 0x6c, 0x3d,
-	0xea, 0xb7, 0xb6, 0x66, 0x73, 0x48, 0x0a, 0xa3, 0xb5, 0x44, 0xa6, 0xb9, 0xa8, 0xb2, 0x9c, 0x69,
-	0xbb, 0x62, 0x7e, 0x32, 0x89, 0xed, 0x79, 0xc6, 0xdd, 0x79, 0xc6, 0xcb, 0xee, 0x3c, 0x6f, 0xbc,
-	0x86, 0xe7, 0xf7, 0x1f, 0xa7, 0x0e, 0x0d, 0xba, 0xd0, 0x19, 0xd3, 0x48, 0x5e, 0xc3, 0x05, 0xbe,
-	0xab, 0xb9, 0x3c, 0x4a, 0x36, 0xfc, 0x1f, 0xc9, 0xce, 0x0f, 0xc1, 0x26, 0xdd, 0x04, 0xbc, 0x12,
-	0x35, 0xcb, 0x99, 0x66, 0xa1, 0x67, 0xf8, 0xd8, 0xeb, 0x51, 0x04, 0x5e, 0xc7, 0x21, 0x01, 0x70,
-	0xd3, 0xf9, 0x5d, 0x3a, 0xbf, 0x1d, 0x9f, 0x34, 0x32, 0xbd, 0x7d, 0xfd, 0xcb, 0xf2, 0x76, 0xec,
-	0x44, 0x7f, 0x3a, 0x10, 0xdc, 0x71, 0xa5, 0x29, 0xaa, 0x5a, 0x54, 0x0a, 0x49, 0x02, 0x03, 0xae,
-	0xb1, 0x54, 0xa1, 0x63, 0x26, 0xff, 0xf5, 0x11, 0x7d, 0xc7, 0xb8, 0x38, 0xd5, 0x58, 0x52, 0x0b,
-	0x25, 0x04, 0xfa, 0xa5, 0x90, 0x68, 0x36, 0xcc, 0xa3, 0x46, 0x9e, 0x20, 0xf4, 0x1b, 0x48, 0xe3,
-	0xab, 0x99, 0x2e, 0xcc, 0x9c, 0xcf, 0xa8, 0x91, 0xc9, 0x0b, 0x18, 0xb6, 0x59, 0x4d, 0x88, 0x9f,
-	0x90, 0xcf, 0xc7, 0x4f, 0x3b, 0x48, 0x73, 0x84, 0x5c, 0x65, 0xb5, 0xc4, 0x37, 0xfc, 0x9d, 0x99,
-	0xb9, 0x47, 0x3d, 0xae, 0xee, 0x8d, 0x7e, 0xd3, 0xff, 0xed, 0xb4, 0x5e, 0xad, 0x5c, 0xc3, 0xd9,
-	0xcb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x73, 0x81, 0x70, 0xa2, 0x4b, 0x05, 0x00, 0x00,
+	0x10, 0x36, 0xad, 0x3f, 0x6a, 0x48, 0xd9, 0xca, 0xa2, 0x68, 0x09, 0xa5, 0x85, 0x14, 0x15, 0x69,
+	0x55, 0x34, 0xa0, 0x0b, 0xe5, 0xd6, 0x9c, 0x6a, 0xc8, 0x40, 0x09, 0x38, 0xaa, 0xb1, 0x32, 0x7a,
+	0xe8, 0x85, 0x58, 0x6b, 0x27, 0xe2, 0xa2, 0x22, 0x97, 0xd9, 0x5d, 0x15, 0xb1, 0x9f, 0xa2, 0x4f,
+	0xd1, 0xb7, 0xe8, 0xbd, 0x0f, 0xd0, 0x53, 0x0f, 0xc9, 0xab, 0x14, 0xdc, 0x25, 0x25, 0xa5, 0x01,
+	0x0a, 0xe4, 0x22, 0xcd, 0xcf, 0xb7, 0x33, 0xc3, 0x6f, 0xbe, 0x81, 0xf3, 0x52, 0x8a, 0xc2, 0xa0,
+	0xe2, 0x77, 0x71, 0xa9, 0xa4, 0x91, 0xa4, 0xbf, 0x0f, 0x8c, 0xc6, 0x1b, 0x29, 0x37, 0x5b, 0xbc,
+	0xb0, 0x89, 0xbb, 0xdd, 0xab, 0x0b, 0x23, 0x72, 0xd4, 0x86, 0xe5, 0xa5, 0xc3, 0x8e, 0x60, 0x23,
+	0x37, 0xb2, 0xb1, 0x0b, 0xc9, 0xb1, 0xb6, 0x43, 0xa9, 0x38, 0x2a, 0xed, 0xbc, 0xe9, 0x1f, 0xa7,
+	0x30, 0xa4, 0xc8, 0x77, 0x05, 0x67, 0xc5, 0xfa, 0x7e, 0xb5, 0xce, 0x30, 0x47, 0xf2, 0x3d, 0xb4,
+	0xcd, 0x7d, 0x89, 0x91, 0x37, 0xf1, 0x66, 0x67, 0xf3, 0xaf, 0xe2, 0xc3, 0x18, 0xff, 0x85, 0xc6,
+	0xee, 0xef, 0xf6, 0xbe, 0x44, 0x6a, 0xdf, 0x90, 0xcf, 0xa0, 0x97, 0x8b, 0x22, 0x55, 0xf8, 0x3a,
+	0x3a, 0x9d, 0x78, 0xb3, 0x0e, 0xed, 0xe6, 0xa2, 0xa0, 0xf8, 0x9a, 0x7c, 0x02, 0x1d, 0x23, 0x0d,
+	0xdb, 0x46, 0x2d, 0x1b, 0x76, 0x0e, 0xf9, 0x06, 0x86, 0x0a, 0x4b, 0x26, 0x54, 0x6a, 0x32, 0x85,
+	0x3a, 0x93, 0x5b, 0x1e, 0xb5, 0x2d, 0xe0, 0xdc, 0xc5, 0x6f, 0x9b, 0x30, 0xf9, 0x16, 0x1e, 0xe9,
+	0xdd, 0x7a, 0x8d, 0x5a, 0x1f, 0x61, 0x3b, 0x16, 0x3b, 0xac, 0x13, 0x07, 0xf0, 0x33, 0x20, 0xa8,
+	0x98, 0xde, 0x29, 0x4c, 0x75, 0xc6, 0xaa, 0x5f, 0xf1, 0x80, 0x51, 0xd7, 0xa1, 0xeb, 0xcc, 0xaa,
+	0x4a, 0xac, 0xc4, 0x03, 0x4e, 0x9f, 0x00, 0x1c, 0x3e, 0x84, 0x04, 0xd0, 0x4b, 0x96, 0x3f, 0xff,
+	0x70, 0x9d, 0x2c, 0x86, 0x27, 0xa4, 0x0b, 0xa7, 0x74, 0x35, 0xf4, 0xa6, 0x0f, 0x10, 0x50, 0xcc,
+	0xa5, 0xc1, 0x1b, 0x81, 0x6b, 0x24, 0x8f, 0xa1, 0x5f, 0x56, 0x46, 0x5a, 0xec, 0x72, 0xcb, 0x53,
+	0x87, 0xfa, 0x36, 0xb0, 0xdc, 0xe5, 0xe4, 0x6b, 0xe8, 0x55, 0x84, 0xa7, 0x82, 0x5b, 0x0e, 0xc2,
+	0xcb, 0xb3, 0xbf, 0xde, 0x8e, 0x4f, 0xfe, 0x79, 0x3b, 0xee, 0x2e, 0x25, 0xc7, 0x64, 0x41, 0xbb,
+	0x55, 0x3a, 0xe1, 0xe4, 0x29, 0xb4, 0x33, 0xa6, 0x33, 0x4b, 0x49, 0x30, 0x7f, 0x14, 0xd7, 0xab,
+	0xb1, 0x2d, 0x7e, 0x64, 0x3a, 0xa3, 0x36, 0x3d, 0x7d, 0xe7, 0xc1, 0xc0, 0x35, 0x5f, 0xe1, 0x26,
+	0xc7, 0xc2, 0x90, 0x17, 0x00, 0x6a, 0xbf, 0x0a, 0xdb, 0x3f, 0x98, 0x3f, 0xfe, 0x9f, 0x3d, 0xd1,
+	0x23, 0x38, 0x79, 0x0e, 0x03, 0x25, 0xa5, 0x49, 0xdd, 0x07, 0xec, 0x87, 0x3c, 0xaf, 0x87, 0xec,
+	0xd9, 0xf6, 0xc9, 0x82, 0x06, 0x15, 0xca, 0x39, 0x9c, 0xbc, 0x80, 0x81, 0xb2, 0x23, 0xb8, 0x67,
+	0x3a, 0x6a, 0x4d, 0x5a, 0xb3, 0x60, 0xfe, 0xe9, 0x7b, 0x4d, 0xf7, 0xfc, 0xd0, 0x50, 0x1d, 0x1c,
+	0x4d, 0xc6, 0x10, 0xe4, 0xa8, 0x7e, 0xdd, 0x62, 0x5a, 0x95, 0xb4, 0x0b, 0x0e, 0x29, 0xb8, 0x10,
+	0x95, 0xd2, 0x4c, 0xff, 0x6e, 0x41, 0xef, 0xc6, 0x15, 0x22, 0x17, 0xef, 0xa9, 0xef, 0xf8, 0xab,
+	0x6a, 0x44, 0xbc, 0x60, 0x86, 0x1d, 0x49, 0xee, 0x29, 0x9c, 0x89, 0x62, 0x2b, 0x0a, 0x4c, 0xb5,
+	0xa3, 0xc7, 0xf2, 0x19, 0xd2, 0x81, 0x8b, 0x36, 0x9c, 0x7d, 0x07, 0x5d, 0x37, 0x94, 0xed, 0x1f,
+	0xcc, 0xa3, 0x0f, 0x46, 0xaf, 0x91, 0xb4, 0xc6, 0x91, 0x27, 0x10, 0xd6, 0x15, 0x9d, 0x7c, 0x2a,
+	0xb1, 0xb5, 0x68, 0x50, 0xc7, 0x2a, 0xe5, 0x90, 0x04, 0x06, 0x6b, 0x85, 0xcc, 0x08, 0x59, 0xa4,
+	0x9c, 0x19, 0x27, 0xb1, 0x60, 0x3e, 0x8a, 0xdd, 0x79, 0xc6, 0xcd, 0x79, 0xc6, 0xb7, 0xcd, 0x79,
+	0x5e, 0xfa, 0x15, 0xcf, 0xbf, 0xbf, 0x1b, 0x7b, 0x34, 0x6c, 0x9e, 0x2e, 0x98, 0x41, 0xf2, 0x12,
+	0xce, 0xf1, 0x4d, 0x29, 0xd4, 0x51, 0xb1, 0xde, 0x47, 0x14, 0x3b, 0x3b, 0x3c, 0xb6, 0xe5, 0x46,
+	0xe0, 0xe7, 0x68, 0x18, 0x67, 0x86, 0x45, 0xbe, 0xe5, 0x63, 0xef, 0x93, 0x2f, 0x61, 0xe0, 0xb6,
+	0xe8, 0x8e, 0x83, 0x47, 0xfd, 0x89, 0x37, 0xf3, 0x69, 0xe8, 0x82, 0xf6, 0x2e, 0x38, 0xf9, 0x02,
+	0xe0, 0x37, 0x54, 0xba, 0x1a, 0x46, 0xf0, 0x08, 0x26, 0xde, 0xac, 0x4f, 0xfb, 0x75, 0x24, 0xe1,
+	0xd3, 0x29, 0xf8, 0xcd, 0x1e, 0x08, 0x40, 0x37, 0x59, 0x5e, 0x27, 0xcb, 0xab, 0xe1, 0x49, 0x65,
+	0xd3, 0xab, 0x97, 0x3f, 0xdd, 0x5e, 0x0d, 0xbd, 0xe9, 0x9f, 0x1e, 0x84, 0xd7, 0x42, 0x1b, 0x8a,
+	0xba, 0x94, 0x85, 0x46, 0x32, 0x87, 0x8e, 0x30, 0x98, 0xeb, 0xc8, 0xb3, 0xea, 0xf9, 0xfc, 0x68,
+	0x05, 0xc7, 0xb8, 0x38, 0x31, 0x98, 0x53, 0x07, 0x25, 0x04, 0xda, 0xb9, 0x54, 0x68, 0x55, 0xea,
+	0x53, 0x6b, 0x8f, 0x10, 0xda, 0x15, 0xa4, 0xca, 0x95, 0xcc, 0x64, 0x56, 0x2b, 0x7d, 0x6a, 0x6d,
+	0xf2, 0x0c, 0x7a, 0x75, 0x55, 0xfb, 0x24, 0x98, 0x93, 0x0f, 0x25, 0x44, 0x1b, 0x48, 0x75, 0xc8,
+	0x42, 0xa7, 0xa5, 0xc2, 0x57, 0xe2, 0x8d, 0xd5, 0x8d, 0x4f, 0x7d, 0xa1, 0x6f, 0xac, 0x7f, 0xd9,
+	0xfe, 0xe5, 0xb4, 0xbc, 0xbb, 0xeb, 0x5a, 0xde, 0x9f, 0xff, 0x3b, 0x00, 0xbc, 0xed, 0x90, 0xb6,
+	0x8f, 0x05, 0x00, 0x00,
 }
@@ -21,8 +21,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type SegmentMeta struct {
-	EncryptedKey         []byte   `protobuf:"bytes,1,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
-	KeyNonce             []byte   `protobuf:"bytes,2,opt,name=key_nonce,json=keyNonce,proto3" json:"key_nonce,omitempty"`
+	EncryptedKey []byte `protobuf:"bytes,1,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+	KeyNonce     []byte `protobuf:"bytes,2,opt,name=key_nonce,json=keyNonce,proto3" json:"key_nonce,omitempty"`
+	// sizes of the independently compressed blocks of the segment,
+	// empty when the stream isn't compressed
+	CompressedBlockSizes []int32  `protobuf:"varint,3,rep,packed,name=compressed_block_sizes,json=compressedBlockSizes,proto3" json:"compressed_block_sizes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -66,6 +69,13 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 	return nil
 }
 
+func (m *SegmentMeta) GetCompressedBlockSizes() []int32 {
+	if m != nil {
+		return m.CompressedBlockSizes
+	}
+	return nil
+}
+
 type StreamInfo struct {
 	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
 	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
@@ -133,6 +143,8 @@ type StreamMeta struct {
 	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
 	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
 	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
+	CompressionType      int32        `protobuf:"varint,5,opt,name=compression_type,json=compressionType,proto3" json:"compression_type,omitempty"`
+	CompressionBlockSize int32        `protobuf:"varint,6,opt,name=compression_block_size,json=compressionBlockSize,proto3" json:"compression_block_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -190,6 +202,20 @@ func (m *StreamMeta) GetLastSegmentMeta() *SegmentMeta {
 	return nil
 }
 
+func (m *StreamMeta) GetCompressionType() int32 {
+	if m != nil {
+		return m.CompressionType
+	}
+	return 0
+}
+
+func (m *StreamMeta) GetCompressionBlockSize() int32 {
+	if m != nil {
+		return m.CompressionBlockSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
@@ -199,24 +225,28 @@ func init() {
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x95, 0xa6, 0x29, 0xc5, 0x6d, 0x69, 0x31, 0x05, 0x45, 0xb0, 0x54, 0x65, 0xa0, 0x20,
+	0xd4, 0xa1, 0xf0, 0x00, 0xa8, 0x1b, 0x42, 0x80, 0x94, 0x32, 0xb1, 0x58, 0x49, 0x7a, 0x41, 0x51,
+	0x1a, 0x3b, 0x8a, 0xcd, 0x60, 0x76, 0x9e, 0x85, 0xd7, 0xe0, 0xd1, 0x90, 0x9d, 0x38, 0x31, 0x1d,
+	0x7d, 0xf7, 0xeb, 0xee, 0xfb, 0xff, 0x33, 0x1a, 0x71, 0x51, 0x42, 0x98, 0xf3, 0x65, 0x51, 0x32,
+	0xc1, 0xf0, 0x41, 0xfd, 0x9c, 0x7f, 0x3b, 0x68, 0xb0, 0x81, 0x8f, 0x1c, 0xa8, 0x78, 0x06, 0x11,
+	0xe2, 0x4b, 0x34, 0x02, 0x1a, 0x97, 0xb2, 0x10, 0xb0, 0x25, 0x19, 0x48, 0xdf, 0x99, 0x39, 0x8b,
+	0x61, 0x30, 0x6c, 0x8a, 0x4f, 0x20, 0xf1, 0x05, 0x3a, 0xcc, 0x40, 0x12, 0xca, 0x68, 0x0c, 0x7e,
+	0x47, 0x0b, 0xfa, 0x19, 0xc8, 0x17, 0xf5, 0xc6, 0xf7, 0xe8, 0x2c, 0x66, 0x79, 0x51, 0x02, 0xe7,
+	0xb0, 0x25, 0xd1, 0x8e, 0xc5, 0x19, 0xe1, 0xe9, 0x17, 0x70, 0xdf, 0x9d, 0xb9, 0x0b, 0x2f, 0x98,
+	0xb6, 0xdd, 0xb5, 0x6a, 0x6e, 0x54, 0x6f, 0xfe, 0xe3, 0x20, 0xb4, 0xd1, 0x4c, 0x8f, 0x34, 0x61,
+	0xf8, 0x16, 0x61, 0xfa, 0x99, 0x47, 0x50, 0x12, 0x96, 0x10, 0x5e, 0xf1, 0x71, 0xcd, 0xe2, 0x06,
+	0x93, 0xaa, 0xf3, 0x9a, 0xd4, 0xdc, 0x5c, 0x41, 0x1b, 0x8d, 0x5e, 0xa5, 0x99, 0xdc, 0x60, 0x68,
+	0x8a, 0x6a, 0x05, 0xbe, 0x41, 0xc7, 0xbb, 0x90, 0x0b, 0x33, 0xad, 0x12, 0xba, 0x5a, 0x38, 0x56,
+	0x8d, 0x7a, 0x9a, 0xd6, 0x9e, 0xa3, 0x7e, 0x0e, 0x22, 0xdc, 0x86, 0x22, 0xf4, 0xbb, 0x95, 0x3f,
+	0xf3, 0x9e, 0xff, 0x76, 0x0c, 0xa9, 0x0e, 0x6c, 0x85, 0x4e, 0xdb, 0xc0, 0xaa, 0x54, 0x49, 0x4a,
+	0x13, 0x56, 0x07, 0x77, 0xd2, 0x34, 0x2d, 0x77, 0x57, 0x68, 0x5c, 0x97, 0x53, 0x46, 0x89, 0x90,
+	0x45, 0x45, 0xec, 0x05, 0x47, 0x6d, 0xf9, 0x4d, 0x16, 0x60, 0x0d, 0x57, 0xc2, 0x36, 0x4b, 0xcd,
+	0xed, 0x35, 0xc3, 0x53, 0x46, 0x9b, 0x28, 0xf1, 0xc3, 0x9e, 0xcf, 0x1c, 0x6a, 0x13, 0x83, 0xd5,
+	0x74, 0x69, 0x7e, 0x81, 0x75, 0xf2, 0x7f, 0xee, 0xb5, 0xa5, 0x6b, 0x34, 0x31, 0x37, 0x6a, 0xf8,
+	0x3c, 0xbd, 0x70, 0x6c, 0xd5, 0x35, 0xa0, 0x75, 0xec, 0x3d, 0xc2, 0xde, 0xcc, 0xb1, 0x8f, 0x6d,
+	0x23, 0xae, 0xbb, 0xef, 0x9d, 0x22, 0x8a, 0x7a, 0xfa, 0x2b, 0xde, 0xfd, 0x0d, 0x00, 0x00, 0x83,
+	0x3a, 0x6c, 0x9b, 0x02, 0x00, 0x00,
 }
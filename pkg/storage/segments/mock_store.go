@@ -66,6 +66,20 @@ func (mr *MockStoreMockRecorder) Get(ctx, path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), ctx, path)
 }
 
+// GetRange mocks base method
+func (m *MockStore) GetRange(ctx context.Context, path storj.Path, offset, length int64) (ranger.Ranger, Meta, error) {
+	ret := m.ctrl.Call(m, "GetRange", ctx, path, offset, length)
+	ret0, _ := ret[0].(ranger.Ranger)
+	ret1, _ := ret[1].(Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRange indicates an expected call of GetRange
+func (mr *MockStoreMockRecorder) GetRange(ctx, path, offset, length interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockStore)(nil).GetRange), ctx, path, offset, length)
+}
+
 // Repair mocks base method
 func (m *MockStore) Repair(ctx context.Context, path storj.Path, lostPieces []int32) error {
 	ret := m.ctrl.Call(m, "Repair", ctx, path, lostPieces)
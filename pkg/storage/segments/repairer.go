@@ -150,12 +150,18 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		requestCount = int(totalNeeded) - len(healthyPieces)
 	}
 
+	placement, err := repairer.metainfo.GetPlacement(ctx, path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		Placement:      placement,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -215,12 +221,17 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair)
 
-	// if partial repair, include "unhealthy" pieces that are not duplicates
+	// if partial repair, include "unhealthy" pieces that are not duplicates,
+	// pieces on disqualified nodes are dropped as those nodes won't come back
 	if healthyLength < pointer.Remote.Redundancy.SuccessThreshold {
 		for _, p := range unhealthyPieces {
-			if _, ok := healthyMap[p.GetPieceNum()]; !ok {
-				healthyPieces = append(healthyPieces, p)
+			if _, ok := healthyMap[p.GetPieceNum()]; ok {
+				continue
+			}
+			if repairer.isDisqualified(ctx, p.NodeId) {
+				continue
 			}
+			healthyPieces = append(healthyPieces, p)
 		}
 	}
 
@@ -231,6 +242,16 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	return repairer.metainfo.Put(ctx, path, pointer)
 }
 
+// isDisqualified returns whether the node is known to be disqualified
+func (repairer *Repairer) isDisqualified(ctx context.Context, nodeID storj.NodeID) bool {
+	node, err := repairer.cache.Get(ctx, nodeID)
+	if err != nil {
+		repairer.log.Debug("unable to get node", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return false
+	}
+	return node.Disqualified != nil
+}
+
 // sliceToSet converts the given slice to a set
 func sliceToSet(slice []int32) map[int32]struct{} {
 	set := make(map[int32]struct{}, len(slice))
@@ -44,6 +44,7 @@ type ListItem struct {
 type Store interface {
 	Meta(ctx context.Context, path storj.Path) (meta Meta, err error)
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
+	GetRange(ctx context.Context, path storj.Path, offset, length int64) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
@@ -180,12 +181,39 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return s.get(ctx, path, 0, -1)
+}
+
+// GetRange works like Get, but the returned ranger of a remote segment can only
+// read the given range of the segment. Only the erasure shares of the stripes
+// intersecting the range are requested from the satellite and downloaded.
+func (s *segmentStore) GetRange(ctx context.Context, path storj.Path, offset, length int64) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if offset < 0 || length <= 0 {
+		return nil, Meta{}, Error.New("invalid range offset %d, length %d", offset, length)
+	}
+
+	return s.get(ctx, path, offset, length)
+}
+
+// get reads the segment, a negative length reads the whole segment
+func (s *segmentStore) get(ctx context.Context, path storj.Path, offset, length int64) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	pointer, limits, piecePrivateKey, err := s.metainfo.ReadSegment(ctx, bucket, objectPath, segmentIndex)
+	var pointer *pb.Pointer
+	var limits []*pb.AddressedOrderLimit
+	var piecePrivateKey storj.PiecePrivateKey
+	if length < 0 {
+		pointer, limits, piecePrivateKey, err = s.metainfo.ReadSegment(ctx, bucket, objectPath, segmentIndex)
+	} else {
+		pointer, limits, piecePrivateKey, err = s.metainfo.ReadSegmentRange(ctx, bucket, objectPath, segmentIndex, offset, length)
+	}
 	if err != nil {
 		return nil, Meta{}, Error.Wrap(err)
 	}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrJournal is the error class for upload journal failures.
+var ErrJournal = errs.Class("upload journal error")
+
+// UploadState is the progress of a resumable upload as recorded in its journal.
+type UploadState struct {
+	// StreamID identifies the upload the journal belongs to.
+	StreamID string
+	// Path is the unencrypted path of the object, including the bucket.
+	Path storj.Path
+	// SegmentSize is the segment size used when the upload was started.
+	SegmentSize int64
+	// CommittedSegments is the number of segments stored on the satellite.
+	CommittedSegments int64
+	// CommittedSize is the number of plain bytes in the committed segments.
+	CommittedSize int64
+	// NextContentNonce is the content nonce for the first segment that was
+	// not committed yet.
+	NextContentNonce storj.Nonce
+}
+
+// UploadJournal persists the progress of a resumable upload, so that the
+// upload can continue from the last committed segment after the uploading
+// process restarts.
+type UploadJournal interface {
+	// Load returns the saved state or nil, if nothing was saved yet.
+	Load(ctx context.Context) (*UploadState, error)
+	// Save persists the state after a segment was committed.
+	Save(ctx context.Context, state *UploadState) error
+	// Finish removes the journal once the upload has completed.
+	Finish(ctx context.Context) error
+}
+
+// fileJournal is an UploadJournal that keeps the state in a local JSON file.
+type fileJournal struct {
+	path string
+}
+
+// NewFileJournal returns an UploadJournal stored in the file at path.
+func NewFileJournal(path string) UploadJournal {
+	return &fileJournal{path: path}
+}
+
+// Load returns the saved state or nil, if the journal file doesn't exist.
+func (journal *fileJournal) Load(ctx context.Context) (_ *UploadState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := ioutil.ReadFile(journal.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ErrJournal.Wrap(err)
+	}
+
+	var state UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, ErrJournal.Wrap(err)
+	}
+	return &state, nil
+}
+
+// Save atomically replaces the journal file with state.
+func (journal *fileJournal) Save(ctx context.Context, state *UploadState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return ErrJournal.Wrap(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(journal.path), 0700); err != nil {
+		return ErrJournal.Wrap(err)
+	}
+
+	tmpPath := journal.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return ErrJournal.Wrap(err)
+	}
+	return ErrJournal.Wrap(os.Rename(tmpPath, journal.path))
+}
+
+// Finish removes the journal file.
+func (journal *fileJournal) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = os.Remove(journal.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return ErrJournal.Wrap(err)
+}
+
+// newStreamID returns a random identifier for a new resumable upload.
+func newStreamID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", ErrJournal.Wrap(err)
+	}
+	return hex.EncodeToString(id[:]), nil
+}
@@ -19,6 +19,7 @@ type Store interface {
 	Meta(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, journal UploadJournal) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
@@ -27,9 +28,10 @@ type shimStore struct {
 	store typedStore
 }
 
-// NewStreamStore constructs a Store.
-func NewStreamStore(segments segments.Store, segmentSize int64, encStore *encryption.Store, encBlockSize int, cipher storj.CipherSuite, inlineThreshold int) (Store, error) {
-	typedStore, err := newTypedStreamStore(segments, segmentSize, encStore, encBlockSize, cipher, inlineThreshold)
+// NewStreamStore constructs a Store. When the algorithm of compression isn't
+// storj.CompressionNone, the data is compressed before it's encrypted.
+func NewStreamStore(segments segments.Store, segmentSize int64, encStore *encryption.Store, encBlockSize int, cipher storj.CipherSuite, inlineThreshold int, compression storj.CompressionParameters) (Store, error) {
+	typedStore, err := newTypedStreamStore(segments, segmentSize, encStore, encBlockSize, cipher, inlineThreshold, compression)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +59,13 @@ func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.C
 	return s.store.Put(ctx, ParsePath(path), pathCipher, data, metadata, expiration)
 }
 
+// PutResumable parses the passed in path and dispatches to the typed store.
+func (s *shimStore) PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, journal UploadJournal) (_ Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.PutResumable(ctx, ParsePath(path), pathCipher, data, metadata, expiration, journal)
+}
+
 // Delete parses the passed in path and dispatches to the typed store.
 func (s *shimStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/compression"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/paths"
@@ -53,6 +54,7 @@ type typedStore interface {
 	Meta(ctx context.Context, path Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, journal UploadJournal) (Meta, error)
 	Delete(ctx context.Context, path Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix Path, startAfter, endBefore string, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
@@ -66,16 +68,20 @@ type streamStore struct {
 	encBlockSize    int
 	cipher          storj.CipherSuite
 	inlineThreshold int
+	compression     storj.CompressionParameters
 }
 
 // newTypedStreamStore constructs a typedStore backed by a streamStore.
-func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *encryption.Store, encBlockSize int, cipher storj.CipherSuite, inlineThreshold int) (typedStore, error) {
+func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *encryption.Store, encBlockSize int, cipher storj.CipherSuite, inlineThreshold int, compression storj.CompressionParameters) (typedStore, error) {
 	if segmentSize <= 0 {
 		return nil, errs.New("segment size must be larger than 0")
 	}
 	if encBlockSize <= 0 {
 		return nil, errs.New("encryption block size must be larger than 0")
 	}
+	if compression.Algorithm != storj.CompressionNone && compression.BlockSize <= 0 {
+		return nil, errs.New("compression block size must be larger than 0")
+	}
 
 	return &streamStore{
 		segments:        segments,
@@ -84,6 +90,7 @@ func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *e
 		encBlockSize:    encBlockSize,
 		cipher:          cipher,
 		inlineThreshold: inlineThreshold,
+		compression:     compression,
 	}, nil
 }
 
@@ -102,7 +109,7 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, nil, nil)
 	if err != nil {
 		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
 	}
@@ -110,14 +117,102 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+// PutResumable works like Put, but records every committed segment in the
+// journal. When the journal already contains the state of an interrupted
+// upload of the same path, the committed segments are kept, the same amount
+// of data is skipped from data and the upload continues from the first
+// segment that wasn't committed. Committed segments aren't deleted when the
+// upload fails, so it can be resumed later.
+func (s *streamStore) PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, journal UploadJournal) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := journal.Load(ctx)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if state == nil {
+		// previously file uploaded?
+		err = s.Delete(ctx, path, pathCipher)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return Meta{}, err
+		}
+
+		streamID, err := newStreamID()
+		if err != nil {
+			return Meta{}, err
+		}
+		state = &UploadState{
+			StreamID:    streamID,
+			Path:        path.String(),
+			SegmentSize: s.segmentSize,
+		}
+		if _, err := encryption.Increment(&state.NextContentNonce, 1); err != nil {
+			return Meta{}, err
+		}
+		if err := journal.Save(ctx, state); err != nil {
+			return Meta{}, err
+		}
+	} else {
+		if state.Path != path.String() || state.SegmentSize != s.segmentSize {
+			return Meta{}, ErrJournal.New("journal of upload %s doesn't match %q", state.StreamID, path)
+		}
+
+		var expectedNonce storj.Nonce
+		if _, err := encryption.Increment(&expectedNonce, state.CommittedSegments+1); err != nil {
+			return Meta{}, err
+		}
+		if expectedNonce != state.NextContentNonce {
+			return Meta{}, ErrJournal.New("journal of upload %s has an invalid nonce state", state.StreamID)
+		}
+
+		if err := skipCommitted(data, state.CommittedSize); err != nil {
+			return Meta{}, err
+		}
+	}
+
+	m, _, err = s.upload(ctx, path, pathCipher, data, metadata, expiration, journal, state)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return m, journal.Finish(ctx)
+}
+
+// skipCommitted advances data past the bytes already stored in committed segments.
+func skipCommitted(data io.Reader, size int64) error {
+	if seeker, ok := data.(io.Seeker); ok {
+		_, err := seeker.Seek(size, io.SeekCurrent)
+		return err
+	}
+
+	n, err := io.CopyN(ioutil.Discard, data, size)
+	if err == io.EOF {
+		return ErrJournal.New("data is shorter than the committed segments: %d < %d", n, size)
+	}
+	return err
+}
+
+// upload stores data in segments. When journal is not nil, the upload starts
+// with the segment after the committed segments in state and the progress is
+// saved to the journal after every segment.
+func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, journal UploadJournal, state *UploadState) (m Meta, lastSegment int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var currentSegment int64
 	var streamSize int64
 	var putMeta segments.Meta
 
+	if journal != nil {
+		currentSegment = state.CommittedSegments
+		streamSize = state.CommittedSize
+	}
+
 	defer func() {
+		if journal != nil {
+			// keep the committed segments for resuming the upload
+			return
+		}
 		select {
 		case <-ctx.Done():
 			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
@@ -172,6 +267,17 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 
 		sizeReader := NewSizeReader(eofReader)
 		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
+
+		// the data is compressed before it's encrypted
+		var compressor *compression.Reader
+		if s.compression.Algorithm != storj.CompressionNone {
+			compressor, err = compression.NewReader(segmentReader, s.compression)
+			if err != nil {
+				return Meta{}, currentSegment, err
+			}
+			segmentReader = compressor
+		}
+
 		peekReader := segments.NewPeekThresholdReader(segmentReader)
 		// If the data is larger than the inline threshold size, then it will be a remote segment
 		isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
@@ -201,19 +307,17 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 					return "", nil, err
 				}
 
-				if s.cipher == storj.EncNull {
+				segmentMeta := s.segmentMeta(encryptedKey, &keyNonce, compressor)
+				if segmentMeta == nil {
 					return segmentPath, nil, nil
 				}
 
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				segmentMetaBytes, err := proto.Marshal(segmentMeta)
 				if err != nil {
 					return "", nil, err
 				}
 
-				return segmentPath, segmentMeta, nil
+				return segmentPath, segmentMetaBytes, nil
 			}
 
 			lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
@@ -241,13 +345,12 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 				EncryptedStreamInfo: encryptedStreamInfo,
 				EncryptionType:      int32(s.cipher),
 				EncryptionBlockSize: int32(s.encBlockSize),
+				LastSegmentMeta:     s.segmentMeta(encryptedKey, &keyNonce, compressor),
 			}
 
-			if s.cipher != storj.EncNull {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				}
+			if compressor != nil {
+				streamMeta.CompressionType = int32(s.compression.Algorithm)
+				streamMeta.CompressionBlockSize = s.compression.BlockSize
 			}
 
 			lastSegmentMeta, err := proto.Marshal(&streamMeta)
@@ -263,6 +366,17 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 
 		currentSegment++
 		streamSize += sizeReader.Size()
+
+		if journal != nil && !eofReader.isEOF() {
+			state.CommittedSegments = currentSegment
+			state.CommittedSize = streamSize
+			if _, err := encryption.Increment(&state.NextContentNonce, 1); err != nil {
+				return Meta{}, currentSegment, err
+			}
+			if err := journal.Save(ctx, state); err != nil {
+				return Meta{}, currentSegment, err
+			}
+		}
 	}
 
 	if eofReader.hasError() {
@@ -316,6 +430,11 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
+	compressionParams := storj.CompressionParameters{
+		Algorithm: storj.CompressionAlgorithm(streamMeta.CompressionType),
+		BlockSize: streamMeta.CompressionBlockSize,
+	}
+
 	var rangers []ranger.Ranger
 	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
 		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
@@ -337,6 +456,7 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 			startingNonce: &contentNonce,
 			encBlockSize:  int(streamMeta.EncryptionBlockSize),
 			cipher:        storj.CipherSuite(streamMeta.EncryptionType),
+			compression:   compressionParams,
 		})
 	}
 
@@ -346,17 +466,16 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
-	encryptedKey, keyNonce := getEncryptedKeyAndNonce(streamMeta.LastSegmentMeta)
-	decryptedLastSegmentRanger, err := decryptRanger(
+	decryptedLastSegmentRanger, err := decodeRanger(
 		ctx,
 		lastSegmentRanger,
 		stream.LastSegmentSize,
 		storj.CipherSuite(streamMeta.EncryptionType),
 		derivedKey,
-		encryptedKey,
-		keyNonce,
+		streamMeta.LastSegmentMeta,
 		&contentNonce,
 		int(streamMeta.EncryptionBlockSize),
+		compressionParams,
 	)
 	if err != nil {
 		return nil, Meta{}, err
@@ -543,7 +662,6 @@ func (s *streamStore) List(ctx context.Context, prefix Path, startAfter, endBefo
 }
 
 type lazySegmentRanger struct {
-	ranger        ranger.Ranger
 	segments      segments.Store
 	path          storj.Path
 	size          int64
@@ -551,6 +669,7 @@ type lazySegmentRanger struct {
 	startingNonce *storj.Nonce
 	encBlockSize  int
 	cipher        storj.CipherSuite
+	compression   storj.CompressionParameters
 }
 
 // Size implements Ranger.Size
@@ -558,26 +677,86 @@ func (lr *lazySegmentRanger) Size() int64 {
 	return lr.size
 }
 
-// Range implements Ranger.Range to be lazily connected
+// Range implements Ranger.Range to be lazily connected. Every call requests
+// new order limits from the satellite, which only cover the encrypted blocks
+// of the requested range. The compressed blocks of a compressed segment are
+// listed in its metadata, so it's fetched first to map the range to them.
 func (lr *lazySegmentRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
-	if lr.ranger == nil {
-		rr, m, err := lr.segments.Get(ctx, lr.path)
+
+	var rr ranger.Ranger
+	var m segments.Meta
+	if length > 0 && length < lr.size {
+		rangeOffset, rangeLength := offset, length
+		if lr.compression.Algorithm != storj.CompressionNone {
+			meta, err := lr.segments.Meta(ctx, lr.path)
+			if err != nil {
+				return nil, err
+			}
+			segmentMeta := pb.SegmentMeta{}
+			err = proto.Unmarshal(meta.Data, &segmentMeta)
+			if err != nil {
+				return nil, err
+			}
+			rangeOffset, rangeLength = compression.CompressedRange(lr.compression, segmentMeta.GetCompressedBlockSizes(), offset, length)
+		}
+
+		encOffset, encLength, err := lr.encryptedRange(rangeOffset, rangeLength)
 		if err != nil {
 			return nil, err
 		}
-		segmentMeta := pb.SegmentMeta{}
-		err = proto.Unmarshal(m.Data, &segmentMeta)
+		rr, m, err = lr.segments.GetRange(ctx, lr.path, encOffset, encLength)
 		if err != nil {
 			return nil, err
 		}
-		encryptedKey, keyNonce := getEncryptedKeyAndNonce(&segmentMeta)
-		lr.ranger, err = decryptRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, lr.startingNonce, lr.encBlockSize)
+	} else {
+		rr, m, err = lr.segments.Get(ctx, lr.path)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return lr.ranger.Range(ctx, offset, length)
+
+	segmentMeta := pb.SegmentMeta{}
+	err = proto.Unmarshal(m.Data, &segmentMeta)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := decodeRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, &segmentMeta, lr.startingNonce, lr.encBlockSize, lr.compression)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.Range(ctx, offset, length)
+}
+
+// encryptedRange returns the range of the encrypted segment, which contains
+// the encrypted blocks of the given range of the decrypted segment
+func (lr *lazySegmentRanger) encryptedRange(offset, length int64) (encOffset, encLength int64, err error) {
+	// the key and nonce don't matter for calculating the block sizes
+	decrypter, err := encryption.NewDecrypter(lr.cipher, new(storj.Key), new(storj.Nonce), lr.encBlockSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	firstBlock, blockCount := encryption.CalcEncompassingBlocks(offset, length, decrypter.OutBlockSize())
+	return firstBlock * int64(decrypter.InBlockSize()), blockCount * int64(decrypter.InBlockSize()), nil
+}
+
+// decodeRanger returns a decrypted ranger of the given rr ranger, which is
+// also decompressed when the segment was compressed before encryption
+func decodeRanger(ctx context.Context, rr ranger.Ranger, decodedSize int64, cipher storj.CipherSuite, derivedKey *storj.Key, segmentMeta *pb.SegmentMeta, startingNonce *storj.Nonce, encBlockSize int, compressionParams storj.CompressionParameters) (decoded ranger.Ranger, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+	if compressionParams.Algorithm == storj.CompressionNone {
+		return decryptRanger(ctx, rr, decodedSize, cipher, derivedKey, encryptedKey, keyNonce, startingNonce, encBlockSize)
+	}
+
+	blockSizes := segmentMeta.GetCompressedBlockSizes()
+	decrypted, err := decryptRanger(ctx, rr, compression.CompressedSize(blockSizes), cipher, derivedKey, encryptedKey, keyNonce, startingNonce, encBlockSize)
+	if err != nil {
+		return nil, err
+	}
+	return compression.NewRanger(decrypted, compressionParams, decodedSize, blockSizes)
 }
 
 // decryptRanger returns a decrypted ranger of the given rr ranger
@@ -643,6 +822,24 @@ func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, pa
 	}
 }
 
+// segmentMeta returns the metadata of a segment, it's nil when the segment
+// is neither encrypted nor compressed
+func (s *streamStore) segmentMeta(encryptedKey storj.EncryptedPrivateKey, keyNonce *storj.Nonce, compressor *compression.Reader) *pb.SegmentMeta {
+	if s.cipher == storj.EncNull && compressor == nil {
+		return nil
+	}
+
+	segmentMeta := &pb.SegmentMeta{}
+	if s.cipher != storj.EncNull {
+		segmentMeta.EncryptedKey = encryptedKey
+		segmentMeta.KeyNonce = keyNonce[:]
+	}
+	if compressor != nil {
+		segmentMeta.CompressedBlockSizes = compressor.BlockSizes()
+	}
+	return segmentMeta
+}
+
 func getEncryptedKeyAndNonce(m *pb.SegmentMeta) (storj.EncryptedPrivateKey, *storj.Nonce) {
 	if m == nil {
 		return nil, nil
@@ -14,6 +14,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
@@ -96,7 +97,7 @@ func TestStreamStoreMeta(t *testing.T) {
 			Meta(gomock.Any(), gomock.Any()).
 			Return(test.segmentMeta, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, storj.EncAESGCM, 4)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, storj.EncAESGCM, 4, storj.CompressionParameters{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -176,7 +177,7 @@ func TestStreamStorePut(t *testing.T) {
 			Delete(gomock.Any(), gomock.Any()).
 			Return(test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, segSize, newStore(), encBlockSize, dataCipher, inlineSize)
+		streamStore, err := NewStreamStore(mockSegmentStore, segSize, newStore(), encBlockSize, dataCipher, inlineSize, storj.CompressionParameters{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -286,7 +287,7 @@ func TestStreamStoreGet(t *testing.T) {
 
 		gomock.InOrder(calls...)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, segSize, newStore(), encBlockSize, dataCipher, inlineSize)
+		streamStore, err := NewStreamStore(mockSegmentStore, segSize, newStore(), encBlockSize, dataCipher, inlineSize, storj.CompressionParameters{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -301,6 +302,49 @@ func TestStreamStoreGet(t *testing.T) {
 	}
 }
 
+func TestLazySegmentRangerCompressedRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSegmentStore := segments.NewMockStore(ctrl)
+
+	segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
+		CompressedBlockSizes: []int32{100, 200, 300, 50},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errStop := errs.New("stop")
+	gomock.InOrder(
+		mockSegmentStore.EXPECT().
+			Meta(gomock.Any(), "path").
+			Return(segments.Meta{Data: segmentMeta}, nil),
+		// the range is within the compressed blocks 1 and 2 at [100, 600),
+		// which are within the encrypted blocks 6 to 37 of 32 bytes each
+		mockSegmentStore.EXPECT().
+			GetRange(gomock.Any(), "path", int64(6*32), int64(32*32)).
+			Return(nil, segments.Meta{}, errStop),
+	)
+
+	lr := &lazySegmentRanger{
+		segments:      mockSegmentStore,
+		path:          "path",
+		size:          4096,
+		derivedKey:    new(storj.Key),
+		startingNonce: new(storj.Nonce),
+		encBlockSize:  32,
+		cipher:        storj.EncAESGCM,
+		compression: storj.CompressionParameters{
+			Algorithm: storj.CompressionGzip,
+			BlockSize: 1024,
+		},
+	}
+
+	_, err = lr.Range(ctx, 1500, 1000)
+	assert.Equal(t, errStop, err)
+}
+
 func TestStreamStoreDelete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -335,7 +379,7 @@ func TestStreamStoreDelete(t *testing.T) {
 			Delete(gomock.Any(), gomock.Any()).
 			Return(test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0, storj.CompressionParameters{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -379,7 +423,7 @@ func TestStreamStoreList(t *testing.T) {
 			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(test.segments, test.segmentMore, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0, storj.CompressionParameters{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -32,4 +32,70 @@ type Bucket struct {
 	DefaultSegmentsSize         int64
 	DefaultRedundancyScheme     RedundancyScheme
 	DefaultEncryptionParameters EncryptionParameters
+	Versioning                  BucketVersioning
+	Lifecycle                   []LifecycleRule
+	// RedundancyPolicy is the redundancy scheme enforced by the satellite
+	// for new segments of the bucket, if any.
+	RedundancyPolicy *RedundancyScheme
+	// Placement restricts the storage nodes, which are selected for the
+	// pieces of the bucket, if any.
+	Placement *PlacementConstraints
+}
+
+// PlacementConstraints restricts the storage nodes, which are selected for
+// storing and repairing pieces, by their location.
+type PlacementConstraints struct {
+	// CountryCodes are the ISO 3166-1 alpha-2 codes of the countries where
+	// pieces may be stored, all countries are allowed if empty
+	CountryCodes []string
+	// ExcludedCountryCodes are the countries where pieces must not be stored
+	ExcludedCountryCodes []string
+	// DistinctASN requires the pieces of a segment to be stored in
+	// different autonomous systems
+	DistinctASN bool
+}
+
+// LifecycleRule expires the objects below a prefix of a bucket.
+// The prefix is matched against whole path components. The satellite
+// only knows the encrypted prefix.
+type LifecycleRule struct {
+	ID       string
+	Prefix   Path
+	Disabled bool
+
+	// ExpireAfterDays deletes objects the given number of days after
+	// they were created, if not zero
+	ExpireAfterDays int
+	// AbortIncompleteUploadAfterDays deletes the segments of uploads that
+	// weren't committed the given number of days after they were started,
+	// if not zero
+	AbortIncompleteUploadAfterDays int
+}
+
+// BucketVersioning is the versioning state of a bucket
+type BucketVersioning int
+
+const (
+	// VersioningUnversioned means overwritten and deleted objects are not kept
+	VersioningUnversioned = BucketVersioning(iota)
+	// VersioningEnabled means every write creates a new version of the object
+	// and the previous versions are kept until they are deleted explicitly
+	VersioningEnabled
+	// VersioningSuspended means new objects get the null version, but
+	// the versions created while versioning was enabled are kept
+	VersioningSuspended
+)
+
+// String returns the name of the versioning state
+func (versioning BucketVersioning) String() string {
+	switch versioning {
+	case VersioningUnversioned:
+		return "Unversioned"
+	case VersioningEnabled:
+		return "Enabled"
+	case VersioningSuspended:
+		return "Suspended"
+	default:
+		return "Unknown"
+	}
 }
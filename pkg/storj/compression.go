@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+import (
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// CompressionParameters is the settings for compressing the data of an object
+// before it's encrypted
+type CompressionParameters struct {
+	// Algorithm determines the algorithm to be used for compression.
+	Algorithm CompressionAlgorithm
+	// BlockSize is the size of the blocks of data, which are compressed
+	// independently. Smaller sizes yield better seek times, while larger
+	// sizes yield better compression ratios.
+	BlockSize int32
+}
+
+// IsZero returns true if no field in the struct is set to non-zero value
+func (params CompressionParameters) IsZero() bool {
+	return params == (CompressionParameters{})
+}
+
+// CompressionAlgorithm specifies one of the compression algorithms supported
+// by Storj libraries for compressing in-network data.
+type CompressionAlgorithm byte
+
+const (
+	// CompressionNone indicates that the data isn't compressed.
+	CompressionNone = CompressionAlgorithm(iota)
+	// CompressionGzip indicates use of gzip compression.
+	CompressionGzip
+)
+
+// String returns the name of the compression algorithm
+func (algorithm CompressionAlgorithm) String() string {
+	switch algorithm {
+	case CompressionNone:
+		return "none"
+	case CompressionGzip:
+		return "gzip"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseCompressionAlgorithm returns the compression algorithm with the given name
+func ParseCompressionAlgorithm(name string) (CompressionAlgorithm, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return CompressionNone, nil
+	case "gzip":
+		return CompressionGzip, nil
+	default:
+		return CompressionNone, errs.New("unknown compression algorithm %q", name)
+	}
+}
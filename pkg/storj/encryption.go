@@ -5,6 +5,7 @@ package storj
 
 import (
 	"encoding/base32"
+	"encoding/json"
 
 	"github.com/zeebo/errs"
 )
@@ -39,11 +40,15 @@ const (
 	// EncNull indicates use of the NULL cipher; that is, no encryption is
 	// done. The ciphertext is equal to the plaintext.
 	EncNull
-	// EncAESGCM indicates use of AES128-GCM encryption.
+	// EncAESGCM indicates use of AES-GCM encryption with a 12-byte nonce.
+	// The whole 32-byte key is used, i.e. this is AES256-GCM.
 	EncAESGCM
 	// EncSecretBox indicates use of XSalsa20-Poly1305 encryption, as provided
 	// by the NaCl cryptography library under the name "Secretbox".
 	EncSecretBox
+	// EncXChaCha20Poly1305 indicates use of XChaCha20-Poly1305 encryption,
+	// an AEAD with a 24-byte nonce.
+	EncXChaCha20Poly1305
 )
 
 // Constant definitions for key and nonce sizes
@@ -151,8 +156,13 @@ func (nonce Nonce) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON deserializes a json string (as bytes) to a nonce
 func (nonce *Nonce) UnmarshalJSON(data []byte) error {
-	var err error
-	*nonce, err = NonceFromString(string(data))
+	var unquoted string
+	err := json.Unmarshal(data, &unquoted)
+	if err != nil {
+		return err
+	}
+
+	*nonce, err = NonceFromString(unquoted)
 	return err
 }
 
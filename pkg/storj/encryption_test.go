@@ -4,6 +4,7 @@
 package storj_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,17 @@ func TestKey_IsZero(t *testing.T) {
 		require.False(t, key.IsZero())
 	})
 }
+
+func TestNonce_JSON(t *testing.T) {
+	nonce := testrand.Nonce()
+
+	buf, err := json.Marshal(nonce)
+	require.NoError(t, err)
+	assert.Equal(t, `"`+nonce.String()+`"`, string(buf))
+
+	var decoded storj.Nonce
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, nonce, decoded)
+
+	assert.Error(t, decoded.UnmarshalJSON([]byte(`{}`)))
+}
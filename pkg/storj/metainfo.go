@@ -22,11 +22,21 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketVersioning changes the versioning state of a bucket
+	SetBucketVersioning(ctx context.Context, bucket string, versioning BucketVersioning) error
+	// SetBucketLifecycle replaces the lifecycle rules of a bucket
+	SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error
+	// GetBucketLifecycle returns the lifecycle rules of a bucket
+	GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error)
+	// SetBucketPlacement replaces the placement constraints of a bucket
+	SetBucketPlacement(ctx context.Context, bucket string, placement *PlacementConstraints) error
 
 	// GetObject returns information about an object
 	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
 	// GetObjectStream returns interface for reading the object stream
 	GetObjectStream(ctx context.Context, bucket string, path Path) (ReadOnlyStream, error)
+	// GetObjectVersion returns information about a version of an object
+	GetObjectVersion(ctx context.Context, bucket string, path Path, versionID string) (Object, error)
 
 	// CreateObject creates a mutable object for uploading stream info
 	CreateObject(ctx context.Context, bucket string, path Path, info *CreateObject) (MutableObject, error)
@@ -34,8 +44,16 @@ type Metainfo interface {
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// CopyObject copies an object to a new path without transferring its data
+	CopyObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) error
+	// MoveObject moves an object to a new path without transferring its data
+	MoveObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) error
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
+	// DeleteObjectVersion permanently deletes a version of an object or a delete marker
+	DeleteObjectVersion(ctx context.Context, bucket string, path Path, versionID string) error
+	// ListObjectVersions lists all versions of the objects in bucket, including delete markers
+	ListObjectVersions(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
 	// ModifyPendingObject creates a mutable object for updating a partially uploaded object
 	ModifyPendingObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
@@ -119,6 +137,8 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 		return ListOptions{
 			Prefix:    opts.Prefix,
 			Cursor:    list.Items[0].Path,
+			Delimiter: opts.Delimiter,
+			Recursive: opts.Recursive,
 			Direction: Before,
 			Limit:     opts.Limit,
 		}
@@ -126,6 +146,8 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 		return ListOptions{
 			Prefix:    opts.Prefix,
 			Cursor:    list.Items[len(list.Items)-1].Path,
+			Delimiter: opts.Delimiter,
+			Recursive: opts.Recursive,
 			Direction: After,
 			Limit:     opts.Limit,
 		}
@@ -17,6 +17,10 @@ var (
 	ErrObjectNotFound = errs.Class("object not found")
 )
 
+// NullVersionID is the version ID of objects stored while versioning
+// was not enabled for the bucket
+const NullVersionID = "null"
+
 // Object contains information about a specific object
 type Object struct {
 	Version  uint32
@@ -31,6 +35,14 @@ type Object struct {
 	Modified    time.Time
 	Expires     time.Time
 
+	// VersionID identifies the version of the object, it is empty
+	// when the object isn't retrieved as part of a version listing
+	VersionID string
+	// IsLatest is true for the current version of the object
+	IsLatest bool
+	// IsDeleteMarker is true when the version marks the deletion of the object
+	IsDeleteMarker bool
+
 	Stream
 }
 
@@ -51,6 +63,8 @@ type Stream struct {
 	RedundancyScheme
 	// EncryptionParameters specifies encryption strategy used for this stream
 	EncryptionParameters
+	// Compression specifies the compression of the data before encryption
+	Compression CompressionParameters
 
 	LastSegment LastSegment // TODO: remove
 }
@@ -0,0 +1,12 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// ErrLimitExceeded is an error class for requests exceeding the storage or
+// bandwidth limits of the project
+var ErrLimitExceeded = errs.Class("limit exceeded")
@@ -19,6 +19,8 @@ type Download struct {
 	reader  io.ReadCloser
 	offset  int64
 	closed  bool
+
+	prefetch PrefetchConfig
 }
 
 // NewDownload creates new stream download.
@@ -30,6 +32,17 @@ func NewDownload(ctx context.Context, stream storj.ReadOnlyStream, streams strea
 	}
 }
 
+// NewPrefetchDownload creates new stream download, which downloads
+// segments concurrently ahead of the reader.
+func NewPrefetchDownload(ctx context.Context, stream storj.ReadOnlyStream, streams streams.Store, prefetch PrefetchConfig) *Download {
+	return &Download{
+		ctx:      ctx,
+		stream:   stream,
+		streams:  streams,
+		prefetch: prefetch,
+	}
+}
+
 // Read reads up to len(data) bytes into data.
 //
 // If this is the first call it will read from the beginning of the stream.
@@ -111,9 +124,14 @@ func (download *Download) resetReader(offset int64) error {
 		return err
 	}
 
-	download.reader, err = rr.Range(download.ctx, offset, obj.Size-offset)
-	if err != nil {
-		return err
+	segmentSize := obj.FixedSegmentSize
+	if concurrency := download.prefetch.concurrency(segmentSize); concurrency > 1 && obj.Size-offset > segmentSize {
+		download.reader = newPrefetchReader(download.ctx, rr, offset, obj.Size-offset, segmentSize, concurrency)
+	} else {
+		download.reader, err = rr.Range(download.ctx, offset, obj.Size-offset)
+		if err != nil {
+			return err
+		}
 	}
 
 	download.offset = offset
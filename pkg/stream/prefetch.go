@@ -0,0 +1,167 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+// PrefetchConfig configures downloading segments ahead of the reader
+type PrefetchConfig struct {
+	// Segments is the number of segments downloaded concurrently.
+	// Segments are downloaded one after another, if it's less than two.
+	Segments int
+	// MaxMemory is the maximum amount of memory for buffering the prefetched
+	// segments. It limits the number of concurrently downloaded segments further.
+	MaxMemory int64
+}
+
+// concurrency returns the number of segments of the given size that can be
+// downloaded concurrently
+func (config PrefetchConfig) concurrency(segmentSize int64) int {
+	if config.Segments < 2 || segmentSize <= 0 {
+		return 1
+	}
+	concurrency := config.Segments
+	if config.MaxMemory > 0 && int64(concurrency)*segmentSize > config.MaxMemory {
+		concurrency = int(config.MaxMemory / segmentSize)
+	}
+	if concurrency < 1 {
+		return 1
+	}
+	return concurrency
+}
+
+// prefetchChunk is a segment, or a part of it, downloaded ahead of the reader
+type prefetchChunk struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// prefetchReader reads a range of a ranger, downloading the chunks aligned to
+// the segments concurrently. The chunks are buffered in memory and delivered in order.
+type prefetchReader struct {
+	ctx       context.Context
+	cancel    func()
+	wg        sync.WaitGroup
+	rr        ranger.Ranger
+	chunkSize int64
+	limit     int
+
+	next, end int64
+	pending   []*prefetchChunk
+	current   *bytes.Reader
+	err       error
+	closed    bool
+}
+
+// newPrefetchReader returns a reader for length bytes of rr starting at offset,
+// which downloads up to limit chunks of chunkSize concurrently
+func newPrefetchReader(ctx context.Context, rr ranger.Ranger, offset, length, chunkSize int64, limit int) *prefetchReader {
+	ctx, cancel := context.WithCancel(ctx)
+	return &prefetchReader{
+		ctx:       ctx,
+		cancel:    cancel,
+		rr:        rr,
+		chunkSize: chunkSize,
+		limit:     limit,
+		next:      offset,
+		end:       offset + length,
+	}
+}
+
+// Read reads the next chunk in order, starting the downloads of the following ones
+func (reader *prefetchReader) Read(data []byte) (n int, err error) {
+	if reader.closed {
+		return 0, Error.New("already closed")
+	}
+	if reader.err != nil {
+		return 0, reader.err
+	}
+
+	for reader.current == nil || reader.current.Len() == 0 {
+		reader.current = nil
+		reader.prefetch()
+		if len(reader.pending) == 0 {
+			return 0, io.EOF
+		}
+
+		chunk := reader.pending[0]
+		reader.pending = reader.pending[1:]
+		select {
+		case <-chunk.done:
+			reader.err = chunk.err
+		case <-reader.ctx.Done():
+			reader.err = reader.ctx.Err()
+		}
+		if reader.err != nil {
+			return 0, reader.err
+		}
+		reader.current = bytes.NewReader(chunk.data)
+	}
+
+	return reader.current.Read(data)
+}
+
+// prefetch starts downloading chunks, until the limit of buffered chunks is reached.
+// The chunk that is being read counts towards the limit.
+func (reader *prefetchReader) prefetch() {
+	for len(reader.pending) < reader.limit && reader.next < reader.end {
+		offset := reader.next
+		// the first chunk ends at the segment boundary
+		length := reader.chunkSize - offset%reader.chunkSize
+		if offset+length > reader.end {
+			length = reader.end - offset
+		}
+		reader.next += length
+
+		chunk := &prefetchChunk{done: make(chan struct{})}
+		reader.pending = append(reader.pending, chunk)
+
+		reader.wg.Add(1)
+		go func() {
+			defer reader.wg.Done()
+			defer close(chunk.done)
+			chunk.data, chunk.err = reader.download(offset, length)
+		}()
+	}
+}
+
+// download reads a chunk of the ranger into memory
+func (reader *prefetchReader) download(offset, length int64) (_ []byte, err error) {
+	rc, err := reader.rr.Range(reader.ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rc.Close()) }()
+
+	data := make([]byte, length)
+	_, err = io.ReadFull(rc, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Close cancels the pending downloads and waits for them to finish
+func (reader *prefetchReader) Close() error {
+	if reader.closed {
+		return Error.New("already closed")
+	}
+	reader.closed = true
+
+	reader.cancel()
+	reader.wg.Wait()
+	reader.pending = nil
+	reader.current = nil
+	return nil
+}
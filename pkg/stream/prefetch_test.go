@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/ranger"
+)
+
+// trackingRanger records the maximum number of concurrent ranges
+type trackingRanger struct {
+	ranger.Ranger
+
+	mu      sync.Mutex
+	active  int
+	maxSeen int
+	fail    int64
+}
+
+func (rr *trackingRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	rr.mu.Lock()
+	rr.active++
+	if rr.active > rr.maxSeen {
+		rr.maxSeen = rr.active
+	}
+	rr.mu.Unlock()
+	defer func() {
+		rr.mu.Lock()
+		rr.active--
+		rr.mu.Unlock()
+	}()
+
+	if rr.fail > 0 && offset <= rr.fail && rr.fail < offset+length {
+		return nil, errors.New("range failed")
+	}
+	return rr.Ranger.Range(ctx, offset, length)
+}
+
+func TestPrefetchConfigConcurrency(t *testing.T) {
+	for _, tt := range []struct {
+		config      PrefetchConfig
+		segmentSize int64
+		expected    int
+	}{
+		{PrefetchConfig{}, 64, 1},
+		{PrefetchConfig{Segments: 1}, 64, 1},
+		{PrefetchConfig{Segments: 4}, 64, 4},
+		{PrefetchConfig{Segments: 4}, 0, 1},
+		{PrefetchConfig{Segments: 4, MaxMemory: 1024}, 64, 4},
+		{PrefetchConfig{Segments: 4, MaxMemory: 128}, 64, 2},
+		{PrefetchConfig{Segments: 4, MaxMemory: 32}, 64, 1},
+	} {
+		assert.Equal(t, tt.expected, tt.config.concurrency(tt.segmentSize), "%+v %d", tt.config, tt.segmentSize)
+	}
+}
+
+func TestPrefetchReader(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	data := testrand.BytesInt(1000)
+
+	for _, tt := range []struct {
+		offset, length, chunkSize int64
+		limit                     int
+	}{
+		{0, 1000, 100, 4},
+		{0, 1000, 300, 2},
+		{150, 700, 100, 3},
+		{999, 1, 100, 4},
+		{0, 1000, 1000, 4},
+		{0, 0, 100, 4},
+	} {
+		rr := &trackingRanger{Ranger: ranger.ByteRanger(data)}
+		reader := newPrefetchReader(ctx, rr, tt.offset, tt.length, tt.chunkSize, tt.limit)
+
+		result, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		assert.Equal(t, data[tt.offset:tt.offset+tt.length], result, "%+v", tt)
+		assert.True(t, rr.maxSeen <= tt.limit, "%+v: %d concurrent ranges", tt, rr.maxSeen)
+	}
+}
+
+func TestPrefetchReaderError(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	data := testrand.BytesInt(1000)
+	rr := &trackingRanger{Ranger: ranger.ByteRanger(data), fail: 550}
+	reader := newPrefetchReader(ctx, rr, 0, 1000, 100, 4)
+
+	result, err := ioutil.ReadAll(reader)
+	require.Error(t, err)
+	assert.Equal(t, data[:500], result)
+
+	_, err = reader.Read(make([]byte, 10))
+	require.Error(t, err)
+
+	require.NoError(t, reader.Close())
+	require.Error(t, reader.Close())
+}
@@ -21,13 +21,25 @@ type Upload struct {
 	ctx      context.Context
 	stream   storj.MutableStream
 	streams  streams.Store
-	writer   io.WriteCloser
+	writer   *io.PipeWriter
 	closed   bool
 	errgroup errgroup.Group
 }
 
 // NewUpload creates new stream upload.
 func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, nil)
+}
+
+// NewResumableUpload creates new stream upload, which records its progress
+// in journal. If journal contains the progress of an interrupted upload of
+// the same object, the data written is expected to start from the beginning
+// again. The part that was already uploaded is skipped.
+func NewResumableUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store, journal streams.UploadJournal) *Upload {
+	return newUpload(ctx, stream, streams, journal)
+}
+
+func newUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store, journal streams.UploadJournal) *Upload {
 	reader, writer := io.Pipe()
 
 	upload := Upload{
@@ -49,7 +61,12 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		path := storj.JoinPaths(obj.Bucket.Name, obj.Path)
+		if journal != nil {
+			_, err = streams.PutResumable(ctx, path, obj.Bucket.PathCipher, reader, metadata, obj.Expires, journal)
+		} else {
+			_, err = streams.Put(ctx, path, obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		}
 		if err != nil {
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
@@ -71,6 +88,24 @@ func (upload *Upload) Write(data []byte) (n int, err error) {
 	return upload.writer.Write(data)
 }
 
+// CloseWithError aborts the upload with err instead of committing the data
+// written so far and releases the underlying resources.
+func (upload *Upload) CloseWithError(err error) error {
+	if upload.closed {
+		return Error.New("already closed")
+	}
+
+	upload.closed = true
+
+	closeErr := upload.writer.CloseWithError(err)
+
+	// Wait for streams.Put to stop. It fails with err, which the caller
+	// already knows about.
+	_ = upload.errgroup.Wait()
+
+	return closeErr
+}
+
 // Close closes the stream and releases the underlying resources.
 func (upload *Upload) Close() error {
 	if upload.closed {
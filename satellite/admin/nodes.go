@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// node is the representation of a storage node in the admin API
+type node struct {
+	ID                     storj.NodeID `json:"id"`
+	Address                string       `json:"address"`
+	LastIP                 string       `json:"lastIp"`
+	CountryCode            string       `json:"countryCode"`
+	Disqualified           *time.Time   `json:"disqualified"`
+	DisqualificationReason string       `json:"disqualificationReason,omitempty"`
+	Suspended              *time.Time   `json:"suspended"`
+
+	AuditCount            int64     `json:"auditCount"`
+	AuditSuccessCount     int64     `json:"auditSuccessCount"`
+	UptimeCount           int64     `json:"uptimeCount"`
+	UptimeSuccessCount    int64     `json:"uptimeSuccessCount"`
+	AuditReputationAlpha  float64   `json:"auditReputationAlpha"`
+	AuditReputationBeta   float64   `json:"auditReputationBeta"`
+	UptimeReputationAlpha float64   `json:"uptimeReputationAlpha"`
+	UptimeReputationBeta  float64   `json:"uptimeReputationBeta"`
+	LastContactSuccess    time.Time `json:"lastContactSuccess"`
+	LastContactFailure    time.Time `json:"lastContactFailure"`
+}
+
+// getNode returns the status and reputation of the node
+func (server *Server) getNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := server.parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	dossier, err := server.overlay.Get(ctx, nodeID)
+	if err != nil {
+		server.serveNodeError(w, err)
+		return
+	}
+
+	sendJSON(w, http.StatusOK, toNode(dossier))
+}
+
+// disqualifyNode disqualifies the node
+func (server *Server) disqualifyNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := server.parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	if err = server.overlay.DisqualifyNode(ctx, nodeID, pb.DisqualificationReason_OPERATOR); err != nil {
+		server.serveNodeError(w, err)
+		return
+	}
+	server.log.Sugar().Infof("node %s disqualified by operator", nodeID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reinstateNode lifts the disqualification of the node
+func (server *Server) reinstateNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := server.parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	if err = server.overlay.ReinstateNode(ctx, nodeID); err != nil {
+		server.serveNodeError(w, err)
+		return
+	}
+	server.log.Sugar().Infof("node %s reinstated by operator", nodeID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseNodeID returns the node id of the request, it serves an error and
+// returns false when it's invalid
+func (server *Server) parseNodeID(w http.ResponseWriter, r *http.Request) (storj.NodeID, bool) {
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["node"])
+	if err != nil {
+		server.serveError(w, http.StatusBadRequest, Error.New("invalid node id: %v", err))
+		return storj.NodeID{}, false
+	}
+	return nodeID, true
+}
+
+// serveNodeError serves an error returned by the overlay
+func (server *Server) serveNodeError(w http.ResponseWriter, err error) {
+	if overlay.ErrNodeNotFound.Has(err) {
+		server.serveError(w, http.StatusNotFound, Error.New("node not found"))
+		return
+	}
+	server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+}
+
+func toNode(dossier *overlay.NodeDossier) node {
+	var reason string
+	if dossier.Disqualified != nil {
+		reason = dossier.DisqualificationReason.String()
+	}
+
+	return node{
+		ID:                     dossier.Id,
+		Address:                dossier.GetAddress().GetAddress(),
+		LastIP:                 dossier.LastIp,
+		CountryCode:            dossier.Location.CountryCode,
+		Disqualified:           dossier.Disqualified,
+		DisqualificationReason: reason,
+		Suspended:              dossier.Suspended,
+
+		AuditCount:            dossier.Reputation.AuditCount,
+		AuditSuccessCount:     dossier.Reputation.AuditSuccessCount,
+		UptimeCount:           dossier.Reputation.UptimeCount,
+		UptimeSuccessCount:    dossier.Reputation.UptimeSuccessCount,
+		AuditReputationAlpha:  dossier.Reputation.AuditReputationAlpha,
+		AuditReputationBeta:   dossier.Reputation.AuditReputationBeta,
+		UptimeReputationAlpha: dossier.Reputation.UptimeReputationAlpha,
+		UptimeReputationBeta:  dossier.Reputation.UptimeReputationBeta,
+		LastContactSuccess:    dossier.Reputation.LastContactSuccess,
+		LastContactFailure:    dossier.Reputation.LastContactFailure,
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+)
+
+// projectUpdate contains the fields of a project which can be modified, nil
+// fields are left unchanged
+type projectUpdate struct {
+	Description *string `json:"description"`
+}
+
+// projectLimit contains the limits of a project in bytes. 0 means the storage
+// and bandwidth limits fall back to the usage limit, and the usage limit to
+// the default limit of the satellite.
+type projectLimit struct {
+	UsageLimit     int64 `json:"usageLimit"`
+	StorageLimit   int64 `json:"storageLimit"`
+	BandwidthLimit int64 `json:"bandwidthLimit"`
+}
+
+// projectLimitUpdate contains the limits of a project to change, nil fields
+// are left unchanged
+type projectLimitUpdate struct {
+	UsageLimit     *int64 `json:"usageLimit"`
+	StorageLimit   *int64 `json:"storageLimit"`
+	BandwidthLimit *int64 `json:"bandwidthLimit"`
+}
+
+// getProject returns the project
+func (server *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	sendJSON(w, http.StatusOK, project)
+}
+
+// updateProject modifies the project
+func (server *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var update projectUpdate
+	if err = decodeJSON(r, &update); err != nil {
+		server.serveError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	if update.Description != nil {
+		project.Description = *update.Description
+	}
+
+	if err = server.db.Console().Projects().Update(ctx, project); err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, project)
+}
+
+// getProjectLimit returns the limits of the project
+func (server *Server) getProjectLimit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	sendJSON(w, http.StatusOK, toProjectLimit(project))
+}
+
+// updateProjectLimit changes the limits of the project
+func (server *Server) updateProjectLimit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var update projectLimitUpdate
+	if err = decodeJSON(r, &update); err != nil {
+		server.serveError(w, http.StatusBadRequest, err)
+		return
+	}
+	for _, limit := range []*int64{update.UsageLimit, update.StorageLimit, update.BandwidthLimit} {
+		if limit != nil && *limit < 0 {
+			server.serveError(w, http.StatusBadRequest, Error.New("limits must not be negative"))
+			return
+		}
+	}
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	if update.UsageLimit != nil {
+		project.UsageLimit = *update.UsageLimit
+	}
+	if update.StorageLimit != nil {
+		project.StorageLimit = *update.StorageLimit
+	}
+	if update.BandwidthLimit != nil {
+		project.BandwidthLimit = *update.BandwidthLimit
+	}
+
+	if err = server.db.Console().Projects().Update(ctx, project); err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, toProjectLimit(project))
+}
+
+// getAPIKeys lists the API keys of the project
+func (server *Server) getAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	keys, err := server.db.Console().APIKeys().GetByProjectID(ctx, project.ID)
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+	if keys == nil {
+		keys = []console.APIKeyInfo{}
+	}
+
+	sendJSON(w, http.StatusOK, keys)
+}
+
+// deleteAPIKey revokes the API key
+func (server *Server) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.Parse(mux.Vars(r)["apikey"])
+	if err != nil {
+		server.serveError(w, http.StatusBadRequest, Error.New("invalid API key id: %v", err))
+		return
+	}
+
+	_, err = server.db.Console().APIKeys().Get(ctx, *id)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			server.serveError(w, http.StatusNotFound, Error.New("API key not found"))
+			return
+		}
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	if err = server.db.Console().APIKeys().Delete(ctx, *id); err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadProject returns the project of the request, it serves an error and
+// returns false when it can't be loaded
+func (server *Server) loadProject(w http.ResponseWriter, r *http.Request) (*console.Project, bool) {
+	id, err := uuid.Parse(mux.Vars(r)["project"])
+	if err != nil {
+		server.serveError(w, http.StatusBadRequest, Error.New("invalid project id: %v", err))
+		return nil, false
+	}
+
+	project, err := server.db.Console().Projects().Get(r.Context(), *id)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			server.serveError(w, http.StatusNotFound, Error.New("project not found"))
+			return nil, false
+		}
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return nil, false
+	}
+	return project, true
+}
+
+func toProjectLimit(project *console.Project) projectLimit {
+	return projectLimit{
+		UsageLimit:     project.UsageLimit,
+		StorageLimit:   project.StorageLimit,
+		BandwidthLimit: project.BandwidthLimit,
+	}
+}
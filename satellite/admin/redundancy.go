@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/console"
+)
+
+// redundancyPolicy is the redundancy scheme required for new segments of a
+// project or bucket
+type redundancyPolicy struct {
+	RequiredShares int `json:"requiredShares"`
+	RepairShares   int `json:"repairShares"`
+	OptimalShares  int `json:"optimalShares"`
+	TotalShares    int `json:"totalShares"`
+	ShareSize      int `json:"shareSize"`
+}
+
+// setRedundancyPolicy sets the redundancy policy of the project, or of the
+// bucket when the path contains one
+func (server *Server) setRedundancyPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var update redundancyPolicy
+	if err = decodeJSON(r, &update); err != nil {
+		server.serveError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	policy := console.RedundancyPolicy{
+		ProjectID:      project.ID,
+		BucketName:     []byte(mux.Vars(r)["bucket"]),
+		RequiredShares: update.RequiredShares,
+		RepairShares:   update.RepairShares,
+		OptimalShares:  update.OptimalShares,
+		TotalShares:    update.TotalShares,
+		ShareSize:      update.ShareSize,
+	}
+	if err = policy.Validate(server.minimumRedundancy); err != nil {
+		server.serveError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	if _, err = server.db.Console().RedundancyPolicies().Set(ctx, policy); err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, update)
+}
+
+// deleteRedundancyPolicy deletes the redundancy policy of the project, or of
+// the bucket when the path contains one
+func (server *Server) deleteRedundancyPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, ok := server.loadProject(w, r)
+	if !ok {
+		return
+	}
+
+	err = server.db.Console().RedundancyPolicies().Delete(ctx, project.ID, []byte(mux.Vars(r)["bucket"]))
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// repairRequest contains the path of the segment to repair, like
+// projectID/l/bucket/encryptedPath
+type repairRequest struct {
+	Path storj.Path `json:"path"`
+}
+
+// repairQueue describes the repair queue
+type repairQueue struct {
+	Count int `json:"count"`
+}
+
+// repairPath queues the segment at the path for repair
+func (server *Server) repairPath(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request repairRequest
+	if err = decodeJSON(r, &request); err != nil {
+		server.serveError(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(storj.SplitPath(request.Path)) < 4 {
+		server.serveError(w, http.StatusBadRequest, Error.New("invalid segment path %q", request.Path))
+		return
+	}
+
+	// segments queued by an operator are selected before all others
+	err = server.db.RepairQueue().Insert(ctx, &pb.InjuredSegment{
+		Path:         []byte(request.Path),
+		InsertedTime: time.Now().UTC(),
+	}, 0)
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+	server.log.Sugar().Infof("segment %s queued for repair by operator", request.Path)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// getRepairQueue returns the number of segments waiting for repair
+func (server *Server) getRepairQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	count, err := server.db.RepairQueue().Count(ctx)
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, repairQueue{Count: count})
+}
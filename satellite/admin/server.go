@@ -0,0 +1,164 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package admin implements the HTTP API satellite operators use to inspect and
+// modify users, projects, redundancy policies, API keys, storage nodes and the
+// repair queue.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/httpserver"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error is the error class of the admin API
+	Error = errs.Class("admin error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configuration for the admin API server
+type Config struct {
+	Address            string `help:"address of the admin API, the admin API is disabled when empty" default:""`
+	AuthorizationToken string `help:"secret token which must be sent in the Authorization header of admin API requests" default:""`
+}
+
+// DB is the database the admin API operates on
+type DB interface {
+	// Console returns the database of users, projects and API keys
+	Console() console.DB
+	// RepairQueue returns the queue of segments that need repairing
+	RepairQueue() queue.RepairQueue
+}
+
+// Server serves the admin API
+type Server struct {
+	log     *zap.Logger
+	config  Config
+	db      DB
+	overlay *overlay.Cache
+	server  *httpserver.Server
+
+	minimumRedundancy console.RedundancyPolicy
+}
+
+// NewServer creates the admin API server listening on config.Address,
+// redundancy policies weaker than minimumRedundancy are rejected
+func NewServer(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, minimumRedundancy console.RedundancyPolicy) (*Server, error) {
+	if config.AuthorizationToken == "" {
+		return nil, Error.New("authorization token is required")
+	}
+
+	server := &Server{
+		log:     log,
+		config:  config,
+		db:      db,
+		overlay: overlay,
+
+		minimumRedundancy: minimumRedundancy,
+	}
+
+	router := mux.NewRouter()
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(server.authorize)
+
+	api.HandleFunc("/users/{user}", server.getUser).Methods(http.MethodGet)
+	api.HandleFunc("/users/{user}", server.updateUser).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}", server.getProject).Methods(http.MethodGet)
+	api.HandleFunc("/projects/{project}", server.updateProject).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods(http.MethodGet)
+	api.HandleFunc("/projects/{project}/limit", server.updateProjectLimit).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}/apikeys", server.getAPIKeys).Methods(http.MethodGet)
+	api.HandleFunc("/projects/{project}/redundancy", server.setRedundancyPolicy).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}/redundancy", server.deleteRedundancyPolicy).Methods(http.MethodDelete)
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/redundancy", server.setRedundancyPolicy).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/redundancy", server.deleteRedundancyPolicy).Methods(http.MethodDelete)
+	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods(http.MethodDelete)
+	api.HandleFunc("/nodes/{node}", server.getNode).Methods(http.MethodGet)
+	api.HandleFunc("/nodes/{node}/disqualify", server.disqualifyNode).Methods(http.MethodPut)
+	api.HandleFunc("/nodes/{node}/disqualify", server.reinstateNode).Methods(http.MethodDelete)
+	api.HandleFunc("/repair", server.repairPath).Methods(http.MethodPost)
+	api.HandleFunc("/repair/queue", server.getRepairQueue).Methods(http.MethodGet)
+
+	var err error
+	server.server, err = httpserver.New(log, httpserver.Config{
+		Name:    "Admin API",
+		Address: config.Address,
+		Handler: router,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return server, nil
+}
+
+// Run runs the server until the context is canceled
+func (server *Server) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(server.server.Run(ctx))
+}
+
+// Addr returns the address the server listens on
+func (server *Server) Addr() string {
+	return server.server.Addr()
+}
+
+// Close closes the server
+func (server *Server) Close() error {
+	return Error.Wrap(server.server.Close())
+}
+
+// authorize rejects requests without the configured authorization token
+func (server *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(server.config.AuthorizationToken)) != 1 {
+			sendError(w, http.StatusUnauthorized, Error.New("invalid authorization token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serveError logs err and sends it to the client
+func (server *Server) serveError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		server.log.Error("admin API request failed", zap.Error(err))
+	}
+	sendError(w, status, err)
+}
+
+// sendJSON sends value encoded as JSON with the status code
+func sendJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// sendError sends err as {"error": "..."} with the status code
+func sendError(w http.ResponseWriter, status int, err error) {
+	sendJSON(w, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+// decodeJSON decodes the request body into value
+func decodeJSON(r *http.Request, value interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return Error.Wrap(decoder.Decode(value))
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+const token = "secret-token"
+
+func TestServer(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		consoleDB := db.Console()
+
+		user, err := consoleDB.Users().Insert(ctx, &console.User{
+			FullName:     "Jane Doe",
+			Email:        "jane@mail.test",
+			PasswordHash: testrand.Bytes(8),
+		})
+		require.NoError(t, err)
+		user.Status = console.Active
+		require.NoError(t, consoleDB.Users().Update(ctx, user))
+
+		project, err := consoleDB.Projects().Insert(ctx, &console.Project{
+			ID:          testrand.UUID(),
+			Name:        "project",
+			Description: "some description",
+		})
+		require.NoError(t, err)
+		_, err = consoleDB.ProjectMembers().Insert(ctx, user.ID, project.ID)
+		require.NoError(t, err)
+
+		apiKey, err := consoleDB.APIKeys().Create(ctx, testrand.Bytes(8), console.APIKeyInfo{
+			ProjectID: project.ID,
+			Name:      "key",
+			Secret:    []byte("xyz"),
+		})
+		require.NoError(t, err)
+
+		config := overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  1,
+			UptimeReputationAlpha0: 1,
+		}
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), nil, config)
+
+		nodeID := testrand.NodeID()
+		err = cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+		require.NoError(t, err)
+
+		server, err := admin.NewServer(zaptest.NewLogger(t), admin.Config{
+			Address:            "127.0.0.1:0",
+			AuthorizationToken: token,
+		}, db, cache, console.RedundancyPolicy{
+			RequiredShares: 4,
+			RepairShares:   6,
+			OptimalShares:  8,
+			TotalShares:    10,
+		})
+		require.NoError(t, err)
+		defer ctx.Check(server.Close)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			return server.Run(runCtx)
+		})
+
+		baseURL := "http://" + server.Addr() + "/api"
+
+		{ // unauthorized
+			request, err := http.NewRequest(http.MethodGet, baseURL+"/users/jane@mail.test", nil)
+			require.NoError(t, err)
+			request.Header.Set("Authorization", "wrong-token")
+
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+		}
+
+		{ // users
+			var got struct {
+				Email        string            `json:"email"`
+				FullName     string            `json:"fullName"`
+				PasswordHash []byte            `json:"passwordHash"`
+				Projects     []console.Project `json:"projects"`
+			}
+			status := do(t, http.MethodGet, baseURL+"/users/jane@mail.test", nil, &got)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "Jane Doe", got.FullName)
+			assert.Nil(t, got.PasswordHash)
+			require.Len(t, got.Projects, 1)
+			assert.Equal(t, project.ID, got.Projects[0].ID)
+
+			status = do(t, http.MethodPut, baseURL+"/users/jane@mail.test", map[string]interface{}{
+				"fullName": "Jane Roe",
+				"status":   console.Inactive,
+			}, &got)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "Jane Roe", got.FullName)
+
+			updated, err := consoleDB.Users().Get(ctx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Jane Roe", updated.FullName)
+			assert.Equal(t, console.Inactive, updated.Status)
+			assert.Equal(t, user.PasswordHash, updated.PasswordHash)
+
+			// inactive users can only be looked up by id
+			status = do(t, http.MethodGet, baseURL+"/users/jane@mail.test", nil, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+			status = do(t, http.MethodGet, baseURL+"/users/"+user.ID.String(), nil, &got)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "jane@mail.test", got.Email)
+
+			status = do(t, http.MethodPut, baseURL+"/users/"+user.ID.String(), map[string]interface{}{
+				"email": "invalid",
+			}, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			status = do(t, http.MethodGet, baseURL+"/users/missing@mail.test", nil, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // projects and limits
+			var got console.Project
+			status := do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String(), map[string]interface{}{
+				"description": "new description",
+			}, &got)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "new description", got.Description)
+
+			var limit struct {
+				UsageLimit     int64 `json:"usageLimit"`
+				StorageLimit   int64 `json:"storageLimit"`
+				BandwidthLimit int64 `json:"bandwidthLimit"`
+			}
+			status = do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String()+"/limit", map[string]interface{}{
+				"usageLimit": 1 << 40,
+			}, &limit)
+			require.Equal(t, http.StatusOK, status)
+
+			status = do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String()+"/limit", map[string]interface{}{
+				"storageLimit":   1 << 30,
+				"bandwidthLimit": 1 << 31,
+			}, &limit)
+			require.Equal(t, http.StatusOK, status)
+
+			status = do(t, http.MethodGet, baseURL+"/projects/"+project.ID.String()+"/limit", nil, &limit)
+			require.Equal(t, http.StatusOK, status)
+			assert.EqualValues(t, 1<<40, limit.UsageLimit)
+			assert.EqualValues(t, 1<<30, limit.StorageLimit)
+			assert.EqualValues(t, 1<<31, limit.BandwidthLimit)
+
+			updated, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1<<30, updated.StorageLimit)
+			assert.EqualValues(t, 1<<31, updated.BandwidthLimit)
+
+			status = do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String()+"/limit", map[string]interface{}{
+				"usageLimit": -1,
+			}, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			status = do(t, http.MethodGet, baseURL+"/projects/"+testrand.UUID().String(), nil, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+			status = do(t, http.MethodGet, baseURL+"/projects/invalid", nil, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+		}
+
+		{ // redundancy policies
+			policy := map[string]interface{}{
+				"requiredShares": 8,
+				"repairShares":   10,
+				"optimalShares":  12,
+				"totalShares":    14,
+				"shareSize":      1024,
+			}
+			status := do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String()+"/buckets/bucket/redundancy", policy, nil)
+			require.Equal(t, http.StatusOK, status)
+
+			stored, err := consoleDB.RedundancyPolicies().Get(ctx, project.ID, []byte("bucket"))
+			require.NoError(t, err)
+			assert.Equal(t, 8, stored.RequiredShares)
+			assert.Equal(t, 1024, stored.ShareSize)
+
+			// weaker than the minimum
+			policy["totalShares"] = 12
+			status = do(t, http.MethodPut, baseURL+"/projects/"+project.ID.String()+"/redundancy", policy, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			status = do(t, http.MethodDelete, baseURL+"/projects/"+project.ID.String()+"/buckets/bucket/redundancy", nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			_, err = consoleDB.RedundancyPolicies().Get(ctx, project.ID, []byte("bucket"))
+			assert.True(t, console.ErrNoRedundancyPolicy.Has(err))
+		}
+
+		{ // API keys
+			var keys []console.APIKeyInfo
+			status := do(t, http.MethodGet, baseURL+"/projects/"+project.ID.String()+"/apikeys", nil, &keys)
+			require.Equal(t, http.StatusOK, status)
+			require.Len(t, keys, 1)
+			assert.Equal(t, apiKey.ID, keys[0].ID)
+
+			status = do(t, http.MethodDelete, baseURL+"/apikeys/"+apiKey.ID.String(), nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			status = do(t, http.MethodDelete, baseURL+"/apikeys/"+apiKey.ID.String(), nil, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // nodes
+			status := do(t, http.MethodPut, baseURL+"/nodes/"+nodeID.String()+"/disqualify", nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			var got struct {
+				Disqualified           *time.Time `json:"disqualified"`
+				DisqualificationReason string     `json:"disqualificationReason"`
+			}
+			status = do(t, http.MethodGet, baseURL+"/nodes/"+nodeID.String(), nil, &got)
+			require.Equal(t, http.StatusOK, status)
+			assert.NotNil(t, got.Disqualified)
+			assert.Equal(t, "OPERATOR", got.DisqualificationReason)
+
+			status = do(t, http.MethodDelete, baseURL+"/nodes/"+nodeID.String()+"/disqualify", nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			dossier, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.Nil(t, dossier.Disqualified)
+			assert.Equal(t, pb.DisqualificationReason_UNKNOWN, dossier.DisqualificationReason)
+
+			status = do(t, http.MethodPut, baseURL+"/nodes/"+testrand.NodeID().String()+"/disqualify", nil, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // repair
+			path := project.ID.String() + "/l/bucket/encrypted"
+			status := do(t, http.MethodPost, baseURL+"/repair", map[string]interface{}{
+				"path": path,
+			}, nil)
+			require.Equal(t, http.StatusAccepted, status)
+
+			status = do(t, http.MethodPost, baseURL+"/repair", map[string]interface{}{
+				"path": "invalid",
+			}, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			var queue struct {
+				Count int `json:"count"`
+			}
+			status = do(t, http.MethodGet, baseURL+"/repair/queue", nil, &queue)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, 1, queue.Count)
+
+			segment, err := db.RepairQueue().Select(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, path, string(segment.Path))
+		}
+	})
+}
+
+// do sends an authorized admin API request and decodes the response into out
+func do(t *testing.T, method, url string, in, out interface{}) int {
+	var body bytes.Buffer
+	if in != nil {
+		require.NoError(t, json.NewEncoder(&body).Encode(in))
+	}
+
+	request, err := http.NewRequest(method, url, &body)
+	require.NoError(t, err)
+	request.Header.Set("Authorization", token)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, response.Body.Close()) }()
+
+	if out != nil && response.StatusCode < 300 {
+		require.NoError(t, json.NewDecoder(response.Body).Decode(out))
+	}
+	return response.StatusCode
+}
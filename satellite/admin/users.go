@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+)
+
+// user is the representation of a user in the admin API, without secrets
+type user struct {
+	ID        uuid.UUID          `json:"id"`
+	FullName  string             `json:"fullName"`
+	ShortName string             `json:"shortName"`
+	Email     string             `json:"email"`
+	Status    console.UserStatus `json:"status"`
+	PartnerID uuid.UUID          `json:"partnerId"`
+	CreatedAt time.Time          `json:"createdAt"`
+
+	Projects []console.Project `json:"projects"`
+}
+
+// userUpdate contains the fields of a user which can be modified, nil fields
+// are left unchanged
+type userUpdate struct {
+	FullName  *string             `json:"fullName"`
+	ShortName *string             `json:"shortName"`
+	Email     *string             `json:"email"`
+	Status    *console.UserStatus `json:"status"`
+}
+
+// getUser returns the user and the projects they're a member of
+func (server *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	consoleUser, ok := server.loadUser(w, r)
+	if !ok {
+		return
+	}
+
+	projects, err := server.db.Console().Projects().GetByUserID(ctx, consoleUser.ID)
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, toUser(consoleUser, projects))
+}
+
+// updateUser modifies the user
+func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var update userUpdate
+	if err = decodeJSON(r, &update); err != nil {
+		server.serveError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	consoleUser, ok := server.loadUser(w, r)
+	if !ok {
+		return
+	}
+
+	if update.FullName != nil {
+		consoleUser.FullName = *update.FullName
+	}
+	if update.ShortName != nil {
+		consoleUser.ShortName = *update.ShortName
+	}
+	if update.Email != nil {
+		consoleUser.Email = *update.Email
+	}
+	if update.Status != nil {
+		switch *update.Status {
+		case console.Inactive, console.Active, console.Deleted:
+			consoleUser.Status = *update.Status
+		default:
+			server.serveError(w, http.StatusBadRequest, Error.New("invalid user status %d", *update.Status))
+			return
+		}
+	}
+
+	info := console.UserInfo{FullName: consoleUser.FullName, Email: consoleUser.Email}
+	if err = info.IsValid(); err != nil {
+		server.serveError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	// the password hash isn't updated when it's empty
+	consoleUser.PasswordHash = nil
+	if err = server.db.Console().Users().Update(ctx, consoleUser); err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	projects, err := server.db.Console().Projects().GetByUserID(ctx, consoleUser.ID)
+	if err != nil {
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	sendJSON(w, http.StatusOK, toUser(consoleUser, projects))
+}
+
+// loadUser returns the user of the request, identified by id or by email.
+// Users which haven't been activated can only be looked up by id. It serves
+// an error and returns false when the user can't be loaded.
+func (server *Server) loadUser(w http.ResponseWriter, r *http.Request) (consoleUser *console.User, ok bool) {
+	var err error
+	key := mux.Vars(r)["user"]
+	if id, parseErr := uuid.Parse(key); parseErr == nil {
+		consoleUser, err = server.db.Console().Users().Get(r.Context(), *id)
+	} else {
+		consoleUser, err = server.db.Console().Users().GetByEmail(r.Context(), key)
+	}
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			server.serveError(w, http.StatusNotFound, Error.New("user not found"))
+			return nil, false
+		}
+		server.serveError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return nil, false
+	}
+	return consoleUser, true
+}
+
+func toUser(consoleUser *console.User, projects []console.Project) user {
+	if projects == nil {
+		projects = []console.Project{}
+	}
+	return user{
+		ID:        consoleUser.ID,
+		FullName:  consoleUser.FullName,
+		ShortName: consoleUser.ShortName,
+		Email:     consoleUser.Email,
+		Status:    consoleUser.Status,
+		PartnerID: consoleUser.PartnerID,
+		CreatedAt: consoleUser.CreatedAt,
+		Projects:  projects,
+	}
+}
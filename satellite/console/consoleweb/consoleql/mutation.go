@@ -8,6 +8,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"go.uber.org/zap"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/post"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/mailservice"
@@ -31,6 +32,8 @@ const (
 	DeleteProjectMutation = "deleteProject"
 	// UpdateProjectDescriptionMutation is a mutation name for project updating
 	UpdateProjectDescriptionMutation = "updateProjectDescription"
+	// UpdateProjectLimitsMutation is a mutation name for updating the project storage and bandwidth limits
+	UpdateProjectLimitsMutation = "updateProjectLimits"
 
 	// AddProjectMembersMutation is a mutation name for adding new project members
 	AddProjectMembersMutation = "addProjectMembers"
@@ -49,6 +52,11 @@ const (
 	// SetDefaultPaymentMethodMutation is mutation name setting payment method as default payment method
 	SetDefaultPaymentMethodMutation = "setDefaultPaymentMethod"
 
+	// SetRedundancyPolicyMutation is a mutation name for setting the redundancy policy of a project or bucket
+	SetRedundancyPolicyMutation = "setRedundancyPolicy"
+	// DeleteRedundancyPolicyMutation is a mutation name for deleting the redundancy policy of a project or bucket
+	DeleteRedundancyPolicyMutation = "deleteRedundancyPolicy"
+
 	// InputArg is argument name for all input types
 	InputArg = "input"
 	// FieldProjectID is field name for projectID
@@ -267,6 +275,33 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.UpdateProject(p.Context, *projectID, description)
 				},
 			},
+			// updates project storage and bandwidth limits
+			UpdateProjectLimitsMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldStorageLimit: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+					FieldBandwidthLimit: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					storageLimit, _ := p.Args[FieldStorageLimit].(float64)
+					bandwidthLimit, _ := p.Args[FieldBandwidthLimit].(float64)
+
+					inputID := p.Args[FieldID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.UpdateProjectLimits(p.Context, *projectID, memory.Size(storageLimit), memory.Size(bandwidthLimit))
+				},
+			},
 			// add user as member of given project
 			AddProjectMembersMutation: &graphql.Field{
 				Type: types.project,
@@ -510,6 +545,66 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return false, err
 					}
 
+					return true, nil
+				},
+			},
+			// sets the redundancy policy of the bucket, or of the project when the bucket name is empty
+			SetRedundancyPolicyMutation: &graphql.Field{
+				Type: types.redundancyPolicy,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldBucketName: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					InputArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.redundancyPolicyInput),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					bucketName, _ := p.Args[FieldBucketName].(string)
+					input := fromMapRedundancyPolicy(p.Args[InputArg].(map[string]interface{}))
+
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					policy, err := service.SetRedundancyPolicy(p.Context, *projectID, bucketName, input)
+					if err != nil {
+						return nil, err
+					}
+
+					return toRedundancyPolicy(*policy), nil
+				},
+			},
+			// deletes the redundancy policy of the bucket, or of the project when the bucket name is empty
+			DeleteRedundancyPolicyMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldBucketName: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					bucketName, _ := p.Args[FieldBucketName].(string)
+
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return false, err
+					}
+
+					err = service.DeleteRedundancyPolicy(p.Context, *projectID, bucketName)
+					if err != nil {
+						return false, err
+					}
+
 					return true, nil
 				},
 			},
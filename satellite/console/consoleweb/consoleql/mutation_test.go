@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/post"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth"
@@ -55,6 +56,8 @@ func TestGrapqhlMutation(t *testing.T) {
 			db.Rewards(),
 			localpayments.NewService(nil),
 			console.TestPasswordCost,
+			console.RedundancyPolicy{},
+			25*memory.GB,
 		)
 		require.NoError(t, err)
 
@@ -484,6 +487,107 @@ func TestGrapqhlMutation(t *testing.T) {
 			}
 		})
 
+		t.Run("Set redundancy policy mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {setRedundancyPolicy(projectID:\"%s\",bucketName:\"%s\",input:{requiredShares:2,repairShares:3,optimalShares:4,totalShares:5,shareSize:256}){bucketName,requiredShares,totalShares,shareSize}}",
+				project.ID.String(),
+				"bucket1",
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			policy := data[consoleql.SetRedundancyPolicyMutation].(map[string]interface{})
+
+			assert.Equal(t, "bucket1", policy[consoleql.FieldBucketName])
+			assert.Equal(t, 2, policy[consoleql.FieldRequiredShares])
+			assert.Equal(t, 5, policy[consoleql.FieldTotalShares])
+			assert.Equal(t, 256, policy[consoleql.FieldShareSize])
+
+			policies, err := service.GetRedundancyPolicies(authCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, policies, 1)
+			assert.Equal(t, 4, policies[0].OptimalShares)
+		})
+
+		t.Run("Set redundancy policy by member", func(t *testing.T) {
+			_, err := service.AddProjectMembers(authCtx, project.ID, []string{user1.Email})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, service.DeleteProjectMembers(authCtx, project.ID, []string{user1.Email}))
+			}()
+
+			memberToken, err := service.Token(ctx, user1.Email, "123a123")
+			require.NoError(t, err)
+			memberAuth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(memberToken)))
+			require.NoError(t, err)
+
+			_, err = service.SetRedundancyPolicy(console.WithAuth(ctx, memberAuth), project.ID, "bucket1", console.RedundancyPolicy{
+				RequiredShares: 1, RepairShares: 1, OptimalShares: 1, TotalShares: 1, ShareSize: 256,
+			})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+		})
+
+		t.Run("Delete redundancy policy mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {deleteRedundancyPolicy(projectID:\"%s\",bucketName:\"%s\")}",
+				project.ID.String(),
+				"bucket1",
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			assert.Equal(t, true, data[consoleql.DeleteRedundancyPolicyMutation])
+
+			policies, err := service.GetRedundancyPolicies(authCtx, project.ID)
+			require.NoError(t, err)
+			assert.Len(t, policies, 0)
+		})
+
+		t.Run("Update project limits mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectLimits(id:\"%s\",storageLimit:%d,bandwidthLimit:%d){id,storageLimit,bandwidthLimit}}",
+				project.ID.String(),
+				10*memory.GB.Int64(),
+				5*memory.GB.Int64(),
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			proj := data[consoleql.UpdateProjectLimitsMutation].(map[string]interface{})
+
+			assert.Equal(t, project.ID.String(), proj[consoleql.FieldID])
+			assert.Equal(t, 10*memory.GB.Float64(), proj[consoleql.FieldStorageLimit])
+			assert.Equal(t, 5*memory.GB.Float64(), proj[consoleql.FieldBandwidthLimit])
+
+			updated, err := service.GetProject(authCtx, project.ID)
+			require.NoError(t, err)
+			assert.Equal(t, 10*memory.GB.Int64(), updated.StorageLimit)
+			assert.Equal(t, 5*memory.GB.Int64(), updated.BandwidthLimit)
+
+			// the limits can't exceed the usage limit of the project
+			_, err = service.UpdateProjectLimits(authCtx, project.ID, 26*memory.GB, 0)
+			assert.True(t, console.ErrValidation.Has(err))
+		})
+
+		t.Run("Update project limits by member", func(t *testing.T) {
+			_, err := service.AddProjectMembers(authCtx, project.ID, []string{user1.Email})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, service.DeleteProjectMembers(authCtx, project.ID, []string{user1.Email}))
+			}()
+
+			memberToken, err := service.Token(ctx, user1.Email, "123a123")
+			require.NoError(t, err)
+			memberAuth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(memberToken)))
+			require.NoError(t, err)
+
+			_, err = service.UpdateProjectLimits(console.WithAuth(ctx, memberAuth), project.ID, memory.GB, memory.GB)
+			assert.True(t, console.ErrUnauthorized.Has(err))
+		})
+
 		t.Run("Delete project mutation", func(t *testing.T) {
 			query := fmt.Sprintf(
 				"mutation {deleteProject(id:\"%s\"){id,name}}",
@@ -47,6 +47,10 @@ const (
 	FieldStorage = "storage"
 	// FieldEgress is a field name for egress total
 	FieldEgress = "egress"
+	// FieldStorageLimit is a field name for project storage limit
+	FieldStorageLimit = "storageLimit"
+	// FieldBandwidthLimit is a field name for project bandwidth limit
+	FieldBandwidthLimit = "bandwidthLimit"
 	// FieldObjectCount is a field name for objects count
 	FieldObjectCount = "objectCount"
 	// FieldPageCount is a field name for total page count
@@ -98,6 +102,12 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldBandwidthLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
 			FieldMembers: &graphql.Field{
 				Type: graphql.NewList(types.projectMember),
 				Args: graphql.FieldConfigArgument{
@@ -230,6 +240,24 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return projectPaymentMethods, nil
 				},
 			},
+			FieldRedundancyPolicies: &graphql.Field{
+				Type: graphql.NewList(types.redundancyPolicy),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					policies, err := service.GetRedundancyPolicies(p.Context, project.ID)
+					if err != nil {
+						return nil, err
+					}
+
+					var result []redundancyPolicy
+					for _, policy := range policies {
+						result = append(result, toRedundancyPolicy(policy))
+					}
+
+					return result, nil
+				},
+			},
 		},
 	})
 }
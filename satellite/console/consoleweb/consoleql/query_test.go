@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite"
@@ -38,6 +39,8 @@ func TestGraphqlQuery(t *testing.T) {
 			db.Rewards(),
 			localpayments.NewService(nil),
 			console.TestPasswordCost,
+			console.RedundancyPolicy{},
+			25*memory.GB,
 		)
 		require.NoError(t, err)
 
@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// RedundancyPolicyType is a graphql type name for redundancy policy
+	RedundancyPolicyType = "redundancyPolicy"
+	// RedundancyPolicyInputType is a graphql type name for redundancy policy input
+	RedundancyPolicyInputType = "redundancyPolicyInput"
+	// FieldRedundancyPolicies is a field name for redundancy policies
+	FieldRedundancyPolicies = "redundancyPolicies"
+	// FieldRequiredShares is a field name for the number of required shares
+	FieldRequiredShares = "requiredShares"
+	// FieldRepairShares is a field name for the repair threshold
+	FieldRepairShares = "repairShares"
+	// FieldOptimalShares is a field name for the success threshold
+	FieldOptimalShares = "optimalShares"
+	// FieldTotalShares is a field name for the total number of shares
+	FieldTotalShares = "totalShares"
+	// FieldShareSize is a field name for the erasure share size
+	FieldShareSize = "shareSize"
+)
+
+// graphqlRedundancyPolicy creates redundancyPolicy graphql type
+func graphqlRedundancyPolicy() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: RedundancyPolicyType,
+		Fields: graphql.Fields{
+			FieldBucketName: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldRequiredShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldRepairShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldOptimalShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotalShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldShareSize: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
+// graphqlRedundancyPolicyInput creates graphql.InputObject type needed to set console.RedundancyPolicy
+func graphqlRedundancyPolicyInput() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: RedundancyPolicyInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldRequiredShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldRepairShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldOptimalShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldTotalShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldShareSize: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+		},
+	})
+}
+
+// redundancyPolicy is the graphql representation of console.RedundancyPolicy
+type redundancyPolicy struct {
+	BucketName     string
+	RequiredShares int
+	RepairShares   int
+	OptimalShares  int
+	TotalShares    int
+	ShareSize      int
+	CreatedAt      time.Time
+}
+
+// toRedundancyPolicy converts console.RedundancyPolicy to its graphql representation
+func toRedundancyPolicy(policy console.RedundancyPolicy) redundancyPolicy {
+	return redundancyPolicy{
+		BucketName:     string(policy.BucketName),
+		RequiredShares: policy.RequiredShares,
+		RepairShares:   policy.RepairShares,
+		OptimalShares:  policy.OptimalShares,
+		TotalShares:    policy.TotalShares,
+		ShareSize:      policy.ShareSize,
+		CreatedAt:      policy.CreatedAt,
+	}
+}
+
+// fromMapRedundancyPolicy creates console.RedundancyPolicy from input args
+func fromMapRedundancyPolicy(args map[string]interface{}) (policy console.RedundancyPolicy) {
+	policy.RequiredShares, _ = args[FieldRequiredShares].(int)
+	policy.RepairShares, _ = args[FieldRepairShares].(int)
+	policy.OptimalShares, _ = args[FieldOptimalShares].(int)
+	policy.TotalShares, _ = args[FieldTotalShares].(int)
+	policy.ShareSize, _ = args[FieldShareSize].(int)
+
+	return
+}
@@ -18,21 +18,23 @@ type TypeCreator struct {
 
 	token *graphql.Object
 
-	user            *graphql.Object
-	reward          *graphql.Object
-	creditUsage     *graphql.Object
-	project         *graphql.Object
-	projectUsage    *graphql.Object
-	bucketUsage     *graphql.Object
-	bucketUsagePage *graphql.Object
-	paymentMethod   *graphql.Object
-	projectMember   *graphql.Object
-	apiKeyInfo      *graphql.Object
-	createAPIKey    *graphql.Object
-
-	userInput         *graphql.InputObject
-	projectInput      *graphql.InputObject
-	bucketUsageCursor *graphql.InputObject
+	user             *graphql.Object
+	reward           *graphql.Object
+	creditUsage      *graphql.Object
+	project          *graphql.Object
+	projectUsage     *graphql.Object
+	bucketUsage      *graphql.Object
+	bucketUsagePage  *graphql.Object
+	paymentMethod    *graphql.Object
+	projectMember    *graphql.Object
+	apiKeyInfo       *graphql.Object
+	createAPIKey     *graphql.Object
+	redundancyPolicy *graphql.Object
+
+	userInput             *graphql.InputObject
+	projectInput          *graphql.InputObject
+	bucketUsageCursor     *graphql.InputObject
+	redundancyPolicyInput *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -53,6 +55,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.redundancyPolicyInput = graphqlRedundancyPolicyInput()
+	if err := c.redundancyPolicyInput.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -99,6 +106,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.redundancyPolicy = graphqlRedundancyPolicy()
+	if err := c.redundancyPolicy.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
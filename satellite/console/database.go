@@ -35,6 +35,8 @@ type DB interface {
 	ProjectPayments() ProjectPayments
 	// ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 	ProjectInvoiceStamps() ProjectInvoiceStamps
+	// RedundancyPolicies is a getter for RedundancyPolicies repository
+	RedundancyPolicies() RedundancyPolicies
 
 	// BeginTransaction is a method for opening transaction
 	BeginTx(ctx context.Context) (DBTx, error)
@@ -36,6 +36,14 @@ type Project struct {
 	Description string    `json:"description"`
 	UsageLimit  int64     `json:"usageLimit"`
 	PartnerID   uuid.UUID `json:"partnerId"`
+	// OwnerID is the user who created the project, it is zero for
+	// projects created before owners were recorded.
+	OwnerID uuid.UUID `json:"ownerId"`
+
+	// StorageLimit and BandwidthLimit override UsageLimit for stored data
+	// and egress, zero means they aren't set.
+	StorageLimit   int64 `json:"storageLimit"`
+	BandwidthLimit int64 `json:"bandwidthLimit"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrNoRedundancyPolicy is returned when a project or bucket doesn't have a redundancy policy.
+var ErrNoRedundancyPolicy = errs.Class("no redundancy policy")
+
+// RedundancyPolicies exposes methods to manage RedundancyPolicies table in database.
+type RedundancyPolicies interface {
+	// Get is a method for querying the redundancy policy of a bucket from the database.
+	// The policy of the whole project is returned, when bucketName is empty.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (*RedundancyPolicy, error)
+	// GetByProjectID is a method for querying all redundancy policies of a project from the database.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]RedundancyPolicy, error)
+	// Set is a method for inserting or replacing a redundancy policy in the database.
+	Set(ctx context.Context, policy RedundancyPolicy) (*RedundancyPolicy, error)
+	// Delete is a method for deleting the redundancy policy of a bucket or project from the database.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName []byte) error
+}
+
+// RedundancyPolicy is a database object that describes the redundancy scheme,
+// which the satellite requires for new segments of a project. Policies with
+// a bucket name only apply to that bucket and take precedence over the policy
+// of the project.
+type RedundancyPolicy struct {
+	// FK on Projects table.
+	ProjectID uuid.UUID
+	// BucketName is empty for the policy of the whole project.
+	BucketName []byte
+
+	RequiredShares int
+	RepairShares   int
+	OptimalShares  int
+	TotalShares    int
+	ShareSize      int
+
+	CreatedAt time.Time
+}
+
+// RedundancyScheme returns the Reed-Solomon redundancy scheme of the policy
+func (policy *RedundancyPolicy) RedundancyScheme() storj.RedundancyScheme {
+	return storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      int32(policy.ShareSize),
+		RequiredShares: int16(policy.RequiredShares),
+		RepairShares:   int16(policy.RepairShares),
+		OptimalShares:  int16(policy.OptimalShares),
+		TotalShares:    int16(policy.TotalShares),
+	}
+}
+
+// Validate checks whether the policy describes a valid Reed-Solomon scheme,
+// which is not weaker than minimum. A zero minimum doesn't limit the policy.
+func (policy *RedundancyPolicy) Validate(minimum RedundancyPolicy) error {
+	var errs validationErrors
+
+	if policy.RequiredShares <= 0 {
+		errs.Add("required shares must be positive")
+	}
+	if policy.RepairShares < policy.RequiredShares {
+		errs.Add("repair shares must not be less than required shares")
+	}
+	if policy.OptimalShares < policy.RepairShares {
+		errs.Add("optimal shares must not be less than repair shares")
+	}
+	if policy.TotalShares < policy.OptimalShares {
+		errs.Add("total shares must not be less than optimal shares")
+	}
+	if policy.TotalShares > 256 {
+		errs.Add("total shares must not be more than 256")
+	}
+	if policy.ShareSize <= 0 {
+		errs.Add("share size must be positive")
+	}
+
+	// the policy must need at least as many shares to recover a segment and
+	// keep at least as many spare shares above them as the minimum
+	if policy.RequiredShares < minimum.RequiredShares {
+		errs.Add("required shares must be at least %d", minimum.RequiredShares)
+	}
+	if policy.RepairShares-policy.RequiredShares < minimum.RepairShares-minimum.RequiredShares {
+		errs.Add("repair shares must exceed required shares by at least %d", minimum.RepairShares-minimum.RequiredShares)
+	}
+	if policy.OptimalShares-policy.RequiredShares < minimum.OptimalShares-minimum.RequiredShares {
+		errs.Add("optimal shares must exceed required shares by at least %d", minimum.OptimalShares-minimum.RequiredShares)
+	}
+	if policy.TotalShares-policy.RequiredShares < minimum.TotalShares-minimum.RequiredShares {
+		errs.Add("total shares must exceed required shares by at least %d", minimum.TotalShares-minimum.RequiredShares)
+	}
+
+	return errs.Combine()
+}
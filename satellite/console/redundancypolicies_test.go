@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestRedundancyPoliciesRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		projects := db.Console().Projects()
+		policies := db.Console().RedundancyPolicies()
+
+		project, err := projects.Insert(ctx, &console.Project{
+			Name:        "ProjectName",
+			Description: "projects description",
+		})
+		require.NoError(t, err)
+
+		t.Run("Get without policy", func(t *testing.T) {
+			policy, err := policies.Get(ctx, project.ID, nil)
+			assert.Nil(t, policy)
+			assert.True(t, console.ErrNoRedundancyPolicy.Has(err))
+		})
+
+		t.Run("Set success", func(t *testing.T) {
+			policy, err := policies.Set(ctx, console.RedundancyPolicy{
+				ProjectID:      project.ID,
+				RequiredShares: 29,
+				RepairShares:   35,
+				OptimalShares:  80,
+				TotalShares:    130,
+				ShareSize:      256,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, project.ID, policy.ProjectID)
+			assert.Equal(t, 29, policy.RequiredShares)
+
+			_, err = policies.Set(ctx, console.RedundancyPolicy{
+				ProjectID:      project.ID,
+				BucketName:     []byte("bucket"),
+				RequiredShares: 2,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+				ShareSize:      1024,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("Set replaces existing policy", func(t *testing.T) {
+			_, err := policies.Set(ctx, console.RedundancyPolicy{
+				ProjectID:      project.ID,
+				BucketName:     []byte("bucket"),
+				RequiredShares: 4,
+				RepairShares:   6,
+				OptimalShares:  8,
+				TotalShares:    10,
+				ShareSize:      1024,
+			})
+			require.NoError(t, err)
+
+			policy, err := policies.Get(ctx, project.ID, []byte("bucket"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte("bucket"), policy.BucketName)
+			assert.Equal(t, 4, policy.RequiredShares)
+			assert.Equal(t, 10, policy.TotalShares)
+		})
+
+		t.Run("GetByProjectID success", func(t *testing.T) {
+			list, err := policies.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, 29, list[0].RequiredShares)
+			assert.Equal(t, []byte("bucket"), list[1].BucketName)
+		})
+
+		t.Run("Delete success", func(t *testing.T) {
+			err := policies.Delete(ctx, project.ID, []byte("bucket"))
+			require.NoError(t, err)
+
+			_, err = policies.Get(ctx, project.ID, []byte("bucket"))
+			assert.True(t, console.ErrNoRedundancyPolicy.Has(err))
+
+			list, err := policies.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			assert.Len(t, list, 1)
+		})
+	})
+}
+
+func TestRedundancyPolicyValidate(t *testing.T) {
+	valid := console.RedundancyPolicy{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 5, ShareSize: 256}
+	assert.NoError(t, valid.Validate(console.RedundancyPolicy{}))
+
+	for _, invalid := range []console.RedundancyPolicy{
+		{RequiredShares: 0, RepairShares: 3, OptimalShares: 4, TotalShares: 5, ShareSize: 256},
+		{RequiredShares: 4, RepairShares: 3, OptimalShares: 4, TotalShares: 5, ShareSize: 256},
+		{RequiredShares: 2, RepairShares: 3, OptimalShares: 6, TotalShares: 5, ShareSize: 256},
+		{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 300, ShareSize: 256},
+		{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 5, ShareSize: 0},
+	} {
+		assert.Error(t, invalid.Validate(console.RedundancyPolicy{}))
+	}
+
+	minimum := console.RedundancyPolicy{RequiredShares: 4, RepairShares: 6, OptimalShares: 8, TotalShares: 10}
+	strong := console.RedundancyPolicy{RequiredShares: 8, RepairShares: 10, OptimalShares: 12, TotalShares: 14, ShareSize: 256}
+	assert.NoError(t, strong.Validate(minimum))
+	for _, weak := range []console.RedundancyPolicy{
+		{RequiredShares: 1, RepairShares: 1, OptimalShares: 1, TotalShares: 1, ShareSize: 256},
+		{RequiredShares: 2, RepairShares: 4, OptimalShares: 6, TotalShares: 8, ShareSize: 256},
+		{RequiredShares: 4, RepairShares: 5, OptimalShares: 8, TotalShares: 10, ShareSize: 256},
+		{RequiredShares: 4, RepairShares: 6, OptimalShares: 7, TotalShares: 10, ShareSize: 256},
+		{RequiredShares: 8, RepairShares: 10, OptimalShares: 12, TotalShares: 13, ShareSize: 256},
+	} {
+		assert.Error(t, weak.Validate(minimum))
+	}
+}
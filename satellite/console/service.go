@@ -16,6 +16,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -65,10 +66,15 @@ type Service struct {
 	rewards rewards.DB
 
 	passwordCost int
+
+	// minimumRedundancy is the weakest redundancy policy users can set
+	minimumRedundancy RedundancyPolicy
+	// defaultUsageLimit is the usage limit of projects without their own usage limit
+	defaultUsageLimit memory.Size
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int, minimumRedundancy RedundancyPolicy, defaultUsageLimit memory.Size) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -89,6 +95,9 @@ func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm
 		rewards:      rewards,
 		pm:           pm,
 		passwordCost: passwordCost,
+
+		minimumRedundancy: minimumRedundancy,
+		defaultUsageLimit: defaultUsageLimit,
 	}, nil
 }
 
@@ -703,6 +712,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 			&Project{
 				Description: projectInfo.Description,
 				Name:        projectInfo.Name,
+				OwnerID:     auth.User.ID,
 			},
 		)
 		if err != nil {
@@ -768,6 +778,53 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 	return project, nil
 }
 
+// UpdateProjectLimits changes the storage and bandwidth limits of a project, zero
+// removes a limit. Only the project owner can change the limits and only up to
+// the usage limit of the project.
+func (s *Service) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, storageLimit, bandwidthLimit memory.Size) (p *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	usageLimit := s.defaultUsageLimit
+	if project.UsageLimit > 0 {
+		usageLimit = memory.Size(project.UsageLimit)
+	}
+
+	var validation validationErrors
+	if storageLimit < 0 || storageLimit > usageLimit {
+		validation.Add("storage limit must be between 0 and %s", usageLimit)
+	}
+	if bandwidthLimit < 0 || bandwidthLimit > usageLimit {
+		validation.Add("bandwidth limit must be between 0 and %s", usageLimit)
+	}
+	if err := validation.Combine(); err != nil {
+		return nil, err
+	}
+
+	project.StorageLimit = storageLimit.Int64()
+	project.BandwidthLimit = bandwidthLimit.Int64()
+
+	err = s.store.Projects().Update(ctx, project)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return project, nil
+}
+
 // AddProjectMembers adds users by email to given project
 func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1103,6 +1160,81 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 	return s.store.UsageRollups().GetBucketUsageRollups(ctx, projectID, since, before)
 }
 
+// GetRedundancyPolicies retrieves the redundancy policies of a project and its buckets
+func (s *Service) GetRedundancyPolicies(ctx context.Context, projectID uuid.UUID) (_ []RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	policies, err := s.store.RedundancyPolicies().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return policies, nil
+}
+
+// SetRedundancyPolicy sets the redundancy scheme required for new segments of a bucket,
+// or of the whole project when bucketName is empty. Only the project owner can set policies.
+func (s *Service) SetRedundancyPolicy(ctx context.Context, projectID uuid.UUID, bucketName string, policy RedundancyPolicy) (_ *RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err := policy.Validate(s.minimumRedundancy); err != nil {
+		return nil, err
+	}
+
+	policy.ProjectID = projectID
+	policy.BucketName = []byte(bucketName)
+
+	created, err := s.store.RedundancyPolicies().Set(ctx, policy)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return created, nil
+}
+
+// DeleteRedundancyPolicy deletes the redundancy policy of a bucket,
+// or of the whole project when bucketName is empty. Only the project owner can delete policies.
+func (s *Service) DeleteRedundancyPolicy(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	err = s.store.RedundancyPolicies().Delete(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return nil
+}
+
 // CreateMonthlyProjectInvoices creates invoices for all created projects on monthly basis.
 // Edge Dates are derived from the date parameter taking UTC year and month, then adding first
 // and last date of the month accordingly
@@ -1327,6 +1459,21 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// isProjectOwner checks if the user is the owner of given project
+func (s *Service) isProjectOwner(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	if project.OwnerID != userID {
+		return ErrNoMembership.New(unauthorizedErrMsg)
+	}
+
+	return nil
+}
+
 // withTx is a helper function for executing db operations
 // in transaction scope
 func withTx(tx DBTx, cb func(tx DBTx) error) (err error) {
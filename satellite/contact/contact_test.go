@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+)
+
+func TestCheckIn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+
+		t.Run("reachable", func(t *testing.T) {
+			node := planet.StorageNodes[0]
+			node.Contact.Chore.Loop.Pause()
+
+			before, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+
+			satelliteNode := satellite.Local().Node
+			conn, err := node.Transport.DialNode(ctx, &satelliteNode)
+			require.NoError(t, err)
+			defer ctx.Check(conn.Close)
+
+			resp, err := pb.NewNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+				Address:  node.Addr(),
+				Capacity: &pb.NodeCapacity{FreeDisk: 1234, FreeBandwidth: 5678},
+				Operator: &pb.NodeOperator{Email: "checkin@mail.test", Wallet: "0x1234"},
+				Version:  &pb.NodeVersion{Version: "v1.2.3", CommitHash: "abc"},
+			})
+			require.NoError(t, err)
+			require.True(t, resp.PingNodeSuccess, resp.PingErrorMessage)
+			require.Empty(t, resp.PingErrorMessage)
+
+			after, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Equal(t, node.Addr(), after.Address.Address)
+			require.EqualValues(t, 1234, after.Capacity.FreeDisk)
+			require.EqualValues(t, 5678, after.Capacity.FreeBandwidth)
+			require.Equal(t, "checkin@mail.test", after.Operator.Email)
+			require.Equal(t, "0x1234", after.Operator.Wallet)
+			require.Equal(t, "v1.2.3", after.Version.Version)
+			require.True(t, after.Reputation.UptimeCount > before.Reputation.UptimeCount)
+			require.True(t, after.Reputation.LastContactSuccess.After(before.Reputation.LastContactSuccess))
+		})
+
+		t.Run("unreachable", func(t *testing.T) {
+			node := planet.StorageNodes[1]
+			node.Contact.Chore.Loop.Pause()
+
+			before, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+
+			satelliteNode := satellite.Local().Node
+			conn, err := node.Transport.DialNode(ctx, &satelliteNode)
+			require.NoError(t, err)
+			defer ctx.Check(conn.Close)
+
+			// nothing listens on the address anymore
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			address := listener.Addr().String()
+			require.NoError(t, listener.Close())
+
+			resp, err := pb.NewNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+				Address:  address,
+				Operator: &pb.NodeOperator{Email: "unreachable@mail.test"},
+			})
+			require.NoError(t, err)
+			require.False(t, resp.PingNodeSuccess)
+			require.NotEmpty(t, resp.PingErrorMessage)
+
+			// the unverified address isn't stored
+			after, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Equal(t, before.Address.Address, after.Address.Address)
+			require.Equal(t, before.Operator.Email, after.Operator.Email)
+			require.True(t, after.Reputation.LastContactFailure.After(before.Reputation.LastContactFailure))
+		})
+	})
+}
+
+func TestCheckInChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+
+		node := planet.StorageNodes[0]
+		node.Contact.Chore.Loop.Pause()
+
+		before, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+
+		node.Contact.Chore.Loop.TriggerWait()
+
+		after, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, node.Local().Operator.Email, after.Operator.Email)
+		require.True(t, after.Reputation.UptimeCount > before.Reputation.UptimeCount)
+		require.True(t, after.Reputation.LastContactSuccess.After(before.Reputation.LastContactSuccess))
+	})
+}
+
+func TestCheckInWithoutKademlia(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Contact.Kademlia = false
+				config.Discovery.Enabled = false
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		require.Nil(t, satellite.Kademlia.Service)
+		require.Nil(t, satellite.Discovery.Service)
+		require.Equal(t, satellite.Addr(), satellite.Local().Address.Address)
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Loop.TriggerWait()
+
+			info, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Equal(t, node.Addr(), info.Address.Address)
+			require.True(t, info.Reputation.UptimeCount > 0)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+var (
+	// Error is the default error class for the contact endpoint
+	Error = errs.Class("contact error")
+
+	mon = monkit.Package()
+)
+
+// Config for the contact endpoint
+type Config struct {
+	Timeout  time.Duration `help:"timeout for pinging a storage node back when it checks in" default:"10s"`
+	Kademlia bool          `help:"set if the satellite runs kademlia besides the check-ins, nodes are only discovered with kademlia lookups when it runs" default:"true"`
+}
+
+// Endpoint handles check-ins from storage nodes
+type Endpoint struct {
+	log       *zap.Logger
+	config    Config
+	transport transport.Client
+	overlay   *overlay.Cache
+}
+
+// NewEndpoint creates a new contact endpoint
+//
+// The transport must not notify the overlay about connections, the endpoint
+// records the outcome of pinging the node back itself.
+func NewEndpoint(log *zap.Logger, config Config, transport transport.Client, overlay *overlay.Cache) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		config:    config,
+		transport: transport,
+		overlay:   overlay,
+	}
+}
+
+// CheckIn is called periodically by storage nodes to update their info in the overlay.
+// The node is pinged back on the given address first and its info is only updated
+// when it's reachable there.
+func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (_ *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, Error.New("missing node address").Error())
+	}
+
+	node := pb.Node{
+		Id: nodeID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   req.Address,
+		},
+	}
+
+	// the address and info are only stored once the node answered on that address
+	pingErr := endpoint.pingBack(ctx, &node)
+	if pingErr != nil {
+		endpoint.log.Debug("failed to ping back node", zap.Stringer("Node ID", nodeID), zap.Error(pingErr))
+
+		// record the failure for nodes the satellite already knows
+		_, err = endpoint.overlay.Get(ctx, nodeID)
+		if err != nil {
+			if overlay.ErrNodeNotFound.Has(err) {
+				return &pb.CheckInResponse{PingErrorMessage: pingErr.Error()}, nil
+			}
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+	} else {
+		err = endpoint.overlay.Put(ctx, nodeID, node)
+		if err != nil {
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+
+		_, err = endpoint.overlay.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+			Type:     pb.NodeType_STORAGE,
+			Operator: req.Operator,
+			Capacity: req.Capacity,
+			Version:  req.Version,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+	}
+
+	_, err = endpoint.overlay.UpdateUptime(ctx, nodeID, pingErr == nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	resp := &pb.CheckInResponse{PingNodeSuccess: pingErr == nil}
+	if pingErr != nil {
+		resp.PingErrorMessage = pingErr.Error()
+	}
+	return resp, nil
+}
+
+// pingBack dials the node and verifies that it responds to pings.
+func (endpoint *Endpoint) pingBack(ctx context.Context, node *pb.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, endpoint.config.Timeout)
+	defer cancel()
+
+	conn, err := endpoint.transport.DialNode(ctx, node)
+	if err != nil {
+		return Error.New("unable to connect to the node: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewContactClient(conn).PingNode(ctx, &pb.ContactPingRequest{})
+	if err != nil {
+		return Error.New("failed to ping node: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/uplink"
+	"storj.io/storj/uplink/piecestore"
+)
+
+// TestGarbageCollection does the following:
+// * Set up a network with one storagenode
+// * Upload two objects
+// * Delete one object from the metainfo service on the satellite
+// * Wait for bloom filter generation
+// * Check that pieces of the deleted object are deleted on the storagenode
+// * Check that pieces of the kept object are not deleted on the storagenode
+// * Restore the trash and check that pieces of the deleted object are back
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// use a very low false positive rate to make sure
+				// the deleted piece doesn't end up in the filter
+				config.GarbageCollection.FalsePositiveRate = 0.000000001
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		targetNode := planet.StorageNodes[0]
+		gcService := satellite.GarbageCollection.Service
+		gcService.Loop.Pause()
+
+		// configure redundancy so that all pieces end up on the single storagenode
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     1,
+			RepairThreshold:  1,
+			SuccessThreshold: 1,
+			MaxThreshold:     1,
+		}
+
+		// Upload two objects
+		testData1 := testrand.Bytes(8 * memory.KiB)
+		testData2 := testrand.Bytes(8 * memory.KiB)
+
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path/1", testData1)
+		require.NoError(t, err)
+		deletedEncPath, pointerToDelete := getPointer(ctx, t, satellite, "")
+
+		err = upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path/2", testData2)
+		require.NoError(t, err)
+		_, pointerToKeep := getPointer(ctx, t, satellite, deletedEncPath)
+
+		// Delete one object from metainfo service on satellite
+		err = satellite.Metainfo.Service.Delete(ctx, deletedEncPath)
+		require.NoError(t, err)
+
+		// Check that piece of the deleted object is on the storagenode
+		pieceIDToDelete := derivePieceID(t, pointerToDelete, targetNode)
+		reader, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceIDToDelete)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// The pieceInfo.GetPieceIDs query converts piece creation and the filter creation timestamps
+		// to datetime in sql. This chops off all precision beyond seconds.
+		// In this test, the amount of time that elapses between piece uploads and the gc loop is
+		// less than a second, meaning datetime(piece_creation) < datetime(filter_creation) is false unless we sleep
+		// for a second.
+		time.Sleep(1 * time.Second)
+
+		// Wait for next iteration of garbage collection to finish
+		gcService.Loop.Restart()
+		gcService.Loop.TriggerWait()
+
+		// Check that piece of the deleted object is not on the storagenode
+		_, err = targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceIDToDelete)
+		require.Error(t, err)
+
+		// Check that piece of the kept object is on the storagenode
+		pieceIDToKeep := derivePieceID(t, pointerToKeep, targetNode)
+		reader, err = targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceIDToKeep)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// Restore the trash to undo the garbage collection
+		node := targetNode.Local().Node
+		client, err := piecestore.Dial(ctx, satellite.Transport, &node, satellite.Log, piecestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		err = client.RestoreTrash(ctx)
+		require.NoError(t, err)
+
+		// Check that piece of the deleted object is back on the storagenode
+		reader, err = targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceIDToDelete)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	})
+}
+
+// getPointer returns the first remote pointer which path differs from skip
+func getPointer(ctx *testcontext.Context, t *testing.T, satellite *satellite.Peer, skip storj.Path) (storj.Path, *pb.Pointer) {
+	t.Helper()
+
+	items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+	require.NoError(t, err)
+
+	for _, item := range items {
+		path := item.GetPath()
+		if path == skip {
+			continue
+		}
+
+		pointer, err := satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		if pointer.GetType() == pb.Pointer_REMOTE {
+			return path, pointer
+		}
+	}
+
+	t.Fatal("satellite doesn't have the expected remote segment")
+	return "", nil
+}
+
+// derivePieceID returns the piece id stored on the node for the pointer
+func derivePieceID(t *testing.T, pointer *pb.Pointer, node *storagenode.Peer) storj.PieceID {
+	t.Helper()
+
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == node.ID() {
+			return remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+		}
+	}
+
+	t.Fatal("node doesn't store a piece of the pointer")
+	return storj.PieceID{}
+}
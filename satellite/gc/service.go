@@ -0,0 +1,210 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error defines the gc service errors class
+	Error = errs.Class("gc service error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval time.Duration `help:"the time between each send of garbage collection filters to storage nodes" releaseDefault:"168h" devDefault:"10m"`
+	Enabled  bool          `help:"set if garbage collection is enabled or not" releaseDefault:"false" devDefault:"true"`
+	// value for InitialPieces currently based on average pieces per node
+	InitialPieces     int     `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" releaseDefault:"400000" devDefault:"10"`
+	FalsePositiveRate float64 `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
+	ConcurrentSends   int     `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
+}
+
+// Service implements the garbage collection service
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	transport transport.Client
+	overlay   *overlay.Cache
+	metainfo  *metainfo.Service
+
+	// pieceCounts keeps track of the number of pieces each node held
+	// during the previous run, used to size the next filters
+	pieceCounts map[storj.NodeID]int
+}
+
+// RetainInfo contains info needed for a storage node to retain important data and delete garbage data
+type RetainInfo struct {
+	Filter       *bloomfilter.Filter
+	CreationDate time.Time
+	Count        int
+}
+
+// NewService creates a new instance of the gc service
+func NewService(log *zap.Logger, config Config, transport transport.Client, overlay *overlay.Cache, metainfo *metainfo.Service) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		transport: transport,
+		overlay:   overlay,
+		metainfo:  metainfo,
+
+		pieceCounts: make(map[storj.NodeID]int),
+	}
+}
+
+// Run starts the gc loop service
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.process(ctx)
+		if err != nil {
+			service.log.Error("process", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the gc loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// process builds the bloom filters for all storage nodes and sends them out
+func (service *Service) process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	retainInfos, err := service.collectRetainInfos(ctx)
+	if err != nil {
+		return err
+	}
+
+	// save piece counts for the next run, so the filters are sized appropriately
+	service.pieceCounts = make(map[storj.NodeID]int, len(retainInfos))
+	for nodeID, info := range retainInfos {
+		service.pieceCounts[nodeID] = info.Count
+	}
+
+	limiter := sync2.NewLimiter(service.config.ConcurrentSends)
+	for nodeID, info := range retainInfos {
+		nodeID, info := nodeID, info
+		limiter.Go(ctx, func() {
+			err := service.sendRetainRequest(ctx, nodeID, info)
+			if err != nil {
+				service.log.Error("error sending retain info to node", zap.Stringer("node ID", nodeID), zap.Error(err))
+			}
+		})
+	}
+	limiter.Wait()
+
+	return nil
+}
+
+// collectRetainInfos iterates over all pointers and builds a bloom filter for every storage node
+func (service *Service) collectRetainInfos(ctx context.Context) (_ map[storj.NodeID]*RetainInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces created after this time are not covered by the filters
+	creationDate := time.Now().UTC()
+	retainInfos := make(map[storj.NodeID]*RetainInfo)
+
+	err = service.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				for _, piece := range remote.GetRemotePieces() {
+					info, ok := retainInfos[piece.NodeId]
+					if !ok {
+						info = &RetainInfo{
+							Filter:       bloomfilter.NewOptimal(service.expectedPieces(piece.NodeId), service.config.FalsePositiveRate),
+							CreationDate: creationDate,
+						}
+						retainInfos[piece.NodeId] = info
+					}
+
+					info.Filter.Add(remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+					info.Count++
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return retainInfos, nil
+}
+
+// expectedPieces returns the number of pieces a filter for the node should be sized for
+func (service *Service) expectedPieces(nodeID storj.NodeID) int {
+	count := service.pieceCounts[nodeID]
+	if count < service.config.InitialPieces {
+		return service.config.InitialPieces
+	}
+	return count
+}
+
+// sendRetainRequest sends the bloom filter to the storage node
+func (service *Service) sendRetainRequest(ctx context.Context, nodeID storj.NodeID, info *RetainInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client, err := piecestore.Dial(ctx, service.transport, &dossier.Node, service.log.Named("piecestore"), piecestore.DefaultConfig)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(client.Close()))
+	}()
+
+	err = client.Retain(ctx, &pb.RetainRequest{
+		CreationDate: info.CreationDate,
+		Filter:       info.Filter.Bytes(),
+	})
+	return Error.Wrap(err)
+}
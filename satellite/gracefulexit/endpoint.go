@@ -0,0 +1,474 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is the default error class for graceful exit
+	Error = errs.Class("graceful exit error")
+	// ErrVerification is returned when the exiting node sent a piece that doesn't match the original
+	ErrVerification = errs.Class("graceful exit verification error")
+
+	// errPieceMoved is returned when the segment changed while the piece was transferred
+	errPieceMoved = errs.Class("piece moved")
+
+	mon = monkit.Package()
+)
+
+// Config for the graceful exit endpoint
+type Config struct {
+	OverallMaxFailuresPercentage int `help:"maximum percentage of transfer failures per node before the graceful exit is considered failed" default:"10"`
+	TransferBatchSize            int `help:"number of pieces of the exiting node collected at once for the transfer" default:"1000"`
+}
+
+// Endpoint for handling the transfer of pieces for graceful exit
+type Endpoint struct {
+	log       *zap.Logger
+	config    Config
+	signer    signing.Signer
+	transport transport.Client
+	metainfo  *metainfo.Service
+	orders    *orders.Service
+	overlay   *overlay.Cache
+}
+
+// transferItem is a piece of a segment that has to be transferred away from the exiting node
+type transferItem struct {
+	path     storj.Path
+	pieceNum int32
+}
+
+// transferResult is the outcome of transferring a single piece
+type transferResult int
+
+const (
+	// transferSucceeded means the piece was moved to a new node
+	transferSucceeded transferResult = iota
+	// transferFailed means the exiting node failed to move the piece and it counts against the exit
+	transferFailed
+	// transferSkipped means the piece doesn't have to be moved or the node can't be blamed for it
+	transferSkipped
+)
+
+// NewEndpoint creates a new graceful exit endpoint
+func NewEndpoint(log *zap.Logger, config Config, signer signing.Signer, transport transport.Client, metainfo *metainfo.Service, orders *orders.Service, overlay *overlay.Cache) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		config:    config,
+		signer:    signer,
+		transport: transport,
+		metainfo:  metainfo,
+		orders:    orders,
+		overlay:   overlay,
+	}
+}
+
+// Process is called by storage nodes to initiate the graceful exit, transfer pieces and receive the exit status.
+func (endpoint *Endpoint) Process(stream pb.SatelliteGracefulExit_ProcessServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	log := endpoint.log.With(zap.Stringer("node ID", nodeID))
+
+	node, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return status.Error(codes.NotFound, Error.Wrap(err).Error())
+		}
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	if node.Disqualified != nil {
+		return status.Error(codes.PermissionDenied, Error.New("node is disqualified").Error())
+	}
+
+	// the exit already finished, send the result again
+	if finishedAt := node.ExitStatus.ExitFinishedAt; finishedAt != nil {
+		return endpoint.sendExitResult(ctx, stream, nodeID, *finishedAt, node.ExitStatus.ExitSuccess, node.ExitStatus.ExitFailureReason)
+	}
+
+	if node.ExitStatus.ExitInitiatedAt == nil {
+		_, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          nodeID,
+			ExitInitiatedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		log.Info("graceful exit initiated")
+	}
+
+	success, reason := true, pb.ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED
+
+	var transferred, failed, skipped int
+	var after storj.Path
+	more := true
+transfers:
+	for more {
+		var items []transferItem
+		items, after, more, err = endpoint.collectTransferItems(ctx, nodeID, after, endpoint.config.TransferBatchSize)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		for _, item := range items {
+			result, err := endpoint.transfer(ctx, stream, nodeID, item)
+			if err != nil {
+				if ErrVerification.Has(err) {
+					log.Warn("transferred piece failed verification", zap.String("path", item.path), zap.Int32("piece num", item.pieceNum), zap.Error(err))
+					success, reason = false, pb.ExitFailed_VERIFICATION_FAILED
+					break transfers
+				}
+				if errs.Is(err, io.EOF) {
+					return nil
+				}
+				if _, ok := status.FromError(err); ok {
+					return err
+				}
+				return status.Error(codes.Unknown, err.Error())
+			}
+
+			switch result {
+			case transferSucceeded:
+				transferred++
+			case transferFailed:
+				failed++
+			case transferSkipped:
+				skipped++
+			}
+		}
+	}
+
+	mon.IntVal("graceful_exit_transfer_piece_success").Observe(int64(transferred))
+	mon.IntVal("graceful_exit_transfer_piece_fail").Observe(int64(failed))
+
+	if total := transferred + failed; total > 0 && failed*100 > total*endpoint.config.OverallMaxFailuresPercentage {
+		success = false
+	}
+
+	finishedAt := time.Now().UTC()
+	_, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+		NodeID:            nodeID,
+		ExitFinishedAt:    finishedAt,
+		ExitSuccess:       success,
+		ExitFailureReason: reason,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	log.Info("graceful exit finished", zap.Bool("success", success), zap.Int("transferred", transferred), zap.Int("failed", failed), zap.Int("skipped", skipped))
+
+	return endpoint.sendExitResult(ctx, stream, nodeID, finishedAt, success, reason)
+}
+
+// collectTransferItems iterates over the pointers after the given path and collects
+// the pieces stored by the node, until at least limit pieces are collected.
+// It returns the path to continue from and whether there are more pointers to check.
+func (endpoint *Endpoint) collectTransferItems(ctx context.Context, nodeID storj.NodeID, after storj.Path, limit int) (items []transferItem, last storj.Path, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	last = after
+	err = endpoint.metainfo.Iterate(ctx, "", after, true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				path := item.Key.String()
+				if path == after {
+					continue
+				}
+
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				for _, piece := range pointer.GetRemote().GetRemotePieces() {
+					if piece.NodeId == nodeID {
+						items = append(items, transferItem{
+							path:     path,
+							pieceNum: piece.PieceNum,
+						})
+					}
+				}
+
+				last = path
+				if len(items) >= limit {
+					more = true
+					return nil
+				}
+			}
+			return nil
+		},
+	)
+	return items, last, more, Error.Wrap(err)
+}
+
+// transfer sends a transfer order for a single piece and updates the pointer
+// when the storage node reports success. Errors on the satellite side abort the
+// exit with an internal error instead of counting against the node, so that the
+// node can retry later. ErrVerification is returned when the transferred piece
+// doesn't match the original.
+func (endpoint *Endpoint) transfer(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, nodeID storj.NodeID, item transferItem) (result transferResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := endpoint.log.With(zap.Stringer("node ID", nodeID), zap.String("path", item.path), zap.Int32("piece num", item.pieceNum))
+
+	pointer, err := endpoint.metainfo.Get(ctx, item.path)
+	if err != nil {
+		// the segment was deleted in the meantime, nothing to transfer
+		if storage.ErrKeyNotFound.Has(err) {
+			return transferSkipped, nil
+		}
+		return transferSkipped, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	remote := pointer.GetRemote()
+	if !hasPiece(remote, nodeID, item.pieceNum) {
+		// the piece was already moved by the repairer or a previous exit attempt
+		return transferSkipped, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		log.Error("invalid redundancy strategy", zap.Error(err))
+		return transferSkipped, nil
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	var excludedNodes []storj.NodeID
+	for _, piece := range remote.GetRemotePieces() {
+		excludedNodes = append(excludedNodes, piece.NodeId)
+	}
+
+	placement, err := endpoint.metainfo.GetPlacement(ctx, item.path)
+	if err != nil {
+		return transferSkipped, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	newNodes, err := endpoint.overlay.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: 1,
+		FreeBandwidth:  pieceSize,
+		FreeDisk:       pieceSize,
+		ExcludedNodes:  excludedNodes,
+		Placement:      placement,
+	})
+	if err != nil {
+		return transferSkipped, status.Error(codes.Unavailable, Error.Wrap(err).Error())
+	}
+	newNode := newNodes[0]
+
+	bucketID, err := createBucketID(item.path)
+	if err != nil {
+		log.Error("invalid path", zap.Error(err))
+		return transferSkipped, nil
+	}
+
+	limit, privateKey, err := endpoint.orders.CreateGracefulExitPutOrderLimit(ctx, bucketID, newNode.Id, item.pieceNum, remote.RootPieceId, pieceSize, pointer.GetExpirationDate())
+	if err != nil {
+		return transferSkipped, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	originalPieceID := remote.RootPieceId.Derive(nodeID, item.pieceNum)
+	err = stream.Send(&pb.SatelliteMessage{
+		Message: &pb.SatelliteMessage_TransferPiece{
+			TransferPiece: &pb.TransferPiece{
+				OriginalPieceId:     originalPieceID,
+				PrivateKey:          privateKey,
+				AddressedOrderLimit: limit,
+			},
+		},
+	})
+	if err != nil {
+		return transferFailed, err
+	}
+
+	response, err := stream.Recv()
+	if err != nil {
+		return transferFailed, err
+	}
+
+	switch m := response.GetMessage().(type) {
+	case *pb.StorageNodeMessage_Succeeded:
+		if m.Succeeded.OriginalPieceId != originalPieceID {
+			return transferFailed, Error.New("unexpected original piece id %s", m.Succeeded.OriginalPieceId)
+		}
+
+		hash := m.Succeeded.GetReplacementPieceHash()
+		err = endpoint.verifyReplacementHash(ctx, newNode, limit.Limit.PieceId, hash)
+		if err != nil {
+			log.Warn("unable to verify replacement piece hash", zap.Error(err))
+			return transferFailed, nil
+		}
+
+		err = endpoint.verifyOriginalHash(ctx, originalPieceID, m.Succeeded.GetOriginalOrderLimit(), m.Succeeded.GetOriginalPieceHash(), hash)
+		if err != nil {
+			return transferFailed, err
+		}
+
+		err = endpoint.updatePointer(ctx, item, nodeID, &pb.RemotePiece{
+			PieceNum: item.pieceNum,
+			NodeId:   newNode.Id,
+			Hash:     hash,
+		})
+		if err != nil {
+			if errPieceMoved.Has(err) {
+				log.Info("segment changed during the transfer", zap.Error(err))
+				return transferSkipped, nil
+			}
+			return transferSkipped, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		return transferSucceeded, nil
+	case *pb.StorageNodeMessage_Failed:
+		if m.Failed.OriginalPieceId != originalPieceID {
+			return transferFailed, Error.New("unexpected original piece id %s", m.Failed.OriginalPieceId)
+		}
+		log.Info("transfer failed", zap.Stringer("error", m.Failed.Error))
+		return transferFailed, nil
+	default:
+		return transferFailed, Error.New("unknown storage node message: %v", response)
+	}
+}
+
+// verifyReplacementHash checks that the hash was signed by the storage node which received the piece
+func (endpoint *Endpoint) verifyReplacementHash(ctx context.Context, node *pb.Node, pieceID storj.PieceID, hash *pb.PieceHash) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if hash == nil {
+		return Error.New("missing replacement piece hash")
+	}
+	if hash.PieceId != pieceID {
+		return Error.New("invalid replacement piece id %s, expected %s", hash.PieceId, pieceID)
+	}
+
+	peer, err := endpoint.transport.FetchPeerIdentity(ctx, node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(signing.VerifyPieceHashSignature(ctx, signing.SigneeFromPeerIdentity(peer), hash))
+}
+
+// verifyOriginalHash checks that the original piece hash was signed by the uplink which uploaded
+// the piece and that the replacement piece has the same content.
+func (endpoint *Endpoint) verifyOriginalHash(ctx context.Context, pieceID storj.PieceID, limit *pb.OrderLimit, original, replacement *pb.PieceHash) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit == nil || original == nil {
+		return ErrVerification.New("missing original order limit or piece hash")
+	}
+	if limit.PieceId != pieceID || original.PieceId != pieceID {
+		return ErrVerification.New("original piece id doesn't match %s", pieceID)
+	}
+	if err := signing.VerifyOrderLimitSignature(ctx, endpoint.signer, limit); err != nil {
+		return ErrVerification.New("invalid original order limit signature: %v", err)
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, limit.UplinkPublicKey, original); err != nil {
+		return ErrVerification.New("invalid original piece hash signature: %v", err)
+	}
+	if !bytes.Equal(original.Hash, replacement.Hash) {
+		return ErrVerification.New("replacement piece hash doesn't match the original")
+	}
+	return nil
+}
+
+// updatePointer replaces the piece of the exiting node with the transferred piece.
+// The pointer is swapped atomically, so that a concurrent repair isn't overwritten.
+func (endpoint *Endpoint) updatePointer(ctx context.Context, item transferItem, exitingNodeID storj.NodeID, newPiece *pb.RemotePiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return endpoint.metainfo.Update(ctx, item.path, func(pointer *pb.Pointer) error {
+		remote := pointer.GetRemote()
+		for i, piece := range remote.GetRemotePieces() {
+			if piece.NodeId == exitingNodeID && piece.PieceNum == item.pieceNum {
+				remote.RemotePieces[i] = newPiece
+				return nil
+			}
+		}
+		return errPieceMoved.New("piece %d of node %s is no longer part of the segment", item.pieceNum, exitingNodeID)
+	})
+}
+
+// sendExitResult sends the signed exit completed or exit failed message to the storage node
+func (endpoint *Endpoint) sendExitResult(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, nodeID storj.NodeID, finishedAt time.Time, success bool, reason pb.ExitFailed_Reason) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var message *pb.SatelliteMessage
+	if success {
+		completed, err := signing.SignExitCompleted(ctx, endpoint.signer, &pb.ExitCompleted{
+			SatelliteId: endpoint.signer.ID(),
+			NodeId:      nodeID,
+			Completed:   finishedAt,
+		})
+		if err != nil {
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		message = &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitCompleted{ExitCompleted: completed}}
+	} else {
+		failed, err := signing.SignExitFailed(ctx, endpoint.signer, &pb.ExitFailed{
+			SatelliteId: endpoint.signer.ID(),
+			NodeId:      nodeID,
+			Failed:      finishedAt,
+			Reason:      reason,
+		})
+		if err != nil {
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		message = &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitFailed{ExitFailed: failed}}
+	}
+
+	err = stream.Send(message)
+	if err != nil {
+		return status.Error(codes.Unknown, Error.Wrap(err).Error())
+	}
+	return nil
+}
+
+// hasPiece returns whether the node stores the piece with the given number
+func hasPiece(remote *pb.RemoteSegment, nodeID storj.NodeID, pieceNum int32) bool {
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == nodeID && piece.PieceNum == pieceNum {
+			return true
+		}
+	}
+	return false
+}
+
+func createBucketID(path storj.Path) ([]byte, error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
+}
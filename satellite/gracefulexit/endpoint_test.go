@@ -0,0 +1,232 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/uplink"
+)
+
+func TestGracefulExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// store a piece on 4 of the 5 nodes, leaving one node free to receive the transferred piece
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		path, pointer, err := testplanet.FindRemotePointer(ctx, satellite)
+		require.NoError(t, err)
+		exitingNode := findNode(t, planet, pointer.GetRemote().GetRemotePieces()[0].NodeId)
+
+		completed, err := exitingNode.GracefulExit.Service.Exit(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.Equal(t, satellite.ID(), completed.SatelliteId)
+		require.Equal(t, exitingNode.ID(), completed.NodeId)
+
+		signee := signing.SigneeFromPeerIdentity(satellite.Identity.PeerIdentity())
+		require.NoError(t, signing.VerifyExitCompleted(ctx, signee, completed))
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dossier.ExitStatus.ExitInitiatedAt)
+		require.NotNil(t, dossier.ExitStatus.ExitFinishedAt)
+		require.True(t, dossier.ExitStatus.ExitSuccess)
+
+		// the piece of the exiting node has been replaced by a piece on another node
+		updated, err := satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		pieces := updated.GetRemote().GetRemotePieces()
+		require.Len(t, pieces, len(pointer.GetRemote().GetRemotePieces()))
+		for _, piece := range pieces {
+			require.NotEqual(t, exitingNode.ID(), piece.NodeId)
+
+			node := findNode(t, planet, piece.NodeId)
+			pieceID := updated.GetRemote().RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+			reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+
+		// exiting again returns the same receipt
+		again, err := exitingNode.GracefulExit.Service.Exit(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.True(t, completed.Completed.Equal(again.Completed))
+
+		// exited nodes are no longer selected for new uploads
+		nodes, err := satellite.Overlay.Service.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4})
+		require.NoError(t, err)
+		for _, node := range nodes {
+			require.NotEqual(t, exitingNode.ID(), node.Id)
+		}
+
+		downloaded, err := upl.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, downloaded)
+	})
+}
+
+func TestGracefulExitVerificationFailed(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		path, pointer, err := testplanet.FindRemotePointer(ctx, satellite)
+		require.NoError(t, err)
+		exitingNode := findNode(t, planet, pointer.GetRemote().GetRemotePieces()[0].NodeId)
+
+		// corrupt the piece of the exiting node, the replacement hash won't match the original
+		pieceID := pointer.GetRemote().RootPieceId.Derive(exitingNode.ID(), pointer.GetRemote().GetRemotePieces()[0].PieceNum)
+		reader, err := exitingNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+		data := make([]byte, reader.Size())
+		_, err = io.ReadFull(reader, data)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		data[0]++
+		writer, err := exitingNode.Storage2.Store.Writer(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		_, err = exitingNode.GracefulExit.Service.Exit(ctx, satellite.ID())
+		require.Error(t, err)
+		require.True(t, gracefulexit.ErrExitFailed.Has(err), err)
+		require.Contains(t, err.Error(), pb.ExitFailed_VERIFICATION_FAILED.String())
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dossier.ExitStatus.ExitFinishedAt)
+		require.False(t, dossier.ExitStatus.ExitSuccess)
+		require.Equal(t, pb.ExitFailed_VERIFICATION_FAILED, dossier.ExitStatus.ExitFailureReason)
+
+		// the corrupted piece wasn't accepted
+		updated, err := satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		require.Equal(t, pointer.GetRemote().GetRemotePieces(), updated.GetRemote().GetRemotePieces())
+
+		// exiting again reports the same reason
+		_, err = exitingNode.GracefulExit.Service.Exit(ctx, satellite.ID())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), pb.ExitFailed_VERIFICATION_FAILED.String())
+	})
+}
+
+func TestGracefulExitStartedByInspector(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		// more segments than the transfer batch size of testplanet
+		for i := 0; i < 5; i++ {
+			err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", fmt.Sprintf("test/path%d", i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		_, pointer, err := testplanet.FindRemotePointer(ctx, satellite)
+		require.NoError(t, err)
+		exitingNode := findNode(t, planet, pointer.GetRemote().GetRemotePieces()[0].NodeId)
+
+		_, err = exitingNode.Storage2.Inspector.StartGracefulExit(ctx, &pb.StartGracefulExitRequest{
+			SatelliteId: testidentity.MustPregeneratedSignedIdentity(9, storj.LatestIDVersion()).ID,
+		})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = exitingNode.Storage2.Inspector.StartGracefulExit(ctx, &pb.StartGracefulExitRequest{
+			SatelliteId: satellite.ID(),
+		})
+		require.NoError(t, err)
+
+		// the exit runs in the background
+		var dossier *overlay.NodeDossier
+		for start := time.Now(); time.Since(start) < 30*time.Second; time.Sleep(100 * time.Millisecond) {
+			dossier, err = satellite.Overlay.Service.Get(ctx, exitingNode.ID())
+			require.NoError(t, err)
+			if dossier.ExitStatus.ExitFinishedAt != nil {
+				break
+			}
+		}
+		require.NotNil(t, dossier.ExitStatus.ExitFinishedAt)
+		require.True(t, dossier.ExitStatus.ExitSuccess)
+
+		// no segment references the exiting node anymore
+		items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+		require.NoError(t, err)
+		for _, item := range items {
+			updated, err := satellite.Metainfo.Service.Get(ctx, item.GetPath())
+			require.NoError(t, err)
+			for _, piece := range updated.GetRemote().GetRemotePieces() {
+				require.NotEqual(t, exitingNode.ID(), piece.NodeId)
+			}
+		}
+	})
+}
+
+// findNode returns the storage node with the given id
+func findNode(t *testing.T, planet *testplanet.Planet, nodeID storj.NodeID) *storagenode.Peer {
+	t.Helper()
+
+	for _, node := range planet.StorageNodes {
+		if node.ID() == nodeID {
+			return node
+		}
+	}
+
+	t.Fatalf("storage node %s not found", nodeID)
+	return nil
+}
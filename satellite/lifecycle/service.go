@@ -0,0 +1,322 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error defines the lifecycle service errors class
+	Error = errs.Class("lifecycle service error")
+	mon   = monkit.Package()
+)
+
+// day is the unit of the lifecycle rules
+const day = 24 * time.Hour
+
+// Config contains configurable values for the enforcement of bucket lifecycle rules
+type Config struct {
+	Interval time.Duration `help:"the time between each scan of the metainfo for objects expired by bucket lifecycle rules" releaseDefault:"24h" devDefault:"1m"`
+	Enabled  bool          `help:"set if bucket lifecycle rules are enforced or not, they are only enforced when garbage collection is enabled too" releaseDefault:"false" devDefault:"true"`
+}
+
+// Service deletes the objects and the incomplete uploads that expired
+// according to the lifecycle rules of their bucket. The pieces of the
+// deleted segments are removed from the storage nodes by garbage collection,
+// so the service must not run without it.
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	metainfo *metainfo.Service
+}
+
+// bucketKey identifies a bucket of a project
+type bucketKey struct {
+	projectID string
+	name      string
+}
+
+// expiredObject is an object or a segment of an incomplete upload that expired
+type expiredObject struct {
+	bucket        storj.Bucket
+	encryptedPath string
+	// segmentPath is only set for segments of incomplete uploads
+	segmentPath storj.Path
+}
+
+// NewService creates a new instance of the lifecycle service
+func NewService(log *zap.Logger, config Config, metainfo *metainfo.Service) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		metainfo: metainfo,
+	}
+}
+
+// Run starts the lifecycle loop service
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.process(ctx)
+		if err != nil {
+			service.log.Error("process", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the lifecycle loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// process deletes all expired objects and incomplete uploads
+func (service *Service) process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	expired, err := service.collectExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var deletedObjects, deletedSegments int64
+	for _, object := range expired {
+		if object.segmentPath != "" {
+			deleted, err := service.deleteIncompleteSegment(ctx, object)
+			if err != nil {
+				service.log.Error("error deleting segment of incomplete upload", zap.String("path", object.segmentPath), zap.Error(err))
+				continue
+			}
+			if deleted {
+				deletedSegments++
+			}
+			continue
+		}
+
+		err := service.metainfo.DeleteObject(ctx, object.bucket, []byte(object.encryptedPath))
+		if err != nil {
+			service.log.Error("error deleting expired object", zap.String("bucket", object.bucket.Name), zap.String("path", object.encryptedPath), zap.Error(err))
+			continue
+		}
+		deletedObjects++
+	}
+
+	mon.IntVal("expired_objects_deleted").Observe(deletedObjects)
+	mon.IntVal("incomplete_segments_deleted").Observe(deletedSegments)
+
+	return nil
+}
+
+// collectExpired iterates over all pointers and returns the objects and the segments
+// of incomplete uploads that expired at the given time. Archived versions are kept.
+func (service *Service) collectExpired(ctx context.Context, now time.Time) (_ []expiredObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the keys are sorted by project, so the buckets and the committed objects
+	// are only tracked for the project that is currently iterated
+	var currentProject string
+	var buckets map[bucketKey]*storj.Bucket
+	var committed map[string]struct{}
+	var expired []expiredObject
+
+	err = service.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				// the keys are <project id>/<segment>/<bucket>/<encrypted path>
+				parts := strings.SplitN(item.Key.String(), "/", 4)
+				if len(parts) < 4 {
+					continue
+				}
+				projectID, segment, bucketName, encryptedPath := parts[0], parts[1], parts[2], parts[3]
+				if segment != "l" && !isSegmentIndex(segment) {
+					continue
+				}
+
+				if projectID != currentProject || buckets == nil {
+					currentProject = projectID
+					buckets = make(map[bucketKey]*storj.Bucket)
+					committed = make(map[string]struct{})
+				}
+
+				key := bucketKey{projectID: projectID, name: bucketName}
+				bucket, ok := buckets[key]
+				if !ok {
+					var err error
+					bucket, err = service.getBucket(ctx, projectID, bucketName)
+					if err != nil {
+						return err
+					}
+					buckets[key] = bucket
+				}
+				if bucket == nil {
+					continue
+				}
+
+				// the last segments of all objects of a project are listed before their
+				// other segments, which are only part of an incomplete upload without it
+				objectKey := storj.JoinPaths(bucketName, encryptedPath)
+				if segment == "l" {
+					committed[objectKey] = struct{}{}
+				} else if _, ok := committed[objectKey]; ok {
+					continue
+				}
+
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				if segment == "l" {
+					if expiredAt(bucket.Lifecycle, encryptedPath, pointer.CreationDate, now, expireAfterDays) {
+						expired = append(expired, expiredObject{
+							bucket:        *bucket,
+							encryptedPath: encryptedPath,
+						})
+					}
+					continue
+				}
+
+				if expiredAt(bucket.Lifecycle, encryptedPath, pointer.CreationDate, now, abortIncompleteUploadAfterDays) {
+					expired = append(expired, expiredObject{
+						bucket:        *bucket,
+						encryptedPath: encryptedPath,
+						segmentPath:   item.Key.String(),
+					})
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return expired, nil
+}
+
+// getBucket returns the bucket, if it has lifecycle rules, otherwise nil
+func (service *Service) getBucket(ctx context.Context, projectID, bucketName string) (_ *storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.Parse(projectID)
+	if err != nil {
+		// pointers that don't belong to a project have no lifecycle
+		return nil, nil
+	}
+
+	bucket, err := service.metainfo.GetBucket(ctx, []byte(bucketName), *id)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(bucket.Lifecycle) == 0 {
+		return nil, nil
+	}
+	return &bucket, nil
+}
+
+// deleteIncompleteSegment deletes a segment, if the upload of its object
+// was never committed
+func (service *Service) deleteIncompleteSegment(ctx context.Context, object expiredObject) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastPath, err := metainfo.CreatePath(ctx, object.bucket.ProjectID, -1, []byte(object.bucket.Name), []byte(object.encryptedPath))
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	_, err = service.metainfo.Get(ctx, lastPath)
+	switch {
+	case err == nil:
+		// the upload was committed in the meantime
+		return false, nil
+	case !storage.ErrKeyNotFound.Has(err):
+		return false, Error.Wrap(err)
+	}
+
+	err = service.metainfo.Delete(ctx, object.segmentPath)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return false, nil
+		}
+		return false, Error.Wrap(err)
+	}
+	return true, nil
+}
+
+// isSegmentIndex returns whether the segment element of a key is a segment
+// of an object that isn't the last one
+func isSegmentIndex(segment string) bool {
+	if len(segment) < 2 || segment[0] != 's' {
+		return false
+	}
+	for _, c := range segment[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// expireAfterDays returns the days after which objects expire
+func expireAfterDays(rule storj.LifecycleRule) int {
+	return rule.ExpireAfterDays
+}
+
+// abortIncompleteUploadAfterDays returns the days after which incomplete uploads expire
+func abortIncompleteUploadAfterDays(rule storj.LifecycleRule) int {
+	return rule.AbortIncompleteUploadAfterDays
+}
+
+// expiredAt returns whether a segment created at the given time expired according
+// to any enabled rule whose prefix matches the encrypted path
+func expiredAt(rules []storj.LifecycleRule, encryptedPath storj.Path, created, now time.Time, days func(storj.LifecycleRule) int) bool {
+	for _, rule := range rules {
+		if rule.Disabled || days(rule) <= 0 || !matchesPrefix(rule.Prefix, encryptedPath) {
+			continue
+		}
+		if !created.Add(time.Duration(days(rule)) * day).After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPrefix returns whether the encrypted path is below the encrypted prefix.
+// Encrypted paths can only be matched by whole path components.
+func matchesPrefix(prefix, encryptedPath storj.Path) bool {
+	if prefix == "" || prefix == encryptedPath {
+		return true
+	}
+	return strings.HasPrefix(encryptedPath, strings.TrimSuffix(prefix, "/")+"/")
+}
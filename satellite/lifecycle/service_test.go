@@ -0,0 +1,118 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+)
+
+// TestLifecycle does the following:
+// * Upload an object below a prefix with a lifecycle rule and one outside of it
+// * Add a segment of an incomplete upload below the prefix
+// * Backdate all pointers beyond the expiration of the rule
+// * Check that only the object and the segment below the prefix are deleted
+func TestLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		lifecycleService := satellite.Lifecycle.Service
+		lifecycleService.Loop.Pause()
+
+		err := upl.Upload(ctx, satellite, "testbucket", "logs/old", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		expiringPath := getLastSegmentPath(ctx, t, satellite, "")
+
+		err = upl.Upload(ctx, satellite, "testbucket", "keep/new", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		keptPath := getLastSegmentPath(ctx, t, satellite, expiringPath)
+
+		// the keys are <project id>/l/<bucket>/<encrypted prefix>/<encrypted name>
+		parts := strings.Split(expiringPath, "/")
+		require.Len(t, parts, 5)
+		projectID, err := uuid.Parse(parts[0])
+		require.NoError(t, err)
+		encryptedPrefix := parts[3]
+
+		bucket, err := satellite.Metainfo.Service.GetBucket(ctx, []byte("testbucket"), *projectID)
+		require.NoError(t, err)
+		bucket.Lifecycle = []storj.LifecycleRule{{
+			ID:                             "expire logs",
+			Prefix:                         encryptedPrefix,
+			ExpireAfterDays:                30,
+			AbortIncompleteUploadAfterDays: 7,
+		}}
+		_, err = satellite.Metainfo.Service.UpdateBucket(ctx, bucket)
+		require.NoError(t, err)
+
+		incompletePath := storj.JoinPaths(parts[0], "s0", parts[2], encryptedPrefix, "incomplete")
+		err = satellite.Metainfo.Service.Put(ctx, incompletePath, &pb.Pointer{Type: pb.Pointer_INLINE})
+		require.NoError(t, err)
+
+		created := time.Now().Add(-31 * 24 * time.Hour)
+		for _, path := range []storj.Path{expiringPath, keptPath, incompletePath} {
+			backdate(ctx, t, satellite, path, created)
+		}
+
+		lifecycleService.Loop.TriggerWait()
+
+		_, err = satellite.Metainfo.Service.Get(ctx, expiringPath)
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+		_, err = satellite.Metainfo.Service.Get(ctx, incompletePath)
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+		_, err = satellite.Metainfo.Service.Get(ctx, keptPath)
+		require.NoError(t, err)
+
+		data, err := upl.Download(ctx, satellite, "testbucket", "keep/new")
+		require.NoError(t, err)
+		require.Len(t, data, memory.KiB.Int())
+	})
+}
+
+// getLastSegmentPath returns the path of a last segment other than skip
+func getLastSegmentPath(ctx *testcontext.Context, t *testing.T, satellite *satellite.Peer, skip storj.Path) storj.Path {
+	t.Helper()
+
+	items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+	require.NoError(t, err)
+
+	for _, item := range items {
+		path := item.GetPath()
+		if path != skip && strings.Contains(path, "/l/") {
+			return path
+		}
+	}
+
+	t.Fatal("satellite doesn't have the expected last segment")
+	return ""
+}
+
+// backdate changes the creation date of a pointer, which is always set to now by the metainfo service
+func backdate(ctx *testcontext.Context, t *testing.T, satellite *satellite.Peer, path storj.Path, created time.Time) {
+	t.Helper()
+
+	pointer, err := satellite.Metainfo.Service.Get(ctx, path)
+	require.NoError(t, err)
+	pointer.CreationDate = created
+
+	value, err := proto.Marshal(pointer)
+	require.NoError(t, err)
+	require.NoError(t, satellite.Metainfo.Database.Put(ctx, storage.Key(path), value))
+}
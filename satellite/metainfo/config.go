@@ -8,6 +8,7 @@ import (
 
 	"storj.io/storj/internal/dbutil"
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
 	"storj.io/storj/storage/postgreskv"
@@ -31,6 +32,21 @@ type RSConfig struct {
 	Validate         bool        `help:"validate redundancy scheme configuration" default:"true"`
 }
 
+// MinimumRedundancy returns the weakest redundancy policy projects and buckets
+// may use. It doesn't limit policies, when validation is disabled.
+func (rs RSConfig) MinimumRedundancy() console.RedundancyPolicy {
+	if !rs.Validate {
+		return console.RedundancyPolicy{}
+	}
+	return console.RedundancyPolicy{
+		RequiredShares: rs.MinThreshold,
+		RepairShares:   rs.RepairThreshold,
+		OptimalShares:  rs.SuccessThreshold,
+		TotalShares:    rs.MaxThreshold,
+		ShareSize:      rs.ErasureShareSize.Int(),
+	}
+}
+
 // Config is a configuration struct that is everything you need to start a metainfo
 type Config struct {
 	DatabaseURL          string      `help:"the database connection string to use" releaseDefault:"postgres://" devDefault:"bolt://$CONFDIR/pointerdb.db"`
@@ -65,6 +65,27 @@ func TestBasicBucketOperations(t *testing.T) {
 		require.Equal(t, expectedBucket.DefaultSegmentsSize, bucket.DefaultSegmentsSize)
 		require.Equal(t, expectedBucket.DefaultRedundancyScheme, bucket.DefaultRedundancyScheme)
 		require.Equal(t, expectedBucket.DefaultEncryptionParameters, bucket.DefaultEncryptionParameters)
+		require.Equal(t, storj.VersioningUnversioned, bucket.Versioning)
+
+		require.Empty(t, bucket.Lifecycle)
+
+		// UpdateBucket
+		lifecycle := []storj.LifecycleRule{
+			{ID: "logs", Prefix: "encrypted-logs", ExpireAfterDays: 30},
+			{Prefix: "", Disabled: true, AbortIncompleteUploadAfterDays: 7},
+		}
+		bucket.Versioning = storj.VersioningEnabled
+		bucket.Lifecycle = lifecycle
+		_, err = bucketsDB.UpdateBucket(ctx, bucket)
+		require.NoError(t, err)
+
+		bucket, err = bucketsDB.GetBucket(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, storj.VersioningEnabled, bucket.Versioning)
+		require.Equal(t, lifecycle, bucket.Lifecycle)
+
+		_, err = bucketsDB.UpdateBucket(ctx, newTestBucket("missing", project.ID))
+		require.True(t, storj.ErrBucketNotFound.Has(err))
 
 		// DeleteBucket
 		err = bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID)
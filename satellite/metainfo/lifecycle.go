@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// maxLifecycleRules is the maximum number of lifecycle rules of a bucket
+const maxLifecycleRules = 1000
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *pb.BucketSetLifecycleRequest) (resp *pb.BucketSetLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	rules := LifecycleFromProto(req.Rules)
+	err = validateLifecycle(rules)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	bucket.Lifecycle = rules
+	_, err = endpoint.metainfo.UpdateBucket(ctx, bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketSetLifecycleResponse{}, nil
+}
+
+// validateLifecycle checks that the lifecycle rules of a bucket can be enforced
+func validateLifecycle(rules []storj.LifecycleRule) error {
+	if len(rules) > maxLifecycleRules {
+		return Error.New("too many lifecycle rules, %d is the maximum", maxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule.ID != "" {
+			if _, ok := ids[rule.ID]; ok {
+				return Error.New("duplicate lifecycle rule ID %q", rule.ID)
+			}
+			ids[rule.ID] = struct{}{}
+		}
+
+		if rule.ExpireAfterDays < 0 || rule.AbortIncompleteUploadAfterDays < 0 {
+			return Error.New("lifecycle rule %q has a negative number of days", rule.ID)
+		}
+		if rule.ExpireAfterDays == 0 && rule.AbortIncompleteUploadAfterDays == 0 {
+			return Error.New("lifecycle rule %q has no action", rule.ID)
+		}
+	}
+	return nil
+}
+
+// LifecycleToProto converts lifecycle rules with encrypted prefixes to protobuf
+func LifecycleToProto(rules []storj.LifecycleRule) []*pb.LifecycleRule {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	pbRules := make([]*pb.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		pbRules = append(pbRules, &pb.LifecycleRule{
+			Id:                             rule.ID,
+			EncryptedPrefix:                []byte(rule.Prefix),
+			Disabled:                       rule.Disabled,
+			ExpireAfterDays:                int32(rule.ExpireAfterDays),
+			AbortIncompleteUploadAfterDays: int32(rule.AbortIncompleteUploadAfterDays),
+		})
+	}
+	return pbRules
+}
+
+// LifecycleFromProto converts lifecycle rules from protobuf. The prefixes stay encrypted.
+func LifecycleFromProto(pbRules []*pb.LifecycleRule) []storj.LifecycleRule {
+	if len(pbRules) == 0 {
+		return nil
+	}
+
+	rules := make([]storj.LifecycleRule, 0, len(pbRules))
+	for _, pbRule := range pbRules {
+		rules = append(rules, storj.LifecycleRule{
+			ID:                             pbRule.Id,
+			Prefix:                         storj.Path(pbRule.EncryptedPrefix),
+			Disabled:                       pbRule.Disabled,
+			ExpireAfterDays:                int(pbRule.ExpireAfterDays),
+			AbortIncompleteUploadAfterDays: int(pbRule.AbortIncompleteUploadAfterDays),
+		})
+	}
+	return rules
+}
@@ -4,6 +4,7 @@
 package metainfo
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"errors"
@@ -49,6 +50,11 @@ type APIKeys interface {
 	GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error)
 }
 
+// RedundancyPolicies is the redundancy policies store methods used by the endpoint
+type RedundancyPolicies interface {
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (*console.RedundancyPolicy, error)
+}
+
 // Revocations is the revocations store methods used by the endpoint
 type Revocations interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([][]byte, error)
@@ -69,6 +75,7 @@ type Endpoint struct {
 	projectUsage   *accounting.ProjectUsage
 	containment    Containment
 	apiKeys        APIKeys
+	policies       RedundancyPolicies
 	createRequests *createRequests
 	rsConfig       RSConfig
 	satellite      signing.Signer
@@ -76,7 +83,7 @@ type Endpoint struct {
 
 // NewEndpoint creates new metainfo endpoint instance
 func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cache *overlay.Cache, partnerinfo attribution.DB,
-	containment Containment, apiKeys APIKeys, projectUsage *accounting.ProjectUsage, rsConfig RSConfig, satellite signing.Signer) *Endpoint {
+	containment Containment, apiKeys APIKeys, policies RedundancyPolicies, projectUsage *accounting.ProjectUsage, rsConfig RSConfig, satellite signing.Signer) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
 		log:            log,
@@ -86,6 +93,7 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cac
 		partnerinfo:    partnerinfo,
 		containment:    containment,
 		apiKeys:        apiKeys,
+		policies:       policies,
 		projectUsage:   projectUsage,
 		createRequests: newCreateRequests(),
 		rsConfig:       rsConfig,
@@ -115,7 +123,7 @@ func (endpoint *Endpoint) SegmentInfoOld(ctx context.Context, req *pb.SegmentInf
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	path, err := endpoint.resolveSegmentPath(ctx, keyInfo.ProjectID, req.Bucket, req.Path, req.Segment, req.VersionId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -155,22 +163,11 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid expiration time")
 	}
 
-	err = endpoint.validateRedundancy(ctx, req.Redundancy)
+	err = endpoint.validateRedundancyPolicy(ctx, keyInfo.ProjectID, req.Bucket, req.Redundancy)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
-	if err != nil {
-		endpoint.log.Error("retrieving project storage totals", zap.Error(err))
-	}
-	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s",
-			limit, keyInfo.ProjectID,
-		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
-	}
-
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(req.GetRedundancy())
 	if err != nil {
 		return nil, err
@@ -178,10 +175,16 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 
 	maxPieceSize := eestream.CalcPieceSize(req.GetMaxEncryptedSegmentSize(), redundancy)
 
+	placement, err := endpoint.metainfo.getPlacement(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: int(req.Redundancy.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Placement:      placement,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -191,6 +194,9 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucketID, nodes, req.Expiration, maxPieceSize)
 	if err != nil {
+		if accounting.ErrProjectLimitExceeded.Has(err) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
 		return nil, Error.Wrap(err)
 	}
 
@@ -288,6 +294,13 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 		// that will be affected is our per-project bandwidth and storage limits.
 	}
 
+	if req.Segment == -1 {
+		err = endpoint.assignVersionID(ctx, keyInfo.ProjectID, req.Bucket, req.Path, req.Pointer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
 	err = endpoint.metainfo.Put(ctx, path, req.Pointer)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
@@ -334,18 +347,7 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 
 	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
 
-	exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID, bucketID)
-	if err != nil {
-		endpoint.log.Error("retrieving project bandwidth total", zap.Error(err))
-	}
-	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for bandwidth for projectID %s.",
-			limit, keyInfo.ProjectID,
-		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
-	}
-
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	path, err := endpoint.resolveSegmentPath(ctx, keyInfo.ProjectID, req.Bucket, req.Path, req.Segment, req.VersionId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -363,12 +365,27 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 		// TODO or maybe use pointer.SegmentSize ??
 		err := endpoint.orders.UpdateGetInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(pointer.InlineSegment)))
 		if err != nil {
+			if accounting.ErrProjectLimitExceeded.Has(err) {
+				return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+			}
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		return &pb.SegmentDownloadResponseOld{Pointer: pointer}, nil
 	} else if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
-		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucketID, pointer)
+		var limits []*pb.AddressedOrderLimit
+		var privateKey storj.PiecePrivateKey
+		if req.Range != nil {
+			if req.Range.Offset < 0 || req.Range.Length <= 0 || req.Range.Offset+req.Range.Length > pointer.SegmentSize {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid range offset %d, length %d of segment with size %d", req.Range.Offset, req.Range.Length, pointer.SegmentSize)
+			}
+			limits, privateKey, err = endpoint.orders.CreateGetOrderLimitsForRange(ctx, bucketID, pointer, req.Range.Offset, req.Range.Length)
+		} else {
+			limits, privateKey, err = endpoint.orders.CreateGetOrderLimits(ctx, bucketID, pointer)
+		}
 		if err != nil {
+			if accounting.ErrProjectLimitExceeded.Has(err) {
+				return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+			}
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		return &pb.SegmentDownloadResponseOld{Pointer: pointer, AddressedLimits: limits, PrivateKey: privateKey}, nil
@@ -410,6 +427,15 @@ func (endpoint *Endpoint) DeleteSegmentOld(ctx context.Context, req *pb.SegmentD
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	// older versions of objects keep their pieces
+	archived, err := endpoint.archiveSegment(ctx, keyInfo.ProjectID, req.Bucket, req.Path, req.Segment, path, pointer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if archived {
+		return &pb.SegmentDeleteResponseOld{}, nil
+	}
+
 	err = endpoint.metainfo.Delete(ctx, path)
 
 	if err != nil {
@@ -424,6 +450,12 @@ func (endpoint *Endpoint) DeleteSegmentOld(ctx context.Context, req *pb.SegmentD
 			}
 		}
 
+		// the pieces might still be referenced by a copy of the object,
+		// garbage collection removes them once they are no longer used
+		if pointer.PiecesShared {
+			return &pb.SegmentDeleteResponseOld{}, nil
+		}
+
 		bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
 		limits, privateKey, err := endpoint.orders.CreateDeleteOrderLimits(ctx, bucketID, pointer)
 		if err != nil {
@@ -548,10 +580,7 @@ func CreatePath(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bu
 	if segmentIndex < -1 {
 		return "", errors.New("invalid segment index")
 	}
-	segment := "l"
-	if segmentIndex > -1 {
-		segment = "s" + strconv.FormatInt(segmentIndex, 10)
-	}
+	segment := segmentName(segmentIndex)
 
 	entries := make([]string, 0)
 	entries = append(entries, projectID.String())
@@ -565,6 +594,15 @@ func CreatePath(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bu
 	return storj.JoinPaths(entries...), nil
 }
 
+// segmentName returns the path element of the segment with the given index,
+// -1 being the last segment
+func segmentName(segmentIndex int64) string {
+	if segmentIndex == -1 {
+		return "l"
+	}
+	return "s" + strconv.FormatInt(segmentIndex, 10)
+}
+
 // SetAttributionOld tries to add attribution to the bucket.
 func (endpoint *Endpoint) SetAttributionOld(ctx context.Context, req *pb.SetAttributionRequestOld) (_ *pb.SetAttributionResponseOld, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -648,8 +686,14 @@ func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRe
 
 	salt := sha256.Sum256(keyInfo.ProjectID[:])
 
+	policy, err := endpoint.getRedundancyPolicy(ctx, keyInfo.ProjectID, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	return &pb.ProjectInfoResponse{
-		ProjectSalt: salt[:],
+		ProjectSalt:      salt[:],
+		RedundancyPolicy: convertRedundancyPolicyToProto(policy),
 	}, nil
 }
 
@@ -674,8 +718,16 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	policy, err := endpoint.getRedundancyPolicy(ctx, keyInfo.ProjectID, req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	pbBucket := convertBucketToProto(ctx, bucket)
+	pbBucket.RedundancyPolicy = convertRedundancyPolicyToProto(policy)
+
 	return &pb.BucketGetResponse{
-		Bucket: convertBucketToProto(ctx, bucket),
+		Bucket: pbBucket,
 	}, nil
 }
 
@@ -697,7 +749,17 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = endpoint.validateRedundancy(ctx, req.GetDefaultRedundancyScheme())
+	policy, err := endpoint.getRedundancyPolicy(ctx, keyInfo.ProjectID, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the redundancy policy takes precedence over the default scheme of the client
+	redundancy := req.GetDefaultRedundancyScheme()
+	if policy != nil {
+		redundancy = convertRedundancyPolicyToProto(policy)
+	}
+	err = endpoint.validateRedundancyPolicy(ctx, keyInfo.ProjectID, req.Name, redundancy)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -707,6 +769,10 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	if policy != nil {
+		bucket.DefaultRedundancyScheme = policy.RedundancyScheme()
+	}
+
 	bucket, err = endpoint.metainfo.CreateBucket(ctx, bucket)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -853,6 +919,23 @@ func convertBucketToProto(ctx context.Context, bucket storj.Bucket) (pbBucket *p
 			CipherSuite: pb.CipherSuite(int(bucket.DefaultEncryptionParameters.CipherSuite)),
 			BlockSize:   int64(bucket.DefaultEncryptionParameters.BlockSize),
 		},
+		Versioning: pb.BucketVersioning(bucket.Versioning),
+		Lifecycle:  LifecycleToProto(bucket.Lifecycle),
+		Placement:  PlacementToProto(bucket.Placement),
+	}
+}
+
+func convertRedundancyPolicyToProto(policy *console.RedundancyPolicy) *pb.RedundancyScheme {
+	if policy == nil {
+		return nil
+	}
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_RS,
+		MinReq:           int32(policy.RequiredShares),
+		Total:            int32(policy.TotalShares),
+		RepairThreshold:  int32(policy.RepairShares),
+		SuccessThreshold: int32(policy.OptimalShares),
+		ErasureShareSize: int32(policy.ShareSize),
 	}
 }
 
@@ -880,6 +963,14 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	policy, err := endpoint.getRedundancyPolicy(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if policy != nil {
+		bucket.DefaultRedundancyScheme = policy.RedundancyScheme()
+	}
+
 	// take bucket RS values if not set in request
 	pbRS := req.RedundancyScheme
 	if pbRS.ErasureShareSize == 0 {
@@ -898,6 +989,11 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		pbRS.Total = int32(bucket.DefaultRedundancyScheme.TotalShares)
 	}
 
+	err = endpoint.validateRedundancyPolicy(ctx, keyInfo.ProjectID, req.Bucket, pbRS)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	pbEP := req.EncryptionParameters
 	if pbEP.CipherSuite == 0 {
 		pbEP.CipherSuite = pb.CipherSuite(bucket.DefaultEncryptionParameters.CipherSuite)
@@ -1100,3 +1196,247 @@ func (endpoint *Endpoint) FinishDeleteObject(ctx context.Context, req *pb.Object
 
 	return &pb.ObjectFinishDeleteResponse{}, nil
 }
+
+// CopyObject copies an object to a new path. Only the pointers are duplicated,
+// the pieces on the storage nodes are shared between both objects.
+func (endpoint *Endpoint) CopyObject(ctx context.Context, req *pb.ObjectCopyRequest) (resp *pb.ObjectCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.copyObject(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, req.Segments, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectCopyResponse{}, nil
+}
+
+// MoveObject moves an object to a new path without transferring any pieces
+func (endpoint *Endpoint) MoveObject(ctx context.Context, req *pb.ObjectMoveRequest) (resp *pb.ObjectMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.copyObject(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, req.Segments, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectMoveResponse{}, nil
+}
+
+// copyObject duplicates the pointers of all segments under the new path and replaces
+// their metadata with the one re-encrypted by the uplink. When move is set, the
+// pointers of the source object are removed afterwards.
+func (endpoint *Endpoint) copyObject(ctx context.Context, bucket, encryptedPath, newBucket, newEncryptedPath []byte, segments []*pb.SegmentMetadata, move bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        bucket,
+		EncryptedPath: encryptedPath,
+		Time:          now,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	if move {
+		_, err = endpoint.validateAuth(ctx, macaroon.Action{
+			Op:            macaroon.ActionDelete,
+			Bucket:        bucket,
+			EncryptedPath: encryptedPath,
+			Time:          now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	_, err = endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        newBucket,
+		EncryptedPath: newEncryptedPath,
+		Time:          now,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, bucket)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, newBucket)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if bytes.Equal(bucket, newBucket) && bytes.Equal(encryptedPath, newEncryptedPath) {
+		return status.Errorf(codes.InvalidArgument, "source and destination are the same")
+	}
+
+	segments, err = sortCopySegments(segments)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	newLastSegmentPath, err := CreatePath(ctx, keyInfo.ProjectID, -1, newBucket, newEncryptedPath)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = endpoint.metainfo.Get(ctx, newLastSegmentPath)
+	if err == nil {
+		return status.Errorf(codes.AlreadyExists, "destination object already exists")
+	}
+	if !storage.ErrKeyNotFound.Has(err) {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	if !move {
+		exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
+		if err != nil {
+			return status.Errorf(codes.Internal, err.Error())
+		}
+		if exceeded {
+			endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s.",
+				limit, keyInfo.ProjectID,
+			)
+			return status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		}
+	}
+
+	// the satellite lists the segments itself, a partial list would leave
+	// segments behind and make a broken copy
+	sourcePaths, pointers, err := endpoint.getObjectSegments(ctx, keyInfo.ProjectID, bucket, encryptedPath)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return status.Errorf(codes.NotFound, err.Error())
+		}
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if len(pointers) != len(segments) {
+		return status.Errorf(codes.InvalidArgument, "object has %d segments, got metadata for %d", len(pointers), len(segments))
+	}
+
+	// the last segment is stored last, so that the object becomes visible
+	// only after all other segments are in place
+	newPaths := make([]storj.Path, 0, len(segments))
+	for i, segment := range segments {
+		newPath, err := CreatePath(ctx, keyInfo.ProjectID, segment.SegmentIndex, newBucket, newEncryptedPath)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		// the nested fields are left untouched, so a shallow copy is enough
+		newPointer := *pointers[i]
+		newPointer.Metadata = segment.Metadata
+		if !move && newPointer.Type == pb.Pointer_REMOTE {
+			newPointer.PiecesShared = true
+		}
+		if segment.SegmentIndex == -1 {
+			err = endpoint.assignVersionID(ctx, keyInfo.ProjectID, newBucket, newEncryptedPath, &newPointer)
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+		}
+
+		err = endpoint.metainfo.Put(ctx, newPath, &newPointer)
+		if err != nil {
+			// remove the incomplete copy, the source is still untouched
+			for _, path := range newPaths {
+				err = errs.Combine(err, endpoint.metainfo.Delete(ctx, path))
+			}
+			return status.Errorf(codes.Internal, err.Error())
+		}
+		newPaths = append(newPaths, newPath)
+
+		if !move {
+			inlineUsed, remoteUsed := calculateSpaceUsed(&newPointer)
+			if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed, remoteUsed); err != nil {
+				endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", keyInfo.ProjectID, err)
+			}
+		}
+	}
+
+	// the last segment is removed first, so that an interrupted move never
+	// leaves a visible but incomplete source object behind
+	for i := len(pointers) - 1; i >= 0; i-- {
+		pointer := pointers[i]
+		switch {
+		case move:
+			err = endpoint.metainfo.Delete(ctx, sourcePaths[i])
+		case pointer.Type == pb.Pointer_REMOTE && !pointer.PiecesShared:
+			// the source doesn't own the pieces anymore, deleting it must not remove them
+			pointer.PiecesShared = true
+			err = endpoint.metainfo.Put(ctx, sourcePaths[i], pointer)
+		default:
+			continue
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// getObjectSegments loads the pointers of all segments of a committed object
+// and returns them ordered by index with the last segment at the end.
+func (endpoint *Endpoint) getObjectSegments(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (paths []storj.Path, pointers []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	lastPointer, err := endpoint.metainfo.Get(ctx, lastPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the segments before the last one are stored contiguously from index 0
+	for index := int64(0); ; index++ {
+		path, err := CreatePath(ctx, projectID, index, bucket, encryptedPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		pointer, err := endpoint.metainfo.Get(ctx, path)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				break
+			}
+			return nil, nil, err
+		}
+		paths = append(paths, path)
+		pointers = append(pointers, pointer)
+	}
+
+	return append(paths, lastPath), append(pointers, lastPointer), nil
+}
+
+// sortCopySegments verifies that the segments describe a complete object
+// and returns them ordered by index with the last segment at the end.
+func sortCopySegments(segments []*pb.SegmentMetadata) (_ []*pb.SegmentMetadata, err error) {
+	if len(segments) == 0 {
+		return nil, Error.New("no segments")
+	}
+
+	sorted := make([]*pb.SegmentMetadata, len(segments))
+	for _, segment := range segments {
+		index := segment.SegmentIndex
+		if index == -1 {
+			index = int64(len(segments) - 1)
+		}
+		if index < 0 || index >= int64(len(segments)) || sorted[index] != nil {
+			return nil, Error.New("invalid segment index %d", segment.SegmentIndex)
+		}
+		sorted[index] = segment
+	}
+
+	if sorted[len(sorted)-1].SegmentIndex != -1 {
+		return nil, Error.New("missing last segment")
+	}
+
+	return sorted, nil
+}
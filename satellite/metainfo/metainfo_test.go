@@ -7,6 +7,7 @@ import (
 	"context"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -921,3 +923,423 @@ func TestListObjects(t *testing.T) {
 		require.Equal(t, 3, len(items))
 	})
 }
+
+func TestReadSegmentRange(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(50*memory.KiB))
+		require.NoError(t, err)
+
+		items, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 1, 0)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+
+		// the path of the pointer is projectID/segment/bucket/encryptedPath
+		pathElements := strings.SplitN(items[0].GetPath(), "/", 4)
+		require.Len(t, pathElements, 4)
+		encryptedPath := pathElements[3]
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		pointer, fullLimits, _, err := metainfo.ReadSegment(ctx, "testbucket", encryptedPath, -1)
+		require.NoError(t, err)
+		require.Equal(t, pb.Pointer_REMOTE, pointer.GetType())
+
+		redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+		require.NoError(t, err)
+		pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+		for _, limit := range fullLimits {
+			if limit != nil {
+				assert.Equal(t, pieceSize, limit.Limit.Limit)
+			}
+		}
+
+		offset, length := int64(redundancy.StripeSize()+1), int64(100)
+		_, expectedLength := eestream.CalcPieceRange(offset, length, redundancy)
+		require.True(t, expectedLength < pieceSize)
+
+		_, limits, _, err := metainfo.ReadSegmentRange(ctx, "testbucket", encryptedPath, -1, offset, length)
+		require.NoError(t, err)
+		require.Len(t, limits, len(fullLimits))
+		for _, limit := range limits {
+			if limit != nil {
+				assert.Equal(t, expectedLength, limit.Limit.Limit)
+			}
+		}
+
+		for _, r := range []struct{ offset, length int64 }{
+			{-1, 10},
+			{0, 0},
+			{0, pointer.GetSegmentSize() + 1},
+			{pointer.GetSegmentSize(), 1},
+		} {
+			_, _, _, err := metainfo.ReadSegmentRange(ctx, "testbucket", encryptedPath, -1, r.offset, r.length)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(errs.Unwrap(err)), "%+v", r)
+		}
+	})
+}
+
+func TestRedundancyPolicy(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		uplink := planet.Uplinks[0]
+		config := uplink.GetConfig(planet.Satellites[0])
+
+		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		policies := planet.Satellites[0].DB.Console().RedundancyPolicies()
+		projectPolicy, err := policies.Set(ctx, console.RedundancyPolicy{
+			ProjectID:      projectID,
+			RequiredShares: 1,
+			RepairShares:   2,
+			OptimalShares:  3,
+			TotalShares:    4,
+			ShareSize:      256,
+		})
+		require.NoError(t, err)
+		bucketPolicy, err := policies.Set(ctx, console.RedundancyPolicy{
+			ProjectID:      projectID,
+			BucketName:     []byte("policy-bucket"),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      512,
+		})
+		require.NoError(t, err)
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		info, err := metainfo.GetProjectInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, toProto(projectPolicy.RedundancyScheme()), info.RedundancyPolicy)
+
+		// the bucket policy replaces the default redundancy scheme of the client
+		created, err := metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "policy-bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, bucketPolicy.RedundancyScheme(), created.DefaultRedundancyScheme)
+
+		bucket, err := metainfo.GetBucket(ctx, "policy-bucket")
+		require.NoError(t, err)
+		require.NotNil(t, bucket.RedundancyPolicy)
+		require.Equal(t, bucketPolicy.RedundancyScheme(), *bucket.RedundancyPolicy)
+
+		expiration := time.Now().Add(time.Hour)
+
+		_, _, _, err = metainfo.CreateSegment(ctx, "policy-bucket", "path", -1, toProto(projectPolicy.RedundancyScheme()), 1000, expiration)
+		require.Error(t, err)
+		_, _, _, err = metainfo.CreateSegment(ctx, "policy-bucket", "path", -1, toProto(bucketPolicy.RedundancyScheme()), 1000, expiration)
+		require.NoError(t, err)
+
+		// buckets without their own policy use the project policy
+		_, _, _, err = metainfo.CreateSegment(ctx, "other-bucket", "path", -1, toProto(bucketPolicy.RedundancyScheme()), 1000, expiration)
+		require.Error(t, err)
+		_, _, _, err = metainfo.CreateSegment(ctx, "other-bucket", "path", -1, toProto(projectPolicy.RedundancyScheme()), 1000, expiration)
+		require.NoError(t, err)
+
+		_, err = metainfo.BeginObject(ctx, []byte("policy-bucket"), []byte("encrypted-path"), 1,
+			projectPolicy.RedundancyScheme(), storj.EncryptionParameters{}, time.Time{}, testrand.Nonce(), testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+		_, err = metainfo.BeginObject(ctx, []byte("policy-bucket"), []byte("encrypted-path"), 1,
+			storj.RedundancyScheme{}, storj.EncryptionParameters{}, time.Time{}, testrand.Nonce(), testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+	})
+}
+
+func TestRedundancyPolicyMinimum(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.RS.Validate = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+
+		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		_, err = metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "weak-bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+
+		policies := planet.Satellites[0].DB.Console().RedundancyPolicies()
+		expiration := time.Now().Add(time.Hour)
+
+		// policies stored directly in the database can be weaker than the satellite configuration
+		weak, err := policies.Set(ctx, console.RedundancyPolicy{
+			ProjectID:      projectID,
+			BucketName:     []byte("weak-bucket"),
+			RequiredShares: 1,
+			RepairShares:   1,
+			OptimalShares:  1,
+			TotalShares:    1,
+			ShareSize:      256,
+		})
+		require.NoError(t, err)
+		_, _, _, err = metainfo.CreateSegment(ctx, "weak-bucket", "path", -1, toProto(weak.RedundancyScheme()), 1000, expiration)
+		require.Error(t, err)
+		_, err = metainfo.BeginObject(ctx, []byte("weak-bucket"), []byte("encrypted-path"), 1,
+			weak.RedundancyScheme(), storj.EncryptionParameters{}, time.Time{}, testrand.Nonce(), testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+
+		_, err = policies.Set(ctx, console.RedundancyPolicy{
+			ProjectID:      projectID,
+			BucketName:     []byte("other-weak-bucket"),
+			RequiredShares: 1,
+			RepairShares:   1,
+			OptimalShares:  1,
+			TotalShares:    1,
+			ShareSize:      256,
+		})
+		require.NoError(t, err)
+		_, err = metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "other-weak-bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.Error(t, err)
+
+		strong, err := policies.Set(ctx, console.RedundancyPolicy{
+			ProjectID:      projectID,
+			BucketName:     []byte("strong-bucket"),
+			RequiredShares: 1,
+			RepairShares:   2,
+			OptimalShares:  3,
+			TotalShares:    5,
+			ShareSize:      512,
+		})
+		require.NoError(t, err)
+		_, _, _, err = metainfo.CreateSegment(ctx, "strong-bucket", "path", -1, toProto(strong.RedundancyScheme()), 1000, expiration)
+		require.NoError(t, err)
+	})
+}
+
+func TestCopyObjectSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		service := planet.Satellites[0].Metainfo.Service
+
+		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID.String()
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		_, err = metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+
+		// an inline object with three segments
+		for _, segment := range []string{"s0", "s1", "l"} {
+			err = service.Put(ctx, storj.JoinPaths(projectID, segment, "bucket", "source"), &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: []byte("data"),
+				SegmentSize:   4,
+				CreationDate:  time.Now(),
+				Metadata:      []byte(segment),
+			})
+			require.NoError(t, err)
+		}
+
+		partial := []*pb.SegmentMetadata{
+			{SegmentIndex: 0, Metadata: []byte("s0")},
+			{SegmentIndex: -1, Metadata: []byte("l")},
+		}
+		err = metainfo.CopyObject(ctx, []byte("bucket"), []byte("source"), []byte("bucket"), []byte("copy"), partial)
+		require.Error(t, err)
+		err = metainfo.MoveObject(ctx, []byte("bucket"), []byte("source"), []byte("bucket"), []byte("moved"), partial)
+		require.Error(t, err)
+		err = metainfo.RekeyObject(ctx, []byte("bucket"), []byte("source"), []byte("source"), []*pb.SegmentMetadata{
+			{SegmentIndex: 0, Metadata: []byte("rekeyed")},
+			{SegmentIndex: -1, Metadata: []byte("rekeyed")},
+		})
+		require.Error(t, err)
+
+		// the source is left untouched
+		for _, segment := range []string{"s0", "s1", "l"} {
+			pointer, err := service.Get(ctx, storj.JoinPaths(projectID, segment, "bucket", "source"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(segment), pointer.Metadata)
+		}
+
+		complete := append(partial, &pb.SegmentMetadata{SegmentIndex: 1, Metadata: []byte("s1")})
+		err = metainfo.MoveObject(ctx, []byte("bucket"), []byte("source"), []byte("bucket"), []byte("moved"), complete)
+		require.NoError(t, err)
+
+		for _, segment := range []string{"s0", "s1", "l"} {
+			_, err = service.Get(ctx, storj.JoinPaths(projectID, segment, "bucket", "source"))
+			require.Error(t, err)
+			pointer, err := service.Get(ctx, storj.JoinPaths(projectID, segment, "bucket", "moved"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(segment), pointer.Metadata)
+		}
+	})
+}
+
+// toProto converts the redundancy scheme to its protobuf representation
+func toProto(rs storj.RedundancyScheme) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_RS,
+		MinReq:           int32(rs.RequiredShares),
+		RepairThreshold:  int32(rs.RepairShares),
+		SuccessThreshold: int32(rs.OptimalShares),
+		Total:            int32(rs.TotalShares),
+		ErasureShareSize: rs.ShareSize,
+	}
+}
+
+func TestBucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// placement constraints are only supported with a location lookup
+				config.Overlay.GeoIP.Database = "testdata/geoip.csv"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		config := planet.Uplinks[0].GetConfig(satellite)
+
+		for _, node := range planet.StorageNodes {
+			err := satellite.DB.OverlayCache().UpdateLocation(ctx, node.ID(), geoip.Location{CountryCode: "DE", ASN: 3320})
+			require.NoError(t, err)
+		}
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		_, err = metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "placement-bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", &storj.PlacementConstraints{CountryCodes: []string{"USA"}})
+		require.Error(t, err)
+		err = metainfo.SetBucketPlacement(ctx, "missing-bucket", &storj.PlacementConstraints{CountryCodes: []string{"US"}})
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		rs := &pb.RedundancyScheme{
+			MinReq:           1,
+			RepairThreshold:  2,
+			SuccessThreshold: 3,
+			Total:            4,
+			ErasureShareSize: 256,
+		}
+		expiration := time.Now().Add(time.Hour)
+
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", &storj.PlacementConstraints{CountryCodes: []string{"us"}})
+		require.NoError(t, err)
+
+		bucket, err := metainfo.GetBucket(ctx, "placement-bucket")
+		require.NoError(t, err)
+		require.Equal(t, &storj.PlacementConstraints{CountryCodes: []string{"US"}}, bucket.Placement)
+
+		_, _, _, err = metainfo.CreateSegment(ctx, "placement-bucket", "path", -1, rs, 1000, expiration)
+		require.Error(t, err)
+
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", &storj.PlacementConstraints{CountryCodes: []string{"DE"}})
+		require.NoError(t, err)
+
+		_, _, _, err = metainfo.CreateSegment(ctx, "placement-bucket", "path", -1, rs, 1000, expiration)
+		require.NoError(t, err)
+
+		// all nodes are in the same autonomous system
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", &storj.PlacementConstraints{DistinctASN: true})
+		require.NoError(t, err)
+
+		_, _, _, err = metainfo.CreateSegment(ctx, "placement-bucket", "path", -1, rs, 1000, expiration)
+		require.Error(t, err)
+
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", nil)
+		require.NoError(t, err)
+
+		bucket, err = metainfo.GetBucket(ctx, "placement-bucket")
+		require.NoError(t, err)
+		require.Nil(t, bucket.Placement)
+
+		_, _, _, err = metainfo.CreateSegment(ctx, "placement-bucket", "path", -1, rs, 1000, expiration)
+		require.NoError(t, err)
+	})
+}
+
+func TestBucketPlacementWithoutGeoIP(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		config := planet.Uplinks[0].GetConfig(satellite)
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		_, err = metainfo.CreateBucket(ctx, storj.Bucket{
+			Name:                        "placement-bucket",
+			PathCipher:                  config.GetEncryptionParameters().CipherSuite,
+			DefaultRedundancyScheme:     config.GetRedundancyScheme(),
+			DefaultEncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+
+		// all nodes have an unknown location, no node would satisfy the constraints
+		for _, placement := range []*storj.PlacementConstraints{
+			{CountryCodes: []string{"DE"}},
+			{ExcludedCountryCodes: []string{"DE"}},
+			{DistinctASN: true},
+		} {
+			err = metainfo.SetBucketPlacement(ctx, "placement-bucket", placement)
+			require.Error(t, err)
+			require.Equal(t, codes.FailedPrecondition, status.Code(errs.Unwrap(err)))
+		}
+
+		err = metainfo.SetBucketPlacement(ctx, "placement-bucket", nil)
+		require.NoError(t, err)
+	})
+}
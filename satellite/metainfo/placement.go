@@ -0,0 +1,153 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// SetBucketPlacement replaces the placement constraints of a bucket
+func (endpoint *Endpoint) SetBucketPlacement(ctx context.Context, req *pb.BucketSetPlacementRequest) (resp *pb.BucketSetPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	placement := PlacementFromProto(req.Placement)
+	err = validatePlacement(placement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// without a location lookup no node satisfies the constraints
+	if hasConstraints(placement) && !endpoint.cache.LocatesNodes() {
+		return nil, status.Errorf(codes.FailedPrecondition, "the satellite doesn't look up node locations, placement constraints are not supported")
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	bucket.Placement = placement
+	_, err = endpoint.metainfo.UpdateBucket(ctx, bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketSetPlacementResponse{}, nil
+}
+
+// validatePlacement checks that the placement constraints only contain
+// ISO 3166-1 alpha-2 country codes and normalizes them to upper case.
+func validatePlacement(placement *storj.PlacementConstraints) error {
+	if placement == nil {
+		return nil
+	}
+
+	for _, codes := range [][]string{placement.CountryCodes, placement.ExcludedCountryCodes} {
+		for i, code := range codes {
+			code = strings.ToUpper(code)
+			if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+				return Error.New("invalid country code %q", codes[i])
+			}
+			codes[i] = code
+		}
+	}
+
+	for _, code := range placement.CountryCodes {
+		for _, excluded := range placement.ExcludedCountryCodes {
+			if code == excluded {
+				return Error.New("country code %q is both allowed and excluded", code)
+			}
+		}
+	}
+	return nil
+}
+
+// hasConstraints returns whether the placement restricts the selected nodes
+func hasConstraints(placement *storj.PlacementConstraints) bool {
+	return placement != nil &&
+		(len(placement.CountryCodes) > 0 || len(placement.ExcludedCountryCodes) > 0 || placement.DistinctASN)
+}
+
+// getPlacement returns the placement constraints of a bucket, nil if there
+// are none or the bucket doesn't exist.
+func (s *Service) getPlacement(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ *storj.PlacementConstraints, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := s.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return bucket.Placement, nil
+}
+
+// GetPlacement returns the placement constraints of the bucket of the segment
+// at path, nil if there are none.
+func (s *Service) GetPlacement(ctx context.Context, path storj.Path) (_ *storj.PlacementConstraints, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the path of a segment is projectID/segment/bucket/encryptedPath
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+	projectID, err := uuid.Parse(comps[0])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return s.getPlacement(ctx, *projectID, []byte(comps[2]))
+}
+
+// PlacementToProto converts placement constraints to protobuf
+func PlacementToProto(placement *storj.PlacementConstraints) *pb.PlacementConstraints {
+	if placement == nil {
+		return nil
+	}
+	return &pb.PlacementConstraints{
+		CountryCodes:         placement.CountryCodes,
+		ExcludedCountryCodes: placement.ExcludedCountryCodes,
+		DistinctAsn:          placement.DistinctASN,
+	}
+}
+
+// PlacementFromProto converts placement constraints from protobuf
+func PlacementFromProto(placement *pb.PlacementConstraints) *storj.PlacementConstraints {
+	if placement == nil {
+		return nil
+	}
+	return &storj.PlacementConstraints{
+		CountryCodes:         placement.CountryCodes,
+		ExcludedCountryCodes: placement.ExcludedCountryCodes,
+		DistinctASN:          placement.DistinctAsn,
+	}
+}
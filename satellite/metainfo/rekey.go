@@ -0,0 +1,320 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// RekeyObject replaces the metadata of all segments of a version of an object
+// with the one re-encrypted by the uplink under a new root key. When the
+// encrypted path changes, the version is moved to it. Apart from the metadata
+// the segments are kept unchanged, including their version ID and creation
+// date. The pieces on the storage nodes are never touched.
+func (endpoint *Endpoint) RekeyObject(ctx context.Context, req *pb.ObjectRekeyRequest) (resp *pb.ObjectRekeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	move := !bytes.Equal(req.EncryptedPath, req.NewEncryptedPath)
+
+	now := time.Now()
+	actions := []macaroon.Action{{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          now,
+	}}
+	if move {
+		actions = append(actions, macaroon.Action{
+			Op:            macaroon.ActionDelete,
+			Bucket:        req.Bucket,
+			EncryptedPath: req.EncryptedPath,
+			Time:          now,
+		}, macaroon.Action{
+			Op:            macaroon.ActionWrite,
+			Bucket:        req.Bucket,
+			EncryptedPath: req.NewEncryptedPath,
+			Time:          now,
+		})
+	}
+
+	var keyInfo *console.APIKeyInfo
+	for _, action := range actions {
+		keyInfo, err = endpoint.validateAuth(ctx, action)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.EncryptedPath) == 0 || len(req.NewEncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, storj.ErrNoPath.New("").Error())
+	}
+
+	version, err := endpoint.metainfo.getVersionSegments(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, req.VersionId)
+	if err != nil {
+		switch {
+		case storage.ErrKeyNotFound.Has(err):
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case Error.Has(err):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	newPaths, err := version.newPaths(ctx, keyInfo.ProjectID, req.Bucket, req.NewEncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// delete markers have no metadata, they only need to be moved
+	if version.isDeleteMarker {
+		if len(req.Segments) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "delete markers have no segments")
+		}
+		if move {
+			err = endpoint.metainfo.Move(ctx, version.paths[0], newPaths[0])
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+		}
+		return &pb.ObjectRekeyResponse{}, nil
+	}
+
+	segments, err := sortCopySegments(req.Segments)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(version.pointers) != len(segments) {
+		return nil, status.Errorf(codes.InvalidArgument, "object has %d segments, got metadata for %d", len(version.pointers), len(segments))
+	}
+	metadata := make([][]byte, len(segments))
+	for i, segment := range segments {
+		metadata[i] = segment.Metadata
+	}
+
+	if move {
+		err = endpoint.metainfo.moveRekeyedSegments(ctx, version.paths, newPaths, version.pointers, metadata)
+	} else {
+		err = endpoint.metainfo.updateRekeyedSegments(ctx, version.paths, version.pointers, metadata)
+	}
+	if err != nil {
+		if storage.ErrValueChanged.Has(err) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectRekeyResponse{}, nil
+}
+
+// versionSegments are the segments of a version of an object, ordered by index
+// with the last segment at the end
+type versionSegments struct {
+	// versionID is empty for the latest version
+	versionID      string
+	isDeleteMarker bool
+	indexes        []int64
+	paths          []storj.Path
+	pointers       []*pb.Pointer
+}
+
+// newPaths returns the paths of the segments of the version under a different encrypted path
+func (version *versionSegments) newPaths(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (_ []storj.Path, err error) {
+	if version.isDeleteMarker {
+		return []storj.Path{createVersionPath(projectID, bucket, encryptedPath, version.versionID, deleteMarkerSegment)}, nil
+	}
+
+	paths := make([]storj.Path, len(version.indexes))
+	for i, index := range version.indexes {
+		if version.versionID != "" {
+			paths[i] = createVersionPath(projectID, bucket, encryptedPath, version.versionID, segmentName(index))
+			continue
+		}
+		paths[i], err = CreatePath(ctx, projectID, index, bucket, encryptedPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// getVersionSegments returns the segments of a version of an object. The
+// latest version is returned when versionID is empty or its version ID.
+func (s *Service) getVersionSegments(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versionID string) (version versionSegments, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID != "" {
+		if err := validateVersionID(versionID); err != nil {
+			return versionSegments{}, err
+		}
+
+		lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+		if err != nil {
+			return versionSegments{}, err
+		}
+
+		lastPointer, err := s.Get(ctx, lastPath)
+		switch {
+		case err == nil && liveVersionID(lastPointer) == versionID:
+			versionID = ""
+		case err != nil && !storage.ErrKeyNotFound.Has(err):
+			return versionSegments{}, err
+		}
+	}
+
+	version.versionID = versionID
+	if versionID == "" {
+		version.paths, err = s.liveSegmentPaths(ctx, projectID, bucket, encryptedPath)
+		if err != nil {
+			return versionSegments{}, err
+		}
+		if len(version.paths) == 0 {
+			return versionSegments{}, storage.ErrKeyNotFound.New("object not found")
+		}
+		for i := range version.paths[1:] {
+			version.indexes = append(version.indexes, int64(i))
+		}
+		version.indexes = append(version.indexes, -1)
+	} else {
+		archived, err := s.archivedVersionSegments(ctx, projectID, bucket, encryptedPath, versionID)
+		if err != nil {
+			return versionSegments{}, err
+		}
+
+		if path, ok := archived[deleteMarkerSegment]; ok {
+			version.isDeleteMarker = true
+			version.paths = []storj.Path{path}
+			return version, nil
+		}
+		if _, ok := archived["l"]; !ok {
+			return versionSegments{}, storage.ErrKeyNotFound.New("version %q not found", versionID)
+		}
+
+		for index := int64(0); index < int64(len(archived)-1); index++ {
+			version.indexes = append(version.indexes, index)
+		}
+		version.indexes = append(version.indexes, -1)
+
+		for _, index := range version.indexes {
+			path, ok := archived[segmentName(index)]
+			if !ok {
+				return versionSegments{}, Error.New("version %q is missing segment %d", versionID, index)
+			}
+			version.paths = append(version.paths, path)
+		}
+	}
+
+	for _, path := range version.paths {
+		pointer, err := s.Get(ctx, path)
+		if err != nil {
+			return versionSegments{}, err
+		}
+		version.pointers = append(version.pointers, pointer)
+	}
+
+	return version, nil
+}
+
+// updateRekeyedSegments replaces the metadata of the segments in place. The
+// segments are rekeyed all or nothing, because neither key could read an
+// object with segments under both of them.
+func (s *Service) updateRekeyedSegments(ctx context.Context, paths []storj.Path, pointers []*pb.Pointer, metadata [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, path := range paths {
+		err = s.Update(ctx, path, func(pointer *pb.Pointer) error {
+			pointer.Metadata = metadata[i]
+			return nil
+		})
+		if err != nil {
+			for j := i - 1; j >= 0; j-- {
+				original := pointers[j].Metadata
+				err = errs.Combine(err, s.Update(ctx, paths[j], func(pointer *pb.Pointer) error {
+					pointer.Metadata = original
+					return nil
+				}))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// moveRekeyedSegments stores the segments with the new metadata under newPaths
+// and removes them from paths. The last segment is stored last and removed
+// first, so that an interrupted move never leaves a visible but incomplete
+// version behind. A move interrupted after storing the last segment is
+// completed by running it again.
+func (s *Service) moveRekeyedSegments(ctx context.Context, paths, newPaths []storj.Path, pointers []*pb.Pointer, metadata [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	last := len(paths) - 1
+
+	newPointers := make([]*pb.Pointer, len(pointers))
+	newValues := make([][]byte, len(pointers))
+	for i, pointer := range pointers {
+		// the nested fields are left untouched, so a shallow copy is enough
+		newPointer := *pointer
+		newPointer.Metadata = metadata[i]
+		newPointers[i] = &newPointer
+
+		newValues[i], err = proto.Marshal(&newPointer)
+		if err != nil {
+			return err
+		}
+	}
+
+	existing, err := s.Get(ctx, newPaths[last])
+	switch {
+	case storage.ErrKeyNotFound.Has(err):
+		for i, path := range newPaths {
+			err = s.DB.Put(ctx, []byte(path), newValues[i])
+			if err != nil {
+				// remove the incomplete copy, the source is still untouched
+				for _, stored := range newPaths[:i] {
+					err = errs.Combine(err, s.Delete(ctx, stored))
+				}
+				return err
+			}
+		}
+	case err != nil:
+		return err
+	case !sameSegment(existing, newPointers[last]):
+		return storage.ErrValueChanged.New("destination already exists")
+	}
+
+	for i := last; i >= 0; i-- {
+		err = s.Delete(ctx, paths[i])
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameSegment returns whether both pointers describe the same segment
+// regardless of their metadata
+func sameSegment(a, b *pb.Pointer) bool {
+	a, b = proto.Clone(a).(*pb.Pointer), proto.Clone(b).(*pb.Pointer)
+	a.Metadata, b.Metadata = nil, nil
+	return proto.Equal(a, b)
+}
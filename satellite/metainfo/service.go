@@ -70,6 +70,40 @@ func (s *Service) Get(ctx context.Context, path string) (pointer *pb.Pointer, er
 	return pointer, nil
 }
 
+// Update atomically modifies the pointer under specific path with the update function.
+// The update is retried with the current pointer when the pointer was changed concurrently.
+func (s *Service) Update(ctx context.Context, path string, update func(pointer *pb.Pointer) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		oldPointerBytes, err := s.DB.Get(ctx, []byte(path))
+		if err != nil {
+			return err
+		}
+
+		pointer := &pb.Pointer{}
+		err = proto.Unmarshal(oldPointerBytes, pointer)
+		if err != nil {
+			return errs.New("error unmarshaling pointer: %v", err)
+		}
+
+		if err := update(pointer); err != nil {
+			return err
+		}
+
+		newPointerBytes, err := proto.Marshal(pointer)
+		if err != nil {
+			return err
+		}
+
+		err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		return err
+	}
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(ctx context.Context, prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
@@ -158,6 +192,23 @@ func (s *Service) Delete(ctx context.Context, path string) (err error) {
 	return s.DB.Delete(ctx, []byte(path))
 }
 
+// Move moves the pointer stored under path to newPath. The pointer is stored
+// unchanged, so it keeps its creation date.
+func (s *Service) Move(ctx context.Context, path, newPath string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointerBytes, err := s.DB.Get(ctx, []byte(path))
+	if err != nil {
+		return err
+	}
+
+	if err = s.DB.Put(ctx, []byte(newPath), pointerBytes); err != nil {
+		return err
+	}
+
+	return s.DB.Delete(ctx, []byte(path))
+}
+
 // Iterate iterates over items in db
 func (s *Service) Iterate(ctx context.Context, prefix string, first string, recurse bool, reverse bool, f func(context.Context, storage.Iterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -182,6 +233,12 @@ func (s *Service) GetBucket(ctx context.Context, bucketName []byte, projectID uu
 	return s.bucketsDB.GetBucket(ctx, bucketName, projectID)
 }
 
+// UpdateBucket updates an existing bucket in the buckets db
+func (s *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.UpdateBucket(ctx, bucket)
+}
+
 // DeleteBucket deletes a bucket from the bucekts db
 func (s *Service) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -348,6 +349,86 @@ func (endpoint *Endpoint) validateRedundancy(ctx context.Context, redundancy *pb
 	return nil
 }
 
+// getRedundancyPolicy returns the redundancy policy of the bucket, falling back to the policy
+// of the project. It returns nil, when neither of them has a policy.
+func (endpoint *Endpoint) getRedundancyPolicy(ctx context.Context, projectID uuid.UUID, bucket []byte) (_ *console.RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.policies == nil {
+		return nil, nil
+	}
+
+	if len(bucket) > 0 {
+		policy, err := endpoint.policies.Get(ctx, projectID, bucket)
+		if err == nil {
+			return policy, nil
+		}
+		if !console.ErrNoRedundancyPolicy.Has(err) {
+			return nil, err
+		}
+	}
+
+	policy, err := endpoint.policies.Get(ctx, projectID, nil)
+	if err != nil {
+		if console.ErrNoRedundancyPolicy.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// validateRedundancyPolicy checks the redundancy scheme against the policy of the bucket or project,
+// or against the satellite configuration when there is no policy. Policies weaker than the
+// satellite configuration are rejected.
+func (endpoint *Endpoint) validateRedundancyPolicy(ctx context.Context, projectID uuid.UUID, bucket []byte, redundancy *pb.RedundancyScheme) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	policy, err := endpoint.getRedundancyPolicy(ctx, projectID, bucket)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return endpoint.validateRedundancy(ctx, redundancy)
+	}
+
+	// the satellite configuration is a floor under every policy, also
+	// under policies stored before it was raised
+	if err := policy.Validate(endpoint.rsConfig.MinimumRedundancy()); err != nil {
+		return Error.New("redundancy policy is weaker than the satellite allows: %v", err)
+	}
+
+	return validateRedundancyPolicy(policy, redundancy)
+}
+
+func validateRedundancyPolicy(policy *console.RedundancyPolicy, redundancy *pb.RedundancyScheme) error {
+	if redundancy == nil {
+		return Error.New("no redundancy scheme specified")
+	}
+
+	if policy.ShareSize != int(redundancy.ErasureShareSize) ||
+		policy.TotalShares != int(redundancy.Total) ||
+		policy.RequiredShares != int(redundancy.MinReq) ||
+		policy.RepairShares != int(redundancy.RepairThreshold) ||
+		policy.OptimalShares != int(redundancy.SuccessThreshold) {
+		return Error.New("redundancy scheme parameters not allowed by policy: want [%d, %d, %d, %d, %d] got [%d, %d, %d, %d, %d]",
+			policy.RequiredShares,
+			policy.RepairShares,
+			policy.OptimalShares,
+			policy.TotalShares,
+			policy.ShareSize,
+
+			redundancy.MinReq,
+			redundancy.RepairThreshold,
+			redundancy.SuccessThreshold,
+			redundancy.Total,
+			redundancy.ErasureShareSize,
+		)
+	}
+
+	return nil
+}
+
 func (endpoint *Endpoint) validatePieceHash(ctx context.Context, piece *pb.RemotePiece, limits []*pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -0,0 +1,796 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// Older versions of objects are kept in a separate part of the pointer
+// database, next to the live segments:
+//
+//	<project id>/v/<bucket>/<encrypted path>/<version id>/<segment>
+//
+// where segment is either the name of the segment of the archived object
+// or deleteMarkerSegment for delete markers.
+const (
+	versionsElement     = "v"
+	deleteMarkerSegment = "d"
+)
+
+// createVersionPath creates the path of a segment of an archived object version
+func createVersionPath(projectID uuid.UUID, bucket, path []byte, versionID, segment string) storj.Path {
+	return storj.JoinPaths(projectID.String(), versionsElement, string(bucket), string(path), versionID, segment)
+}
+
+// createVersionPrefix creates the prefix of the archived versions of all objects below path
+func createVersionPrefix(projectID uuid.UUID, bucket, path []byte) storj.Path {
+	entries := []string{projectID.String(), versionsElement, string(bucket)}
+	if len(path) != 0 {
+		entries = append(entries, string(path))
+	}
+	prefix := storj.JoinPaths(entries...)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// splitVersionKey splits the key of an archived segment, relative to the
+// versions prefix of the bucket, into the encrypted path, version ID and segment.
+func splitVersionKey(key string) (path, versionID, segment string, ok bool) {
+	i := strings.LastIndexByte(key, '/')
+	if i < 0 {
+		return "", "", "", false
+	}
+	segment = key[i+1:]
+	key = key[:i]
+
+	i = strings.LastIndexByte(key, '/')
+	if i < 0 {
+		return "", key, segment, true
+	}
+	return key[:i], key[i+1:], segment, true
+}
+
+// newVersionID returns a new version ID. Version IDs created later sort first.
+func newVersionID(now time.Time) (string, error) {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(math.MaxInt64-now.UnixNano()))
+	if _, err := rand.Read(id[8:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return hex.EncodeToString(id[:]), nil
+}
+
+// validateVersionID checks that the version ID can be used as a path element
+func validateVersionID(versionID string) error {
+	if versionID == storj.NullVersionID {
+		return nil
+	}
+	id, err := hex.DecodeString(versionID)
+	if err != nil || len(id) != 16 {
+		return Error.New("invalid version ID %q", versionID)
+	}
+	return nil
+}
+
+// liveVersionID returns the version ID of the object with the given last segment
+func liveVersionID(pointer *pb.Pointer) string {
+	if pointer.VersionId == "" {
+		return storj.NullVersionID
+	}
+	return pointer.VersionId
+}
+
+// SetBucketVersioning changes the versioning state of a bucket
+func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *pb.BucketSetVersioningRequest) (resp *pb.BucketSetVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	versioning := storj.BucketVersioning(req.Versioning)
+	switch versioning {
+	case storj.VersioningEnabled, storj.VersioningSuspended:
+	case storj.VersioningUnversioned:
+		// the versions of objects would become unreachable
+		if bucket.Versioning != storj.VersioningUnversioned {
+			return nil, status.Errorf(codes.FailedPrecondition, "versioning can only be suspended once it was enabled")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid versioning state %d", req.Versioning)
+	}
+
+	bucket.Versioning = versioning
+	_, err = endpoint.metainfo.UpdateBucket(ctx, bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketSetVersioningResponse{}, nil
+}
+
+// assignVersionID sets the version ID of a new object according to the
+// versioning state of its bucket. pointer is the last segment of the object.
+func (endpoint *Endpoint) assignVersionID(ctx context.Context, projectID uuid.UUID, bucketName, path []byte, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the uplink can't choose the version ID
+	pointer.VersionId = ""
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			// segments can be stored without a bucket, they aren't versioned
+			return nil
+		}
+		return err
+	}
+
+	switch bucket.Versioning {
+	case storj.VersioningEnabled:
+		pointer.VersionId, err = newVersionID(time.Now())
+		return err
+	case storj.VersioningSuspended:
+		// the new object replaces the null version
+		_, err = endpoint.metainfo.deleteArchivedVersion(ctx, projectID, bucketName, path, storj.NullVersionID)
+		return err
+	}
+	return nil
+}
+
+// archiveSegment keeps the segment stored under path as part of an older version of
+// the object, when the versioning state of the bucket requires it. It returns false,
+// when the segment has to be deleted instead. The pieces of archived segments are
+// kept on the storage nodes.
+func (endpoint *Endpoint) archiveSegment(ctx context.Context, projectID uuid.UUID, bucketName, encryptedPath []byte, segmentIndex int64, path storj.Path, pointer *pb.Pointer) (archived bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if bucket.Versioning == storj.VersioningUnversioned {
+		return false, nil
+	}
+
+	// the version ID is kept on the last segment, which is deleted last
+	lastPointer := pointer
+	if segmentIndex != -1 {
+		lastPath, err := CreatePath(ctx, projectID, -1, bucketName, encryptedPath)
+		if err != nil {
+			return false, err
+		}
+		lastPointer, err = endpoint.metainfo.Get(ctx, lastPath)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				// the segment doesn't belong to a committed object
+				return false, nil
+			}
+			return false, err
+		}
+	}
+
+	versionID := liveVersionID(lastPointer)
+	if versionID == storj.NullVersionID && bucket.Versioning == storj.VersioningSuspended {
+		// the null version isn't kept while versioning is suspended
+		return false, nil
+	}
+
+	versionPath := createVersionPath(projectID, bucketName, encryptedPath, versionID, segmentName(segmentIndex))
+	return true, endpoint.metainfo.Move(ctx, path, versionPath)
+}
+
+// resolveSegmentPath returns the path of a segment of the requested version of the
+// object. The latest version is used when versionID is empty.
+func (endpoint *Endpoint) resolveSegmentPath(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, segmentIndex int64, versionID string) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err := CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+	if err != nil || versionID == "" {
+		return path, err
+	}
+
+	if err := validateVersionID(versionID); err != nil {
+		return "", err
+	}
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return "", err
+	}
+
+	lastPointer, err := endpoint.metainfo.Get(ctx, lastPath)
+	if err == nil && liveVersionID(lastPointer) == versionID {
+		return path, nil
+	}
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return "", err
+	}
+
+	return createVersionPath(projectID, bucket, encryptedPath, versionID, segmentName(segmentIndex)), nil
+}
+
+// objectVersion is an archived version of an object or a delete marker
+type objectVersion struct {
+	versionID      string
+	isDeleteMarker bool
+	pointer        *pb.Pointer
+}
+
+// sortVersions orders the versions newest first
+func sortVersions(versions []objectVersion) {
+	sort.SliceStable(versions, func(i, k int) bool {
+		a, b := versions[i].pointer.CreationDate, versions[k].pointer.CreationDate
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return versions[i].versionID < versions[k].versionID
+	})
+}
+
+// ListObjectVersions lists all versions of the objects below a prefix, including
+// the delete markers. The listing is always recursive.
+func (endpoint *Endpoint) ListObjectVersions(ctx context.Context, req *pb.ObjectListVersionsRequest) (resp *pb.ObjectListVersionsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPrefix,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+	cursor := string(req.EncryptedCursor)
+
+	livePrefix, err := CreatePath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if !strings.HasSuffix(livePrefix, "/") {
+		livePrefix += "/"
+	}
+
+	liveItems, liveMore, err := storage.ListV2(ctx, endpoint.metainfo.DB, storage.ListOptions{
+		Prefix:       storage.Key(livePrefix),
+		StartAfter:   storage.Key(cursor),
+		Recursive:    true,
+		Limit:        limit,
+		IncludeValue: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	live := make(map[string]*pb.Pointer, len(liveItems))
+	paths := make([]string, 0, len(liveItems))
+	for _, item := range liveItems {
+		pointer := &pb.Pointer{}
+		if err := proto.Unmarshal(item.Value, pointer); err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		live[item.Key.String()] = pointer
+		paths = append(paths, item.Key.String())
+	}
+
+	versionPrefix := createVersionPrefix(keyInfo.ProjectID, req.Bucket, req.EncryptedPrefix)
+	archived := make(map[string][]objectVersion)
+	var archivedPaths []string
+	archivedMore := false
+
+	err = endpoint.metainfo.Iterate(ctx, versionPrefix, versionPrefix+cursor, true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				path, versionID, segment, ok := splitVersionKey(string(item.Key[len(versionPrefix):]))
+				if !ok || path == "" || path <= cursor {
+					continue
+				}
+				if segment != "l" && segment != deleteMarkerSegment {
+					continue
+				}
+
+				if _, found := archived[path]; !found {
+					if len(archivedPaths) == limit {
+						archivedMore = true
+						return nil
+					}
+					archivedPaths = append(archivedPaths, path)
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+				archived[path] = append(archived[path], objectVersion{
+					versionID:      versionID,
+					isDeleteMarker: segment == deleteMarkerSegment,
+					pointer:        pointer,
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the objects after the last listed object of a truncated source may
+	// still have versions in the other source, they belong to the next page
+	var bound string
+	bounded := false
+	if liveMore && len(paths) > 0 {
+		bound, bounded = paths[len(paths)-1], true
+	}
+	if archivedMore && len(archivedPaths) > 0 {
+		last := archivedPaths[len(archivedPaths)-1]
+		if !bounded || last < bound {
+			bound, bounded = last, true
+		}
+	}
+
+	for _, path := range archivedPaths {
+		if _, found := live[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	more := liveMore || archivedMore
+	var items []*pb.ObjectVersion
+	for i, path := range paths {
+		if i == limit || (bounded && path > bound) {
+			more = true
+			break
+		}
+
+		versions := archived[path]
+		sortVersions(versions)
+
+		if pointer, found := live[path]; found {
+			items = append(items, &pb.ObjectVersion{
+				EncryptedPath: []byte(path),
+				VersionId:     liveVersionID(pointer),
+				IsLatest:      true,
+				CreatedAt:     pointer.CreationDate,
+				Pointer:       pointer,
+			})
+		}
+
+		for k, version := range versions {
+			item := &pb.ObjectVersion{
+				EncryptedPath:  []byte(path),
+				VersionId:      version.versionID,
+				IsLatest:       k == 0 && live[path] == nil,
+				IsDeleteMarker: version.isDeleteMarker,
+				CreatedAt:      version.pointer.CreationDate,
+			}
+			if !version.isDeleteMarker {
+				item.Pointer = version.pointer
+			}
+			items = append(items, item)
+		}
+	}
+
+	return &pb.ObjectListVersionsResponse{Items: items, More: more}, nil
+}
+
+// DeleteObjectVersion permanently deletes a version of an object or a delete marker.
+// When the latest version is deleted, the previous version becomes the latest one.
+// The pieces of the deleted version are removed by garbage collection.
+func (endpoint *Endpoint) DeleteObjectVersion(ctx context.Context, req *pb.ObjectDeleteVersionRequest) (resp *pb.ObjectDeleteVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.EncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, storj.ErrNoPath.New("").Error())
+	}
+
+	err = validateVersionID(req.VersionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	lastPath, err := CreatePath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var deleted bool
+	lastPointer, err := endpoint.metainfo.Get(ctx, lastPath)
+	switch {
+	case err == nil && liveVersionID(lastPointer) == req.VersionId:
+		deleted, err = endpoint.metainfo.deleteLiveObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	case err == nil || storage.ErrKeyNotFound.Has(err):
+		deleted, err = endpoint.metainfo.deleteArchivedVersion(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, req.VersionId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "version %q not found", req.VersionId)
+	}
+
+	err = endpoint.metainfo.promoteLatestVersion(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectDeleteVersionResponse{}, nil
+}
+
+// PutDeleteMarker deletes the latest version of an object in a bucket with versioning.
+// The object is archived and a delete marker becomes its latest version.
+func (endpoint *Endpoint) PutDeleteMarker(ctx context.Context, req *pb.ObjectPutDeleteMarkerRequest) (resp *pb.ObjectPutDeleteMarkerResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.EncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, storj.ErrNoPath.New("").Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if bucket.Versioning == storj.VersioningUnversioned {
+		return nil, status.Errorf(codes.FailedPrecondition, "versioning is not enabled for the bucket")
+	}
+
+	versionID, err := endpoint.metainfo.PutDeleteMarker(ctx, bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectPutDeleteMarkerResponse{VersionId: versionID}, nil
+}
+
+// PutDeleteMarker archives the latest version of an object in a bucket with
+// versioning and adds a delete marker as its latest version. It returns the
+// version ID of the delete marker.
+func (s *Service) PutDeleteMarker(ctx context.Context, bucket storj.Bucket, encryptedPath []byte) (versionID string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName := []byte(bucket.Name)
+	lastPath, err := CreatePath(ctx, bucket.ProjectID, -1, bucketName, encryptedPath)
+	if err != nil {
+		return "", err
+	}
+
+	lastPointer, err := s.Get(ctx, lastPath)
+	switch {
+	case storage.ErrKeyNotFound.Has(err):
+		// there is no object to archive, only the delete marker is added
+		err = nil
+	case err != nil:
+		return "", err
+	case liveVersionID(lastPointer) == storj.NullVersionID && bucket.Versioning == storj.VersioningSuspended:
+		_, err = s.deleteLiveObject(ctx, bucket.ProjectID, bucketName, encryptedPath)
+	default:
+		err = s.archiveLiveObject(ctx, bucket.ProjectID, bucketName, encryptedPath, liveVersionID(lastPointer))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	versionID = storj.NullVersionID
+	if bucket.Versioning == storj.VersioningEnabled {
+		versionID, err = newVersionID(time.Now())
+	} else {
+		// the delete marker replaces the null version
+		_, err = s.deleteArchivedVersion(ctx, bucket.ProjectID, bucketName, encryptedPath, storj.NullVersionID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	marker := &pb.Pointer{
+		Type:      pb.Pointer_INLINE,
+		VersionId: versionID,
+	}
+	err = s.Put(ctx, createVersionPath(bucket.ProjectID, bucketName, encryptedPath, versionID, deleteMarkerSegment), marker)
+	if err != nil {
+		return "", err
+	}
+	return versionID, nil
+}
+
+// DeleteObject deletes the latest version of an object. In a bucket with
+// versioning the object is archived and a delete marker is added instead.
+func (s *Service) DeleteObject(ctx context.Context, bucket storj.Bucket, encryptedPath []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket.Versioning != storj.VersioningUnversioned {
+		_, err = s.PutDeleteMarker(ctx, bucket, encryptedPath)
+		return err
+	}
+	_, err = s.deleteLiveObject(ctx, bucket.ProjectID, []byte(bucket.Name), encryptedPath)
+	return err
+}
+
+// liveSegmentPaths returns the paths of all segments of the latest version of an
+// object, with the last segment at the end. It returns nil, if there is no such object.
+func (s *Service) liveSegmentPaths(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (_ []storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.Get(ctx, lastPath)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var paths []storj.Path
+	for index := int64(0); ; index++ {
+		path, err := CreatePath(ctx, projectID, index, bucket, encryptedPath)
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.Get(ctx, path)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return append(paths, lastPath), nil
+}
+
+// archiveLiveObject moves all segments of the latest version of an object to the archived versions
+func (s *Service) archiveLiveObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	paths, err := s.liveSegmentPaths(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return err
+	}
+
+	// the last segment is moved first, so that an interrupted move never
+	// leaves a listed but incomplete latest version behind
+	for i := len(paths) - 1; i >= 0; i-- {
+		index := int64(i)
+		if i == len(paths)-1 {
+			index = -1
+		}
+		err = s.Move(ctx, paths[i], createVersionPath(projectID, bucket, encryptedPath, versionID, segmentName(index)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteLiveObject deletes all segments of the latest version of an object
+func (s *Service) deleteLiveObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	paths, err := s.liveSegmentPaths(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return false, err
+	}
+
+	// the last segment is deleted first, so that an interrupted delete never
+	// leaves a listed but incomplete object behind
+	for i := len(paths) - 1; i >= 0; i-- {
+		err = s.Delete(ctx, paths[i])
+		if err != nil {
+			return false, err
+		}
+	}
+	return len(paths) > 0, nil
+}
+
+// archivedVersionSegments returns the keys of the segments of an archived version
+// keyed by their segment name
+func (s *Service) archivedVersionSegments(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versionID string) (_ map[string]storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := createVersionPath(projectID, bucket, encryptedPath, versionID, "")
+	segments := make(map[string]storj.Path)
+	err = s.Iterate(ctx, prefix, "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				segments[string(item.Key[len(prefix):])] = item.Key.String()
+			}
+			return nil
+		})
+	return segments, err
+}
+
+// deleteArchivedVersion deletes all segments of an archived version or a delete marker
+func (s *Service) deleteArchivedVersion(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versionID string) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments, err := s.archivedVersionSegments(ctx, projectID, bucket, encryptedPath, versionID)
+	if err != nil {
+		return false, err
+	}
+
+	// the segments listing the version are deleted first, so that an
+	// interrupted delete never leaves a listed but incomplete version behind
+	for _, name := range []string{"l", deleteMarkerSegment} {
+		if path, ok := segments[name]; ok {
+			if err := s.Delete(ctx, path); err != nil {
+				return false, err
+			}
+		}
+	}
+	for name, path := range segments {
+		if name == "l" || name == deleteMarkerSegment {
+			continue
+		}
+		err = s.Delete(ctx, path)
+		if err != nil {
+			return false, err
+		}
+	}
+	return len(segments) > 0, nil
+}
+
+// promoteLatestVersion makes the newest archived version of an object its latest
+// version, when the object has no latest version and the newest archived version
+// isn't a delete marker.
+func (s *Service) promoteLatestVersion(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	paths, err := s.liveSegmentPaths(ctx, projectID, bucket, encryptedPath)
+	if err != nil || len(paths) > 0 {
+		return err
+	}
+
+	prefix := createVersionPrefix(projectID, bucket, encryptedPath)
+	var versions []objectVersion
+	err = s.Iterate(ctx, prefix, "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				// the versions of objects below the path have more elements
+				path, versionID, segment, ok := splitVersionKey(string(item.Key[len(prefix):]))
+				if !ok || path != "" || (segment != "l" && segment != deleteMarkerSegment) {
+					continue
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+				versions = append(versions, objectVersion{
+					versionID:      versionID,
+					isDeleteMarker: segment == deleteMarkerSegment,
+					pointer:        pointer,
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	sortVersions(versions)
+	if len(versions) == 0 || versions[0].isDeleteMarker {
+		return nil
+	}
+
+	segments, err := s.archivedVersionSegments(ctx, projectID, bucket, encryptedPath, versions[0].versionID)
+	if err != nil {
+		return err
+	}
+
+	// the last segment is moved last, so that the object is complete once it's visible
+	for name, versionPath := range segments {
+		if name == "l" {
+			continue
+		}
+		index, err := strconv.ParseInt(strings.TrimPrefix(name, "s"), 10, 64)
+		if err != nil {
+			return Error.New("invalid segment name %q", name)
+		}
+		path, err := CreatePath(ctx, projectID, index, bucket, encryptedPath)
+		if err != nil {
+			return err
+		}
+		err = s.Move(ctx, versionPath, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return err
+	}
+	return s.Move(ctx, segments["l"], lastPath)
+}
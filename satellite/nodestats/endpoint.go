@@ -53,29 +53,24 @@ func (e *Endpoint) GetStats(ctx context.Context, req *pb.GetStatsRequest) (_ *pb
 		return nil, NodeStatsEndpointErr.Wrap(err)
 	}
 
-	uptimeScore := calculateReputationScore(
-		node.Reputation.UptimeReputationAlpha,
-		node.Reputation.UptimeReputationBeta)
-
-	auditScore := calculateReputationScore(
-		node.Reputation.AuditReputationAlpha,
-		node.Reputation.AuditReputationBeta)
-
 	return &pb.GetStatsResponse{
 		UptimeCheck: &pb.ReputationStats{
 			TotalCount:      node.Reputation.UptimeCount,
 			SuccessCount:    node.Reputation.UptimeSuccessCount,
 			ReputationAlpha: node.Reputation.UptimeReputationAlpha,
 			ReputationBeta:  node.Reputation.UptimeReputationBeta,
-			ReputationScore: uptimeScore,
+			ReputationScore: node.Reputation.UptimeReputationScore(),
 		},
 		AuditCheck: &pb.ReputationStats{
 			TotalCount:      node.Reputation.AuditCount,
 			SuccessCount:    node.Reputation.AuditSuccessCount,
 			ReputationAlpha: node.Reputation.AuditReputationAlpha,
 			ReputationBeta:  node.Reputation.AuditReputationBeta,
-			ReputationScore: auditScore,
+			ReputationScore: node.Reputation.AuditReputationScore(),
 		},
+		Disqualified:           node.Disqualified,
+		DisqualificationReason: node.DisqualificationReason,
+		Suspended:              node.Suspended,
 	}, nil
 }
 
@@ -117,8 +112,3 @@ func toPBDailyStorageUsage(usages []accounting.NodeSpaceUsage) []*pb.DailyStorag
 
 	return pbUsages
 }
-
-// calculateReputationScore is helper method to calculate reputation score value
-func calculateReputationScore(alpha, beta float64) float64 {
-	return alpha / (alpha + beta)
-}
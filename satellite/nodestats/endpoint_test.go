@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestGetStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+		node.Contact.Chore.Loop.Pause()
+
+		_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       node.ID(),
+			AuditSuccess: false,
+			IsUp:         true,
+		})
+		require.NoError(t, err)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+
+		stats, err := node.NodeStats.Service.GetStatsFromSatellite(ctx, satellite.ID())
+		require.NoError(t, err)
+
+		// the node sees the same reputation the satellite uses for disqualification
+		require.Equal(t, dossier.Reputation.AuditCount, stats.AuditCheck.TotalCount)
+		require.Equal(t, dossier.Reputation.AuditSuccessCount, stats.AuditCheck.SuccessCount)
+		require.Equal(t, dossier.Reputation.AuditReputationAlpha, stats.AuditCheck.ReputationAlpha)
+		require.Equal(t, dossier.Reputation.AuditReputationBeta, stats.AuditCheck.ReputationBeta)
+		require.Equal(t, dossier.Reputation.AuditReputationScore(), stats.AuditCheck.ReputationScore)
+		require.True(t, stats.AuditCheck.ReputationScore < 1)
+
+		require.Equal(t, dossier.Reputation.UptimeCount, stats.UptimeCheck.TotalCount)
+		require.Equal(t, dossier.Reputation.UptimeSuccessCount, stats.UptimeCheck.SuccessCount)
+		require.NotZero(t, stats.UptimeCheck.SuccessCount)
+		require.Equal(t, dossier.Reputation.UptimeReputationAlpha, stats.UptimeCheck.ReputationAlpha)
+		require.Equal(t, dossier.Reputation.UptimeReputationBeta, stats.UptimeCheck.ReputationBeta)
+		require.Equal(t, dossier.Reputation.UptimeReputationScore(), stats.UptimeCheck.ReputationScore)
+	})
+}
+
+func TestGetStatsDisqualified(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+		node.Contact.Chore.Loop.Pause()
+
+		stats, err := node.NodeStats.Service.GetStatsFromSatellite(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.Nil(t, stats.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_UNKNOWN, stats.DisqualificationReason)
+
+		err = satellite.Overlay.Service.DisqualifyNode(ctx, node.ID(), pb.DisqualificationReason_OPERATOR)
+		require.NoError(t, err)
+
+		stats, err = node.NodeStats.Service.GetStatsFromSatellite(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.NotNil(t, stats.Disqualified)
+		require.Equal(t, pb.DisqualificationReason_OPERATOR, stats.DisqualificationReason)
+	})
+}
+
+func TestGetStatsSuspended(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+		node.Contact.Chore.Loop.Pause()
+
+		stats, err := node.NodeStats.Service.GetStatsFromSatellite(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.Nil(t, stats.Suspended)
+
+		// a failed audit drops the score below a suspension cut-off of 1
+		_, err = satellite.DB.OverlayCache().UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       node.ID(),
+			IsUp:         true,
+			AuditSuccess: false,
+			AuditLambda:  1,
+			AuditWeight:  1,
+			UptimeLambda: 1,
+			UptimeWeight: 1,
+			Suspension: overlay.SuspensionConfig{
+				AuditReputation: 1,
+				GracePeriod:     time.Hour,
+			},
+		})
+		require.NoError(t, err)
+
+		stats, err = node.NodeStats.Service.GetStatsFromSatellite(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.NotNil(t, stats.Suspended)
+		require.Nil(t, stats.Disqualified)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/overlay"
@@ -31,6 +32,7 @@ type Service struct {
 	satellite                           signing.Signer
 	cache                               *overlay.Cache
 	orders                              DB
+	projectUsage                        *accounting.ProjectUsage
 	satelliteAddress                    *pb.NodeAddress
 	orderExpiration                     time.Duration
 	repairMaxExcessRateOptimalThreshold float64
@@ -39,14 +41,15 @@ type Service struct {
 // NewService creates new service for creating order limits.
 func NewService(
 	log *zap.Logger, satellite signing.Signer, cache *overlay.Cache,
-	orders DB, orderExpiration time.Duration, satelliteAddress *pb.NodeAddress,
-	repairMaxExcessRateOptimalThreshold float64,
+	orders DB, projectUsage *accounting.ProjectUsage, orderExpiration time.Duration,
+	satelliteAddress *pb.NodeAddress, repairMaxExcessRateOptimalThreshold float64,
 ) *Service {
 	return &Service{
 		log:                                 log,
 		satellite:                           satellite,
 		cache:                               cache,
 		orders:                              orders,
+		projectUsage:                        projectUsage,
 		satelliteAddress:                    satelliteAddress,
 		orderExpiration:                     orderExpiration,
 		repairMaxExcessRateOptimalThreshold: repairMaxExcessRateOptimalThreshold,
@@ -121,26 +124,59 @@ func (service *Service) updateBandwidth(ctx context.Context, projectID uuid.UUID
 func (service *Service) CreateGetOrderLimits(ctx context.Context, bucketID []byte, pointer *pb.Pointer) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	rootPieceID := pointer.GetRemote().RootPieceId
-	pieceExpiration := pointer.ExpirationDate
-	orderExpiration := time.Now().Add(service.orderExpiration)
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
 
-	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+	return service.createGetOrderLimits(ctx, bucketID, pointer, redundancy, pieceSize)
+}
+
+// CreateGetOrderLimitsForRange creates the order limits for downloading the
+// range of the segment of pointer. The limits only allow downloading the
+// erasure shares of the stripes intersecting the range from every piece.
+func (service *Service) CreateGetOrderLimitsForRange(ctx context.Context, bucketID []byte, pointer *pb.Pointer, offset, length int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if offset < 0 || length <= 0 || offset+length > pointer.GetSegmentSize() {
+		return nil, storj.PiecePrivateKey{}, Error.New("invalid range offset %d, length %d of segment with size %d", offset, length, pointer.GetSegmentSize())
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	serialNumber, err := service.createSerial(ctx)
+	_, pieceLength := eestream.CalcPieceRange(offset, length, redundancy)
+	return service.createGetOrderLimits(ctx, bucketID, pointer, redundancy, pieceLength)
+}
+
+// createGetOrderLimits creates the order limits for downloading pieceSize bytes from every piece of pointer.
+func (service *Service) createGetOrderLimits(ctx context.Context, bucketID []byte, pointer *pb.Pointer, redundancy eestream.RedundancyStrategy, pieceSize int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := SplitBucketID(bucketID)
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	if err := service.checkBandwidthLimit(ctx, *projectID, bucketID); err != nil {
+		return nil, storj.PiecePrivateKey{}, err
+	}
 
-	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	rootPieceID := pointer.GetRemote().RootPieceId
+	pieceExpiration := pointer.ExpirationDate
+	orderExpiration := time.Now().Add(service.orderExpiration)
+
+	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
 
 	var combinedErrs error
 	var limits []*pb.AddressedOrderLimit
@@ -197,10 +233,6 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucketID []byt
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	projectID, bucketName, err := SplitBucketID(bucketID)
-	if err != nil {
-		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
-	}
 	if err := service.updateBandwidth(ctx, *projectID, bucketName, limits...); err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
@@ -212,6 +244,14 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucketID []byt
 func (service *Service) CreatePutOrderLimits(ctx context.Context, bucketID []byte, nodes []*pb.Node, expiration time.Time, maxPieceSize int64) (_ storj.PieceID, _ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	if err := service.checkStorageLimit(ctx, *projectID); err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, err
+	}
+
 	orderExpiration := time.Now().Add(service.orderExpiration)
 
 	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
@@ -257,10 +297,6 @@ func (service *Service) CreatePutOrderLimits(ctx context.Context, bucketID []byt
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	projectID, bucketName, err := SplitBucketID(bucketID)
-	if err != nil {
-		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
-	}
 	if err := service.updateBandwidth(ctx, *projectID, bucketName, limits...); err != nil {
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
@@ -504,6 +540,73 @@ func (service *Service) CreateAuditOrderLimit(ctx context.Context, bucketID []by
 	return limit, piecePrivateKey, nil
 }
 
+// CreateGracefulExitPutOrderLimit creates an order limit for uploading a piece of an exiting node to a new node.
+func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, bucketID []byte, nodeID storj.NodeID, pieceNum int32, rootPieceID storj.PieceID, pieceSize int64, pieceExpiration time.Time) (limit *pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	orderExpiration := time.Now().Add(service.orderExpiration)
+
+	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	node, err := service.cache.Get(ctx, nodeID)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	if node.Disqualified != nil {
+		return nil, storj.PiecePrivateKey{}, overlay.ErrNodeDisqualified.New(nodeID.String())
+	}
+
+	if !service.cache.IsOnline(node) {
+		return nil, storj.PiecePrivateKey{}, overlay.ErrNodeOffline.New(nodeID.String())
+	}
+
+	orderLimit, err := signing.SignOrderLimit(ctx, service.satellite, &pb.OrderLimit{
+		SerialNumber:     serialNumber,
+		SatelliteId:      service.satellite.ID(),
+		SatelliteAddress: service.satelliteAddress,
+		UplinkPublicKey:  piecePublicKey,
+		StorageNodeId:    nodeID,
+		PieceId:          rootPieceID.Derive(nodeID, pieceNum),
+		Action:           pb.PieceAction_PUT_REPAIR,
+		Limit:            pieceSize,
+		PieceExpiration:  pieceExpiration,
+		OrderCreation:    time.Now(),
+		OrderExpiration:  orderExpiration,
+	})
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	limit = &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: node.Address,
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpiration)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return limit, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	if err := service.updateBandwidth(ctx, *projectID, bucketName, limit); err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	return limit, piecePrivateKey, nil
+}
+
 // CreateGetRepairOrderLimits creates the order limits for downloading the
 // healthy pieces of pointer as the source for repair.
 //
@@ -707,6 +810,9 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, projectID uuid.UUID, bucketName []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	if err := service.checkBandwidthLimit(ctx, projectID, []byte(storj.JoinPaths(projectID.String(), string(bucketName)))); err != nil {
+		return err
+	}
 	now := time.Now().UTC()
 	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
 
@@ -722,6 +828,40 @@ func (service *Service) UpdatePutInlineOrder(ctx context.Context, projectID uuid
 	return service.orders.UpdateBucketBandwidthInline(ctx, projectID, bucketName, pb.PieceAction_PUT, amount, intervalStart)
 }
 
+// checkBandwidthLimit returns an ErrProjectLimitExceeded error when the
+// project exceeded its monthly bandwidth limit.
+func (service *Service) checkBandwidthLimit(ctx context.Context, projectID uuid.UUID, bucketID []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := service.projectUsage.ExceedsBandwidthUsage(ctx, projectID, bucketID)
+	if err != nil {
+		service.log.Error("retrieving project bandwidth total", zap.Error(err))
+		return nil
+	}
+	if exceeded {
+		service.log.Sugar().Errorf("monthly bandwidth limit of %s has been exceeded for projectID %s", limit, projectID)
+		return accounting.ErrProjectLimitExceeded.New("monthly bandwidth limit of %s exceeded", limit)
+	}
+	return nil
+}
+
+// checkStorageLimit returns an ErrProjectLimitExceeded error when the
+// project exceeded its storage limit.
+func (service *Service) checkStorageLimit(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := service.projectUsage.ExceedsStorageUsage(ctx, projectID)
+	if err != nil {
+		service.log.Error("retrieving project storage totals", zap.Error(err))
+		return nil
+	}
+	if exceeded {
+		service.log.Sugar().Errorf("storage limit of %s has been exceeded for projectID %s", limit, projectID)
+		return accounting.ErrProjectLimitExceeded.New("storage limit of %s exceeded", limit)
+	}
+	return nil
+}
+
 // SplitBucketID takes a bucketID, splits on /, and returns a projectID and bucketName
 func SplitBucketID(bucketID []byte) (projectID *uuid.UUID, bucketName []byte, err error) {
 	pathElements := bytes.Split(bucketID, []byte("/"))
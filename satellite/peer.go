@@ -34,6 +34,7 @@ import (
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/discovery"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
@@ -42,11 +43,16 @@ import (
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/marketingweb"
@@ -110,6 +116,7 @@ type Config struct {
 	Kademlia  kademlia.Config
 	Overlay   overlay.Config
 	Discovery discovery.Config
+	Contact   contact.Config
 
 	Metainfo metainfo.Config
 	Orders   orders.Config
@@ -118,6 +125,12 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
+	GarbageCollection gc.Config
+
+	Lifecycle lifecycle.Config
+
+	GracefulExit gracefulexit.Config
+
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
@@ -128,6 +141,8 @@ type Config struct {
 	Marketing marketingweb.Config
 	Vouchers  vouchers.Config
 
+	Admin admin.Config
+
 	Version version.Config
 }
 
@@ -163,6 +178,11 @@ type Peer struct {
 		Service *discovery.Discovery
 	}
 
+	Contact struct {
+		Self     overlay.NodeDossier
+		Endpoint *contact.Endpoint
+	}
+
 	Metainfo struct {
 		Database  storage.KeyValueStore // TODO: move into pointerDB
 		Service   *metainfo.Service
@@ -187,6 +207,18 @@ type Peer struct {
 		Service *audit.Service
 	}
 
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	Lifecycle struct {
+		Service *lifecycle.Service
+	}
+
+	GracefulExit struct {
+		Endpoint *gracefulexit.Endpoint
+	}
+
 	Accounting struct {
 		Tally        *tally.Service
 		Rollup       *rollup.Service
@@ -216,6 +248,10 @@ type Peer struct {
 		Endpoint *marketingweb.Server
 	}
 
+	Admin struct {
+		Server *admin.Server
+	}
+
 	NodeStats struct {
 		Endpoint *nodestats.Endpoint
 	}
@@ -264,19 +300,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		log.Debug("Starting overlay")
 		config := config.Overlay
 
-		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), config.Node)
-		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
+		lookup, err := geoip.Open(config.GeoIP)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if lookup == nil && config.Node.DistinctASN {
+			return nil, errs.Combine(errs.New("distinct autonomous systems require a geoip database"), peer.Close())
+		}
+
+		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), lookup, config.Node)
 
 		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
 		pb.RegisterOverlayInspectorServer(peer.Server.PrivateGRPC(), peer.Overlay.Inspector)
 	}
 
-	{ // setup kademlia
-		log.Debug("Setting up Kademlia")
-		config := config.Kademlia
-		// TODO: move this setup logic into kademlia package
-		if config.ExternalAddress == "" {
-			config.ExternalAddress = peer.Addr()
+	{ // setup contact
+		log.Debug("Setting up contact")
+		// TODO: move this setup logic into contact package
+		externalAddress := config.Kademlia.ExternalAddress
+		if externalAddress == "" {
+			externalAddress = peer.Addr()
 		}
 
 		pbVersion, err := versionInfo.Proto()
@@ -284,21 +327,38 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		self := &overlay.NodeDossier{
+		peer.Contact.Self = overlay.NodeDossier{
 			Node: pb.Node{
 				Id: peer.ID(),
 				Address: &pb.NodeAddress{
-					Address: config.ExternalAddress,
+					Address: externalAddress,
 				},
 			},
 			Type: pb.NodeType_SATELLITE,
 			Operator: pb.NodeOperator{
-				Email:  config.Operator.Email,
-				Wallet: config.Operator.Wallet,
+				Email:  config.Kademlia.Operator.Email,
+				Wallet: config.Kademlia.Operator.Wallet,
 			},
 			Version: *pbVersion,
 		}
 
+		// the endpoint records the uptime of nodes checking in itself,
+		// so it uses the transport before the overlay starts observing it
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), config.Contact, peer.Transport, peer.Overlay.Service)
+		pb.RegisterNodeServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+
+		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
+	}
+
+	if config.Contact.Kademlia { // setup kademlia
+		log.Debug("Setting up Kademlia")
+		config := config.Kademlia
+		// TODO: move this setup logic into kademlia package
+		if config.ExternalAddress == "" {
+			config.ExternalAddress = peer.Addr()
+		}
+		self := peer.Contact.Self
+
 		{ // setup routing table
 			// TODO: clean this up, should be part of database
 			log.Debug("Setting up routing table")
@@ -315,7 +375,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			}
 			peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb = dbs[0], dbs[1], dbs[2]
 
-			peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), self, peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb, &config.RoutingTableConfig)
+			peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), &self, peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb, &config.RoutingTableConfig)
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
@@ -335,8 +395,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
-	{ // setup discovery
+	if config.Discovery.Enabled { // setup discovery
 		log.Debug("Setting up discovery")
+		if !config.Contact.Kademlia {
+			return nil, errs.Combine(errs.New("discovery requires kademlia"), peer.Close())
+		}
 		config := config.Discovery
 		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, config)
 	}
@@ -385,6 +448,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.DB.Orders(),
+			peer.Accounting.ProjectUsage,
 			config.Orders.Expiration,
 			&pb.NodeAddress{
 				Transport: pb.NodeTransport_TCP_TLS_GRPC,
@@ -416,6 +480,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.DB.Attribution(),
 			peer.DB.Containment(),
 			peer.DB.Console().APIKeys(),
+			peer.DB.Console().RedundancyPolicies(),
 			peer.Accounting.ProjectUsage,
 			config.Metainfo.RS,
 			signing.SignerFromFullIdentity(peer.Identity),
@@ -468,6 +533,50 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 	}
 
+	{ // setup garbage collection
+		log.Debug("Setting up garbage collection")
+
+		peer.GarbageCollection.Service = gc.NewService(
+			peer.Log.Named("garbage collection"),
+			config.GarbageCollection,
+			peer.Transport,
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+		)
+	}
+
+	{ // setup bucket lifecycle
+		log.Debug("Setting up bucket lifecycle")
+
+		// expired objects only release their pieces through garbage collection
+		lifecycleConfig := config.Lifecycle
+		if lifecycleConfig.Enabled && !config.GarbageCollection.Enabled {
+			log.Warn("Bucket lifecycle rules are not enforced, because garbage collection is disabled")
+			lifecycleConfig.Enabled = false
+		}
+
+		peer.Lifecycle.Service = lifecycle.NewService(
+			peer.Log.Named("lifecycle"),
+			lifecycleConfig,
+			peer.Metainfo.Service,
+		)
+	}
+
+	{ // setup graceful exit
+		log.Debug("Setting up graceful exit")
+
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			config.GracefulExit,
+			signing.SignerFromFullIdentity(peer.Identity),
+			peer.Transport,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.Overlay.Service,
+		)
+		pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
+	}
+
 	{ // setup accounting
 		log.Debug("Setting up accounting")
 		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Service, peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
@@ -581,6 +690,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.DB.Rewards(),
 			pmService,
 			consoleConfig.PasswordCost,
+			config.Metainfo.RS.MinimumRedundancy(),
+			config.Rollup.MaxAlphaUsage,
 		)
 
 		if err != nil {
@@ -627,6 +738,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterNodeStatsServer(peer.Server.GRPC(), peer.NodeStats.Endpoint)
 	}
 
+	if config.Admin.Address != "" { // setup admin API
+		log.Debug("Setting up admin API")
+
+		peer.Admin.Server, err = admin.NewServer(
+			peer.Log.Named("admin"),
+			config.Admin,
+			peer.DB,
+			peer.Overlay.Service,
+			config.Metainfo.RS.MinimumRedundancy(),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	return peer, nil
 }
 
@@ -639,15 +765,19 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Version.Run(ctx))
 	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
-	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
-	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
-	})
+	if peer.Kademlia.Service != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
+		})
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
+		})
+	}
+	if peer.Discovery.Service != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
+		})
+	}
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
@@ -663,6 +793,12 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GarbageCollection.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Lifecycle.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -677,6 +813,11 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Marketing.Endpoint.Run(ctx))
 	})
+	if peer.Admin.Server != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Admin.Server.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
@@ -702,6 +843,10 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Mail.Service.Close())
 	}
 
+	if peer.Admin.Server != nil {
+		errlist.Add(peer.Admin.Server.Close())
+	}
+
 	if peer.Marketing.Endpoint != nil {
 		errlist.Add(peer.Marketing.Endpoint.Close())
 	} else if peer.Marketing.Listener != nil {
@@ -709,6 +854,12 @@ func (peer *Peer) Close() error {
 	}
 
 	// close services in reverse initialization order
+	if peer.Lifecycle.Service != nil {
+		errlist.Add(peer.Lifecycle.Service.Close())
+	}
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -720,6 +871,10 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Metainfo.Database.Close())
 	}
 
+	if peer.LiveAccounting.Service != nil {
+		errlist.Add(peer.LiveAccounting.Service.Close())
+	}
+
 	if peer.Discovery.Service != nil {
 		errlist.Add(peer.Discovery.Service.Close())
 	}
@@ -749,7 +904,12 @@ func (peer *Peer) Close() error {
 func (peer *Peer) ID() storj.NodeID { return peer.Identity.ID }
 
 // Local returns the peer local node info.
-func (peer *Peer) Local() overlay.NodeDossier { return peer.Kademlia.RoutingTable.Local() }
+func (peer *Peer) Local() overlay.NodeDossier {
+	if peer.Kademlia.RoutingTable != nil {
+		return peer.Kademlia.RoutingTable.Local()
+	}
+	return peer.Contact.Self
+}
 
 // Addr returns the public address.
 func (peer *Peer) Addr() string { return peer.Server.Addr().String() }
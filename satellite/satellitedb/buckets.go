@@ -8,9 +8,11 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/metainfo"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
@@ -28,6 +30,14 @@ func (db *DB) Buckets() metainfo.BucketsDB {
 // CreateBucket creates a new bucket
 func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
+	lifecycle, err := marshalLifecycle(bucket.Lifecycle)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	placement, err := marshalPlacement(bucket.Placement)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
 	row, err := db.db.Create_BucketMetainfo(ctx,
 		dbx.BucketMetainfo_Id(bucket.ID[:]),
 		dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
@@ -42,8 +52,11 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 		dbx.BucketMetainfo_DefaultRedundancyRepairShares(int(bucket.DefaultRedundancyScheme.RepairShares)),
 		dbx.BucketMetainfo_DefaultRedundancyOptimalShares(int(bucket.DefaultRedundancyScheme.OptimalShares)),
 		dbx.BucketMetainfo_DefaultRedundancyTotalShares(int(bucket.DefaultRedundancyScheme.TotalShares)),
+		dbx.BucketMetainfo_Versioning(int(bucket.Versioning)),
 		dbx.BucketMetainfo_Create_Fields{
 			PartnerId: dbx.BucketMetainfo_PartnerId(bucket.PartnerID[:]),
+			Lifecycle: dbx.BucketMetainfo_Lifecycle_Raw(lifecycle),
+			Placement: dbx.BucketMetainfo_Placement_Raw(placement),
 		},
 	)
 	if err != nil {
@@ -73,6 +86,44 @@ func (db *bucketsDB) GetBucket(ctx context.Context, bucketName []byte, projectID
 	return convertDBXtoBucket(dbxBucket)
 }
 
+// UpdateBucket updates the defaults, the versioning state, the lifecycle rules and the placement constraints of an existing bucket
+func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	lifecycle, err := marshalLifecycle(bucket.Lifecycle)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	placement, err := marshalPlacement(bucket.Placement)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
+		dbx.BucketMetainfo_Name([]byte(bucket.Name)),
+		dbx.BucketMetainfo_Update_Fields{
+			DefaultSegmentSize:              dbx.BucketMetainfo_DefaultSegmentSize(int(bucket.DefaultSegmentsSize)),
+			DefaultEncryptionCipherSuite:    dbx.BucketMetainfo_DefaultEncryptionCipherSuite(int(bucket.DefaultEncryptionParameters.CipherSuite)),
+			DefaultEncryptionBlockSize:      dbx.BucketMetainfo_DefaultEncryptionBlockSize(int(bucket.DefaultEncryptionParameters.BlockSize)),
+			DefaultRedundancyAlgorithm:      dbx.BucketMetainfo_DefaultRedundancyAlgorithm(int(bucket.DefaultRedundancyScheme.Algorithm)),
+			DefaultRedundancyShareSize:      dbx.BucketMetainfo_DefaultRedundancyShareSize(int(bucket.DefaultRedundancyScheme.ShareSize)),
+			DefaultRedundancyRequiredShares: dbx.BucketMetainfo_DefaultRedundancyRequiredShares(int(bucket.DefaultRedundancyScheme.RequiredShares)),
+			DefaultRedundancyRepairShares:   dbx.BucketMetainfo_DefaultRedundancyRepairShares(int(bucket.DefaultRedundancyScheme.RepairShares)),
+			DefaultRedundancyOptimalShares:  dbx.BucketMetainfo_DefaultRedundancyOptimalShares(int(bucket.DefaultRedundancyScheme.OptimalShares)),
+			DefaultRedundancyTotalShares:    dbx.BucketMetainfo_DefaultRedundancyTotalShares(int(bucket.DefaultRedundancyScheme.TotalShares)),
+			Versioning:                      dbx.BucketMetainfo_Versioning(int(bucket.Versioning)),
+			Lifecycle:                       dbx.BucketMetainfo_Lifecycle_Raw(lifecycle),
+			Placement:                       dbx.BucketMetainfo_Placement_Raw(placement),
+		},
+	)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.Bucket{}, storj.ErrBucketNotFound.New("%s", bucket.Name)
+	}
+	return convertDBXtoBucket(dbxBucket)
+}
+
 // DeleteBucket deletes a bucket
 func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -172,6 +223,14 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 	if err != nil {
 		return bucket, err
 	}
+	lifecycle, err := unmarshalLifecycle(dbxBucket.Lifecycle)
+	if err != nil {
+		return bucket, err
+	}
+	placement, err := unmarshalPlacement(dbxBucket.Placement)
+	if err != nil {
+		return bucket, err
+	}
 	return storj.Bucket{
 		ID:                  id,
 		Name:                string(dbxBucket.Name),
@@ -191,5 +250,56 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 			CipherSuite: storj.CipherSuite(dbxBucket.DefaultEncryptionCipherSuite),
 			BlockSize:   int32(dbxBucket.DefaultEncryptionBlockSize),
 		},
+		Versioning: storj.BucketVersioning(dbxBucket.Versioning),
+		Lifecycle:  lifecycle,
+		Placement:  placement,
 	}, nil
 }
+
+// marshalLifecycle encodes the lifecycle rules of a bucket, nil if there are none
+func marshalLifecycle(rules []storj.LifecycleRule) (*[]byte, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	data, err := proto.Marshal(&pb.BucketLifecycle{Rules: metainfo.LifecycleToProto(rules)})
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// unmarshalLifecycle decodes the lifecycle rules of a bucket
+func unmarshalLifecycle(data *[]byte) ([]storj.LifecycleRule, error) {
+	if data == nil {
+		return nil, nil
+	}
+	lifecycle := &pb.BucketLifecycle{}
+	if err := proto.Unmarshal(*data, lifecycle); err != nil {
+		return nil, err
+	}
+	return metainfo.LifecycleFromProto(lifecycle.Rules), nil
+}
+
+// marshalPlacement encodes the placement constraints of a bucket, nil if there are none
+func marshalPlacement(placement *storj.PlacementConstraints) (*[]byte, error) {
+	if placement == nil {
+		return nil, nil
+	}
+	data, err := proto.Marshal(metainfo.PlacementToProto(placement))
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// unmarshalPlacement decodes the placement constraints of a bucket
+func unmarshalPlacement(data *[]byte) (*storj.PlacementConstraints, error) {
+	if data == nil {
+		return nil, nil
+	}
+	placement := &pb.PlacementConstraints{}
+	if err := proto.Unmarshal(*data, placement); err != nil {
+		return nil, err
+	}
+	return metainfo.PlacementFromProto(placement), nil
+}
@@ -81,6 +81,11 @@ func (db *ConsoleDB) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	return &projectinvoicestamps{db.methods}
 }
 
+// RedundancyPolicies is a getter for console.RedundancyPolicies repository
+func (db *ConsoleDB) RedundancyPolicies() console.RedundancyPolicies {
+	return &redundancyPolicies{db.db, db.methods}
+}
+
 // BeginTx is a method for opening transaction
 func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	if db.db == nil {
@@ -65,6 +65,7 @@ CREATE TABLE injuredsegments (
 	path bytea NOT NULL,
 	data bytea NOT NULL,
 	attempted timestamp,
+	num_healthy_pieces integer NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -106,6 +107,14 @@ CREATE TABLE nodes (
 	audit_reputation_beta double precision NOT NULL,
 	uptime_reputation_alpha double precision NOT NULL,
 	uptime_reputation_beta double precision NOT NULL,
+	exit_initiated_at timestamp with time zone,
+	exit_finished_at timestamp with time zone,
+	exit_success boolean NOT NULL,
+	country_code text NOT NULL,
+	asn bigint NOT NULL,
+	disqualification_reason integer,
+	exit_failure_reason integer,
+	suspended timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE offers (
@@ -139,6 +148,9 @@ CREATE TABLE projects (
 	usage_limit bigint NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	storage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
+	owner_id bytea,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE registration_tokens (
@@ -225,6 +237,9 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning integer NOT NULL,
+	lifecycle bytea,
+	placement bytea,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -243,6 +258,17 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE redundancy_policies (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	bucket_name bytea NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	share_size integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE used_serials (
 	serial_number_id integer NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id bytea NOT NULL,
@@ -278,6 +304,7 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_num_healthy_pieces_index ON injuredsegments ( num_healthy_pieces );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
@@ -65,6 +65,7 @@ CREATE TABLE injuredsegments (
 	path BLOB NOT NULL,
 	data BLOB NOT NULL,
 	attempted TIMESTAMP,
+	num_healthy_pieces INTEGER NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -106,6 +107,14 @@ CREATE TABLE nodes (
 	audit_reputation_beta REAL NOT NULL,
 	uptime_reputation_alpha REAL NOT NULL,
 	uptime_reputation_beta REAL NOT NULL,
+	exit_initiated_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success INTEGER NOT NULL,
+	country_code TEXT NOT NULL,
+	asn INTEGER NOT NULL,
+	disqualification_reason INTEGER,
+	exit_failure_reason INTEGER,
+	suspended TIMESTAMP,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE offers (
@@ -139,6 +148,9 @@ CREATE TABLE projects (
 	usage_limit INTEGER NOT NULL,
 	partner_id BLOB,
 	created_at TIMESTAMP NOT NULL,
+	storage_limit INTEGER NOT NULL,
+	bandwidth_limit INTEGER NOT NULL,
+	owner_id BLOB,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE registration_tokens (
@@ -225,6 +237,9 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares INTEGER NOT NULL,
 	default_redundancy_optimal_shares INTEGER NOT NULL,
 	default_redundancy_total_shares INTEGER NOT NULL,
+	versioning INTEGER NOT NULL,
+	lifecycle BLOB,
+	placement BLOB,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -243,6 +258,17 @@ CREATE TABLE project_members (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE redundancy_policies (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	bucket_name BLOB NOT NULL,
+	required_shares INTEGER NOT NULL,
+	repair_shares INTEGER NOT NULL,
+	optimal_shares INTEGER NOT NULL,
+	total_shares INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE used_serials (
 	serial_number_id INTEGER NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id BLOB NOT NULL,
@@ -278,6 +304,7 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_num_healthy_pieces_index ON injuredsegments ( num_healthy_pieces );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
@@ -117,6 +118,13 @@ func (m *lockedBuckets) ListBuckets(ctx context.Context, projectID uuid.UUID, li
 	return m.db.ListBuckets(ctx, projectID, listOpts, allowedBuckets)
 }
 
+// UpdateBucket updates an existing bucket
+func (m *lockedBuckets) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateBucket(ctx, bucket)
+}
+
 // CertDB returns database for storing uplink's public key & ID
 func (m *locked) CertDB() certdb.DB {
 	m.Lock()
@@ -445,6 +453,48 @@ func (m *lockedProjects) Update(ctx context.Context, project *console.Project) e
 	return m.db.Update(ctx, project)
 }
 
+// RedundancyPolicies is a getter for RedundancyPolicies repository
+func (m *lockedConsole) RedundancyPolicies() console.RedundancyPolicies {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedRedundancyPolicies{m.Locker, m.db.RedundancyPolicies()}
+}
+
+// lockedRedundancyPolicies implements locking wrapper for console.RedundancyPolicies
+type lockedRedundancyPolicies struct {
+	sync.Locker
+	db console.RedundancyPolicies
+}
+
+// Delete is a method for deleting the redundancy policy of a bucket or project from the database.
+func (m *lockedRedundancyPolicies) Delete(ctx context.Context, projectID uuid.UUID, bucketName []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, bucketName)
+}
+
+// Get is a method for querying the redundancy policy of a bucket from the database.
+// The policy of the whole project is returned, when bucketName is empty.
+func (m *lockedRedundancyPolicies) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (*console.RedundancyPolicy, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucketName)
+}
+
+// GetByProjectID is a method for querying all redundancy policies of a project from the database.
+func (m *lockedRedundancyPolicies) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.RedundancyPolicy, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID)
+}
+
+// Set is a method for inserting or replacing a redundancy policy in the database.
+func (m *lockedRedundancyPolicies) Set(ctx context.Context, policy console.RedundancyPolicy) (*console.RedundancyPolicy, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, policy)
+}
+
 // RegistrationTokens is a getter for RegistrationTokens repository
 func (m *lockedConsole) RegistrationTokens() console.RegistrationTokens {
 	m.Lock()
@@ -901,14 +951,14 @@ func (m *lockedOverlayCache) Reliable(ctx context.Context, a1 *overlay.NodeCrite
 }
 
 // SelectNewStorageNodes looks up nodes based on new node criteria
-func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*overlay.NodeDossier, error) {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.SelectNewStorageNodes(ctx, count, criteria)
 }
 
 // SelectStorageNodes looks up nodes based on criteria
-func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*overlay.NodeDossier, error) {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.SelectStorageNodes(ctx, count, criteria)
@@ -921,6 +971,34 @@ func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node,
 	return m.db.UpdateAddress(ctx, value, defaults)
 }
 
+// UpdateExitStatus updates a single storagenode's graceful exit status.
+func (m *lockedOverlayCache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateExitStatus(ctx, request)
+}
+
+// UpdateLocation updates the geographic and network location of a node.
+func (m *lockedOverlayCache) UpdateLocation(ctx context.Context, nodeID storj.NodeID, location geoip.Location) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateLocation(ctx, nodeID, location)
+}
+
+// DisqualifyNode disqualifies a storagenode for the given reason.
+func (m *lockedOverlayCache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason pb.DisqualificationReason) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DisqualifyNode(ctx, nodeID, reason)
+}
+
+// ReinstateNode lifts the disqualification of a storagenode and resets its reputation.
+func (m *lockedOverlayCache) ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults overlay.NodeSelectionConfig) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ReinstateNode(ctx, nodeID, defaults)
+}
+
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 func (m *lockedOverlayCache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *overlay.NodeDossier, err error) {
 	m.Lock()
@@ -936,10 +1014,10 @@ func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.U
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda float64, weight float64, uptimeDQ float64) (stats *overlay.NodeStats, err error) {
+func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda float64, weight float64, uptimeDQ float64, suspension overlay.SuspensionConfig) (stats *overlay.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ, suspension)
 }
 
 // ProjectAccounting returns database for storing information about project data use
@@ -969,6 +1047,13 @@ func (m *lockedProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context
 	return m.db.GetAllocatedBandwidthTotal(ctx, projectID, from)
 }
 
+// GetProjectLimits returns the usage, storage and bandwidth limits of a project
+func (m *lockedProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (accounting.ProjectLimits, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectLimits(ctx, projectID)
+}
+
 // GetProjectUsageLimits returns project usage limit
 func (m *lockedProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error) {
 	m.Lock()
@@ -1003,6 +1088,20 @@ type lockedRepairQueue struct {
 	db queue.RepairQueue
 }
 
+// Count counts the number of injured segments.
+func (m *lockedRepairQueue) Count(ctx context.Context) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Count(ctx)
+}
+
+// CountByHealthyPieces counts the injured segments by their number of healthy pieces.
+func (m *lockedRepairQueue) CountByHealthyPieces(ctx context.Context) (map[int]int, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CountByHealthyPieces(ctx)
+}
+
 // Delete removes an injured segment.
 func (m *lockedRepairQueue) Delete(ctx context.Context, s *pb.InjuredSegment) error {
 	m.Lock()
@@ -1011,10 +1110,10 @@ func (m *lockedRepairQueue) Delete(ctx context.Context, s *pb.InjuredSegment) er
 }
 
 // Insert adds an injured segment.
-func (m *lockedRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment) error {
+func (m *lockedRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment, numHealthy int) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Insert(ctx, s)
+	return m.db.Insert(ctx, s, numHealthy)
 }
 
 // Select gets an injured segment.
@@ -1019,6 +1019,104 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE bucket_metainfos ADD COLUMN partner_id BYTEA`,
 				},
 			},
+			{
+				Description: "Add graceful exit status columns to nodes table",
+				Version:     46,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN exit_initiated_at timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN exit_finished_at timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN exit_success boolean NOT NULL DEFAULT FALSE;`,
+				},
+			},
+			{
+				Description: "Add versioning column to bucket_metainfos table",
+				Version:     47,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Add lifecycle column to bucket_metainfos table",
+				Version:     48,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle bytea;`,
+				},
+			},
+			{
+				Description: "Add redundancy_policies table",
+				Version:     49,
+				Action: migrate.SQL{
+					`CREATE TABLE redundancy_policies (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						bucket_name bytea NOT NULL,
+						required_shares integer NOT NULL,
+						repair_shares integer NOT NULL,
+						optimal_shares integer NOT NULL,
+						total_shares integer NOT NULL,
+						share_size integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				Description: "Add location columns to nodes and placement column to bucket_metainfos",
+				Version:     50,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN country_code text NOT NULL DEFAULT '';`,
+					`ALTER TABLE nodes ADD COLUMN asn bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN placement bytea;`,
+				},
+			},
+			{
+				Description: "Add storage and bandwidth limit columns to projects",
+				Version:     51,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN storage_limit bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE projects ADD COLUMN bandwidth_limit bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Add num_healthy_pieces column to injuredsegments to prioritize repairs",
+				Version:     52,
+				Action: migrate.SQL{
+					`ALTER TABLE injuredsegments ADD COLUMN num_healthy_pieces integer NOT NULL DEFAULT 0;`,
+					`CREATE INDEX injuredsegments_num_healthy_pieces_index ON injuredsegments ( num_healthy_pieces );`,
+				},
+			},
+			{
+				Description: "Add disqualification_reason column to nodes",
+				Version:     53,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN disqualification_reason integer;`,
+				},
+			},
+			{
+				Description: "Add owner_id column to projects",
+				Version:     54,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN owner_id bytea;`,
+					`UPDATE projects SET owner_id = (
+						SELECT member_id FROM project_members
+						WHERE project_members.project_id = projects.id
+						ORDER BY project_members.created_at ASC LIMIT 1
+					);`,
+				},
+			},
+			{
+				Description: "Add exit_failure_reason column to nodes",
+				Version:     55,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN exit_failure_reason integer;`,
+				},
+			},
+			{
+				Description: "Add suspended column to nodes",
+				Version:     56,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN suspended timestamp with time zone;`,
+				},
+			},
 		},
 	}
 }
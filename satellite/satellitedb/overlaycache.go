@@ -15,6 +15,7 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/geoip"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -32,13 +33,15 @@ type overlaycache struct {
 	db *dbx.DB
 }
 
-func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
+func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodeType := int(pb.NodeType_STORAGE)
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND suspended IS NULL
+		AND exit_initiated_at IS NULL
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -61,7 +64,11 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	if !criteria.DistinctIP {
+	safePlacement, placementArgs := placementQuery(criteria)
+	safeQuery += safePlacement
+	args = append(args, placementArgs...)
+
+	if !criteria.DistinctIP && !criteria.DistinctASN {
 		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
 		if err != nil {
 			return nil, err
@@ -69,16 +76,21 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		return nodes, nil
 	}
 
-	// query for distinct IPs
+	// query for distinct IPs or autonomous systems
 	for i := 0; i < 3; i++ {
-		moreNodes, err := cache.queryNodesDistinct(ctx, criteria.ExcludedNodes, criteria.ExcludedIPs, count-len(nodes), safeQuery, criteria.DistinctIP, args...)
+		moreNodes, err := cache.queryNodesDistinct(ctx, criteria, count-len(nodes), safeQuery, args...)
 		if err != nil {
 			return nil, err
 		}
 		for _, n := range moreNodes {
 			nodes = append(nodes, n)
 			criteria.ExcludedNodes = append(criteria.ExcludedNodes, n.Id)
-			criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
+			if criteria.DistinctIP {
+				criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
+			}
+			if criteria.DistinctASN && n.Location.ASN != 0 {
+				criteria.ExcludedASNs = append(criteria.ExcludedASNs, n.Location.ASN)
+			}
 		}
 		if len(nodes) == count {
 			break
@@ -88,13 +100,15 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 	return nodes, nil
 }
 
-func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
+func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodeType := int(pb.NodeType_STORAGE)
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND suspended IS NULL
+		AND exit_initiated_at IS NULL
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -115,7 +129,11 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	if !criteria.DistinctIP {
+	safePlacement, placementArgs := placementQuery(criteria)
+	safeQuery += safePlacement
+	args = append(args, placementArgs...)
+
+	if !criteria.DistinctIP && !criteria.DistinctASN {
 		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
 		if err != nil {
 			return nil, err
@@ -123,16 +141,21 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		return nodes, nil
 	}
 
-	// query for distinct IPs
+	// query for distinct IPs or autonomous systems
 	for i := 0; i < 3; i++ {
-		moreNodes, err := cache.queryNodesDistinct(ctx, criteria.ExcludedNodes, criteria.ExcludedIPs, count-len(nodes), safeQuery, criteria.DistinctIP, args...)
+		moreNodes, err := cache.queryNodesDistinct(ctx, criteria, count-len(nodes), safeQuery, args...)
 		if err != nil {
 			return nil, err
 		}
 		for _, n := range moreNodes {
 			nodes = append(nodes, n)
 			criteria.ExcludedNodes = append(criteria.ExcludedNodes, n.Id)
-			criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
+			if criteria.DistinctIP {
+				criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
+			}
+			if criteria.DistinctASN && n.Location.ASN != 0 {
+				criteria.ExcludedASNs = append(criteria.ExcludedASNs, n.Location.ASN)
+			}
 		}
 		if len(nodes) == count {
 			break
@@ -142,7 +165,7 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 	return nodes, nil
 }
 
-func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
@@ -163,7 +186,8 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 	rows, err = cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net,
 	free_bandwidth, free_disk, total_audit_count, audit_success_count,
 	total_uptime_count, uptime_success_count, disqualified, audit_reputation_alpha,
-	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta
+	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta,
+	country_code, asn
 	FROM nodes
 	`+safeQuery+safeExcludeNodes+`
 	ORDER BY RANDOM()
@@ -173,7 +197,7 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
@@ -182,6 +206,7 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount, &dbNode.Disqualified,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
 			&dbNode.UptimeReputationAlpha, &dbNode.UptimeReputationBeta,
+			&dbNode.CountryCode, &dbNode.Asn,
 		)
 		if err != nil {
 			return nil, err
@@ -191,57 +216,94 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
 }
 
-func (cache *overlaycache) queryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+// placementQuery restricts the selected nodes to the allowed countries.
+func placementQuery(criteria *overlay.NodeCriteria) (safeQuery string, args []interface{}) {
+	if len(criteria.CountryCodes) > 0 {
+		safeQuery += ` AND country_code IN (?` + strings.Repeat(", ?", len(criteria.CountryCodes)-1) + `)`
+		for _, code := range criteria.CountryCodes {
+			args = append(args, code)
+		}
+	}
+	if len(criteria.ExcludedCountryCodes) > 0 {
+		safeQuery += ` AND country_code NOT IN (?` + strings.Repeat(", ?", len(criteria.ExcludedCountryCodes)-1) + `)`
+		for _, code := range criteria.ExcludedCountryCodes {
+			args = append(args, code)
+		}
+	}
+	return safeQuery, args
+}
+
+// distinctQuery excludes the nodes, networks and autonomous systems, which
+// were already selected. It returns the column with one node per value.
+func distinctQuery(criteria *overlay.NodeCriteria) (safeQuery string, args []interface{}, distinctColumn string) {
+	if len(criteria.ExcludedNodes) > 0 {
+		safeQuery += ` AND id NOT IN (?` + strings.Repeat(", ?", len(criteria.ExcludedNodes)-1) + `)`
+		for _, id := range criteria.ExcludedNodes {
+			args = append(args, id.Bytes())
+		}
+	}
+
+	if criteria.DistinctIP && len(criteria.ExcludedIPs) > 0 {
+		safeQuery += ` AND last_net NOT IN (?` + strings.Repeat(", ?", len(criteria.ExcludedIPs)-1) + `)`
+		for _, ip := range criteria.ExcludedIPs {
+			args = append(args, ip)
+		}
+	}
+
+	if !criteria.DistinctASN {
+		return safeQuery, args, "last_net"
+	}
+
+	// nodes without a known autonomous system are never excluded, but count
+	// as a single one in each query
+	if len(criteria.ExcludedASNs) > 0 {
+		safeQuery += ` AND asn NOT IN (?` + strings.Repeat(", ?", len(criteria.ExcludedASNs)-1) + `)`
+		for _, asn := range criteria.ExcludedASNs {
+			args = append(args, int64(asn))
+		}
+	}
+	return safeQuery, args, "asn"
+}
+
+func (cache *overlaycache) queryNodesDistinct(ctx context.Context, criteria *overlay.NodeCriteria, count int, safeQuery string, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	switch t := cache.db.DB.Driver().(type) {
 	case *sqlite3.SQLiteDriver:
-		return cache.sqliteQueryNodesDistinct(ctx, excludedNodes, excludedIPs, count, safeQuery, distinctIP, args...)
+		return cache.sqliteQueryNodesDistinct(ctx, criteria, count, safeQuery, args...)
 	case *pq.Driver:
-		return cache.postgresQueryNodesDistinct(ctx, excludedNodes, excludedIPs, count, safeQuery, distinctIP, args...)
+		return cache.postgresQueryNodesDistinct(ctx, criteria, count, safeQuery, args...)
 	default:
-		return []*pb.Node{}, Error.New("Unsupported database %t", t)
+		return []*overlay.NodeDossier{}, Error.New("Unsupported database %t", t)
 	}
 }
 
-func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, criteria *overlay.NodeCriteria, count int, safeQuery string, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
 		return nil, nil
 	}
 
-	safeExcludeNodes := ""
-	if len(excludedNodes) > 0 {
-		safeExcludeNodes = ` AND id NOT IN (?` + strings.Repeat(", ?", len(excludedNodes)-1) + `)`
-		for _, id := range excludedNodes {
-			args = append(args, id.Bytes())
-		}
-	}
-
-	safeExcludeIPs := ""
-	if len(excludedIPs) > 0 {
-		safeExcludeIPs = ` AND last_net NOT IN (?` + strings.Repeat(", ?", len(excludedIPs)-1) + `)`
-		for _, ip := range excludedIPs {
-			args = append(args, ip)
-		}
-	}
+	safeExclude, excludeArgs, distinctColumn := distinctQuery(criteria)
+	args = append(args, excludeArgs...)
 
 	args = append(args, count)
 
 	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net,
 	free_bandwidth, free_disk, total_audit_count, audit_success_count,
 	total_uptime_count, uptime_success_count, disqualified, audit_reputation_alpha,
-	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta
-	FROM (SELECT *, Row_number() OVER(PARTITION BY last_net ORDER BY RANDOM()) rn
+	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta,
+	country_code, asn
+	FROM (SELECT *, Row_number() OVER(PARTITION BY `+distinctColumn+` ORDER BY RANDOM()) rn
 		FROM nodes
-		`+safeQuery+safeExcludeNodes+safeExcludeIPs+`) n
+		`+safeQuery+safeExclude+`) n
 	WHERE rn = 1
 	ORDER BY RANDOM()
 	LIMIT ?`), args...)
@@ -250,7 +312,7 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
@@ -259,6 +321,7 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount, &dbNode.Disqualified,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
 			&dbNode.UptimeReputationAlpha, &dbNode.UptimeReputationBeta,
+			&dbNode.CountryCode, &dbNode.Asn,
 		)
 		if err != nil {
 			return nil, err
@@ -268,52 +331,39 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
 }
 
-func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, criteria *overlay.NodeCriteria, count int, safeQuery string, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
 		return nil, nil
 	}
 
-	safeExcludeNodes := ""
-	if len(excludedNodes) > 0 {
-		safeExcludeNodes = ` AND id NOT IN (?` + strings.Repeat(", ?", len(excludedNodes)-1) + `)`
-		for _, id := range excludedNodes {
-			args = append(args, id.Bytes())
-		}
-	}
-
-	safeExcludeIPs := ""
-	if len(excludedIPs) > 0 {
-		safeExcludeIPs = ` AND last_net NOT IN (?` + strings.Repeat(", ?", len(excludedIPs)-1) + `)`
-		for _, ip := range excludedIPs {
-			args = append(args, ip)
-		}
-	}
+	safeExclude, excludeArgs, distinctColumn := distinctQuery(criteria)
+	args = append(args, excludeArgs...)
 	args = append(args, count)
 
 	rows, err := cache.db.Query(cache.db.Rebind(`
 	WITH candidates AS (
 		SELECT * FROM nodes
-		`+safeQuery+safeExcludeNodes+safeExcludeIPs+`
+		`+safeQuery+safeExclude+`
 	)
 	SELECT
 		id, type, address, last_net, free_bandwidth, free_disk, total_audit_count,
 		audit_success_count, total_uptime_count, uptime_success_count,
 		audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha,
-		uptime_reputation_beta
+		uptime_reputation_beta, country_code, asn
 	FROM (
 		SELECT * FROM (
-			SELECT DISTINCT ON (last_net) *  -- choose at max 1 node from this IP or network
+			SELECT DISTINCT ON (`+distinctColumn+`) *  -- choose at max 1 node from this IP, network or AS
 			FROM candidates
 			WHERE last_net <> ''             -- don't try to IP-filter nodes with no known IP yet
-			ORDER BY last_net, RANDOM()      -- equal chance of choosing any qualified node at this IP or network
+			ORDER BY `+distinctColumn+`, RANDOM()      -- equal chance of choosing any qualified node at this IP or network
 		) ipfiltered
 		UNION ALL
 		SELECT * FROM candidates WHERE last_net = ''
@@ -325,7 +375,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
@@ -334,6 +384,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
 			&dbNode.UptimeReputationAlpha, &dbNode.UptimeReputationBeta,
+			&dbNode.CountryCode, &dbNode.Asn,
 		)
 		if err != nil {
 			return nil, err
@@ -342,7 +393,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
@@ -648,6 +699,9 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
 			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
 			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+			dbx.Node_ExitSuccess(false),
+			dbx.Node_CountryCode(""),
+			dbx.Node_Asn(0),
 			dbx.Node_Create_Fields{
 				Disqualified: dbx.Node_Disqualified_Null(),
 			},
@@ -671,6 +725,67 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 	return Error.Wrap(tx.Commit())
 }
 
+// UpdateLocation updates the geographic and network location of a node
+func (cache *overlaycache) UpdateLocation(ctx context.Context, nodeID storj.NodeID, location geoip.Location) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		CountryCode: dbx.Node_CountryCode(location.CountryCode),
+		Asn:         dbx.Node_Asn(int64(location.ASN)),
+	})
+	return Error.Wrap(err)
+}
+
+// DisqualifyNode disqualifies a storagenode, an earlier disqualification and its reason are kept
+func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason pb.DisqualificationReason) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errs.Combine(overlay.ErrNodeNotFound.New(nodeID.String()), Error.Wrap(tx.Rollback()))
+		}
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+	if dbNode.Disqualified != nil {
+		return Error.Wrap(tx.Commit())
+	}
+
+	_, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified:           dbx.Node_Disqualified(time.Now().UTC()),
+		DisqualificationReason: dbx.Node_DisqualificationReason(int(reason)),
+	})
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+	return Error.Wrap(tx.Commit())
+}
+
+// ReinstateNode lifts the disqualification of a storagenode and resets its reputation
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults overlay.NodeSelectionConfig) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified:           dbx.Node_Disqualified_Null(),
+		DisqualificationReason: dbx.Node_DisqualificationReason_Null(),
+		AuditReputationAlpha:   dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+		AuditReputationBeta:    dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+		UptimeReputationAlpha:  dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+		UptimeReputationBeta:   dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return overlay.ErrNodeNotFound.New(nodeID.String())
+	}
+	return nil
+}
+
 // UpdateStats a single storagenode's stats in the db
 func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.UpdateRequest) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -721,16 +836,18 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
 	}
 
-	auditRep := auditAlpha / (auditAlpha + auditBeta)
-	if auditRep <= updateReq.AuditDQ {
-		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
-	}
-
-	uptimeRep := uptimeAlpha / (uptimeAlpha + uptimeBeta)
-	if uptimeRep <= updateReq.UptimeDQ {
-		// n.b. that this will overwrite the audit DQ timestamp
-		// if it has already been set.
-		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+	// only the first disqualification is recorded, failing audits takes precedence
+	if dbNode.Disqualified == nil {
+		switch {
+		case overlay.ReputationScore(auditAlpha, auditBeta) <= updateReq.AuditDQ:
+			updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+			updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(pb.DisqualificationReason_AUDIT_FAILURE))
+		case overlay.ReputationScore(uptimeAlpha, uptimeBeta) <= updateReq.UptimeDQ:
+			updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+			updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(pb.DisqualificationReason_OFFLINE))
+		default:
+			updateSuspension(dbNode, overlay.ReputationScore(auditAlpha, auditBeta), overlay.ReputationScore(uptimeAlpha, uptimeBeta), updateReq.Suspension, &updateFields)
+		}
 	}
 
 	if updateReq.IsUp {
@@ -768,6 +885,30 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
+// updateSuspension suspends the node while one of its reputation scores is below
+// the suspension cut-off and disqualifies it when it hasn't recovered within the
+// grace period. A recovered node is no longer suspended.
+func updateSuspension(dbNode *dbx.Node, auditScore, uptimeScore float64, config overlay.SuspensionConfig, updateFields *dbx.Node_Update_Fields) {
+	auditSuspended := auditScore < config.AuditReputation
+	uptimeSuspended := uptimeScore < config.UptimeReputation
+
+	switch {
+	case !auditSuspended && !uptimeSuspended:
+		if dbNode.Suspended != nil {
+			updateFields.Suspended = dbx.Node_Suspended_Null()
+		}
+	case dbNode.Suspended == nil:
+		updateFields.Suspended = dbx.Node_Suspended(time.Now().UTC())
+	case time.Since(*dbNode.Suspended) > config.GracePeriod:
+		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+		if auditSuspended {
+			updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(pb.DisqualificationReason_AUDIT_FAILURE))
+		} else {
+			updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(pb.DisqualificationReason_OFFLINE))
+		}
+	}
+}
+
 // UpdateNodeInfo updates the email and wallet for a given node ID for satellite payments.
 func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.NodeID, nodeInfo *pb.InfoResponse) (stats *overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -808,7 +949,7 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64, suspension overlay.SuspensionConfig) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -840,9 +981,12 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 
-	uptimeRep := uptimeAlpha / (uptimeAlpha + uptimeBeta)
-	if uptimeRep <= uptimeDQ {
+	if overlay.ReputationScore(uptimeAlpha, uptimeBeta) <= uptimeDQ {
 		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+		updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(pb.DisqualificationReason_OFFLINE))
+	} else {
+		auditScore := overlay.ReputationScore(dbNode.AuditReputationAlpha, dbNode.AuditReputationBeta)
+		updateSuspension(dbNode, auditScore, overlay.ReputationScore(uptimeAlpha, uptimeBeta), suspension, &updateFields)
 	}
 
 	lastContactSuccess := dbNode.LastContactSuccess
@@ -889,6 +1033,43 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
+// UpdateExitStatus is used to update a node's graceful exit status.
+func (cache *overlaycache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID := request.NodeID
+
+	updateFields := populateExitStatusFields(request)
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("unable to get node by ID: %v", nodeID)
+	}
+
+	return convertDBNode(ctx, dbNode)
+}
+
+func populateExitStatusFields(request *overlay.ExitStatusRequest) dbx.Node_Update_Fields {
+	dbxUpdateFields := dbx.Node_Update_Fields{}
+
+	if !request.ExitInitiatedAt.IsZero() {
+		dbxUpdateFields.ExitInitiatedAt = dbx.Node_ExitInitiatedAt(request.ExitInitiatedAt)
+	}
+	if !request.ExitFinishedAt.IsZero() {
+		dbxUpdateFields.ExitFinishedAt = dbx.Node_ExitFinishedAt(request.ExitFinishedAt)
+		dbxUpdateFields.ExitSuccess = dbx.Node_ExitSuccess(request.ExitSuccess)
+		if request.ExitSuccess {
+			dbxUpdateFields.ExitFailureReason = dbx.Node_ExitFailureReason_Null()
+		} else {
+			dbxUpdateFields.ExitFailureReason = dbx.Node_ExitFailureReason(int(request.ExitFailureReason))
+		}
+	}
+
+	return dbxUpdateFields
+}
+
 func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if info == nil {
@@ -930,8 +1111,21 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 			Timestamp:  info.Timestamp,
 			Release:    info.Release,
 		},
-		Contained:    info.Contained,
-		Disqualified: info.Disqualified,
+		Contained:              info.Contained,
+		Disqualified:           info.Disqualified,
+		DisqualificationReason: disqualificationReason(info),
+		Suspended:              info.Suspended,
+		ExitStatus: overlay.ExitStatus{
+			NodeID:            id,
+			ExitInitiatedAt:   info.ExitInitiatedAt,
+			ExitFinishedAt:    info.ExitFinishedAt,
+			ExitSuccess:       info.ExitSuccess,
+			ExitFailureReason: exitFailureReason(info),
+		},
+		Location: geoip.Location{
+			CountryCode: info.CountryCode,
+			ASN:         uint32(info.Asn),
+		},
 	}
 
 	return node, nil
@@ -959,20 +1153,41 @@ func convertDBNodeToPBNode(ctx context.Context, info *dbx.Id_LastNet_Address_Pro
 
 func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 	nodeStats := &overlay.NodeStats{
-		Latency90:             dbNode.Latency90,
-		AuditCount:            dbNode.TotalAuditCount,
-		UptimeCount:           dbNode.TotalUptimeCount,
-		LastContactSuccess:    dbNode.LastContactSuccess,
-		LastContactFailure:    dbNode.LastContactFailure,
-		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
-		AuditReputationBeta:   dbNode.AuditReputationBeta,
-		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
-		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
-		Disqualified:          dbNode.Disqualified,
+		Latency90:              dbNode.Latency90,
+		AuditSuccessCount:      dbNode.AuditSuccessCount,
+		AuditCount:             dbNode.TotalAuditCount,
+		UptimeSuccessCount:     dbNode.UptimeSuccessCount,
+		UptimeCount:            dbNode.TotalUptimeCount,
+		LastContactSuccess:     dbNode.LastContactSuccess,
+		LastContactFailure:     dbNode.LastContactFailure,
+		AuditReputationAlpha:   dbNode.AuditReputationAlpha,
+		AuditReputationBeta:    dbNode.AuditReputationBeta,
+		UptimeReputationAlpha:  dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:   dbNode.UptimeReputationBeta,
+		Disqualified:           dbNode.Disqualified,
+		DisqualificationReason: disqualificationReason(dbNode),
+		Suspended:              dbNode.Suspended,
 	}
 	return nodeStats
 }
 
+// disqualificationReason returns the reason the node was disqualified for,
+// nodes disqualified before the reason was recorded have an unknown reason.
+func disqualificationReason(dbNode *dbx.Node) pb.DisqualificationReason {
+	if dbNode.DisqualificationReason == nil {
+		return pb.DisqualificationReason_UNKNOWN
+	}
+	return pb.DisqualificationReason(*dbNode.DisqualificationReason)
+}
+
+func exitFailureReason(dbNode *dbx.Node) pb.ExitFailed_Reason {
+	// exits finished before the reason was recorded only failed on the failure percentage
+	if dbNode.ExitFailureReason == nil {
+		return pb.ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED
+	}
+	return pb.ExitFailed_Reason(*dbNode.ExitFailureReason)
+}
+
 // updateReputation uses the Beta distribution model to determine a node's reputation.
 // lambda is the "forgetting factor" which determines how much past info is kept when determining current reputation score.
 // w is the normalization weight that affects how severely new updates affect the current reputation distribution.
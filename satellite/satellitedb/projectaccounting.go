@@ -124,3 +124,17 @@ func (db *ProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectI
 	}
 	return memory.Size(project.UsageLimit), nil
 }
+
+// GetProjectLimits returns the usage, storage and bandwidth limits of a project
+func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		return accounting.ProjectLimits{}, err
+	}
+	return accounting.ProjectLimits{
+		Usage:     memory.Size(project.UsageLimit),
+		Storage:   memory.Size(project.StorageLimit),
+		Bandwidth: memory.Size(project.BandwidthLimit),
+	}, nil
+}
@@ -75,14 +75,21 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 		return nil, err
 	}
 
+	createFields := dbx.Project_Create_Fields{
+		PartnerId: dbx.Project_PartnerId(project.PartnerID[:]),
+	}
+	if !project.OwnerID.IsZero() {
+		createFields.OwnerId = dbx.Project_OwnerId(project.OwnerID[:])
+	}
+
 	createdProject, err := projects.db.Create_Project(ctx,
 		dbx.Project_Id(projectID[:]),
 		dbx.Project_Name(project.Name),
 		dbx.Project_Description(project.Description),
 		dbx.Project_UsageLimit(0),
-		dbx.Project_Create_Fields{
-			PartnerId: dbx.Project_PartnerId(project.PartnerID[:]),
-		},
+		dbx.Project_StorageLimit(0),
+		dbx.Project_BandwidthLimit(0),
+		createFields,
 	)
 
 	if err != nil {
@@ -106,8 +113,10 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 	defer mon.Task()(&ctx)(&err)
 
 	updateFields := dbx.Project_Update_Fields{
-		Description: dbx.Project_Description(project.Description),
-		UsageLimit:  dbx.Project_UsageLimit(project.UsageLimit),
+		Description:    dbx.Project_Description(project.Description),
+		UsageLimit:     dbx.Project_UsageLimit(project.UsageLimit),
+		StorageLimit:   dbx.Project_StorageLimit(project.StorageLimit),
+		BandwidthLimit: dbx.Project_BandwidthLimit(project.BandwidthLimit),
 	}
 
 	_, err = projects.db.Update_Project_By_Id(ctx,
@@ -131,10 +140,20 @@ func projectFromDBX(ctx context.Context, project *dbx.Project) (_ *console.Proje
 	}
 
 	u := &console.Project{
-		ID:          id,
-		Name:        project.Name,
-		Description: project.Description,
-		CreatedAt:   project.CreatedAt,
+		ID:             id,
+		Name:           project.Name,
+		Description:    project.Description,
+		UsageLimit:     project.UsageLimit,
+		StorageLimit:   project.StorageLimit,
+		BandwidthLimit: project.BandwidthLimit,
+		CreatedAt:      project.CreatedAt,
+	}
+
+	if project.OwnerId != nil {
+		u.OwnerID, err = bytesToUUID(project.OwnerId)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return u, nil
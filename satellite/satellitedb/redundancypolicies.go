@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that redundancyPolicies implements console.RedundancyPolicies.
+var _ console.RedundancyPolicies = (*redundancyPolicies)(nil)
+
+// redundancyPolicies is an implementation of console.RedundancyPolicies
+type redundancyPolicies struct {
+	db      *dbx.DB
+	methods dbx.Methods
+}
+
+// Get is a method for querying the redundancy policy of a bucket from the database.
+func (policies *redundancyPolicies) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ *console.RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPolicy, err := policies.methods.Get_RedundancyPolicy_By_ProjectId_And_BucketName(ctx,
+		dbx.RedundancyPolicy_ProjectId(projectID[:]),
+		dbx.RedundancyPolicy_BucketName(policyBucketName(bucketName)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, console.ErrNoRedundancyPolicy.Wrap(err)
+		}
+		return nil, err
+	}
+
+	return fromDBXRedundancyPolicy(dbxPolicy)
+}
+
+// GetByProjectID is a method for querying all redundancy policies of a project from the database.
+func (policies *redundancyPolicies) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPolicies, err := policies.methods.All_RedundancyPolicy_By_ProjectId_OrderBy_Asc_BucketName(ctx,
+		dbx.RedundancyPolicy_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []console.RedundancyPolicy
+	for _, dbxPolicy := range dbxPolicies {
+		policy, err := fromDBXRedundancyPolicy(dbxPolicy)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *policy)
+	}
+
+	return result, nil
+}
+
+// Set is a method for inserting or replacing a redundancy policy in the database.
+func (policies *redundancyPolicies) Set(ctx context.Context, policy console.RedundancyPolicy) (_ *console.RedundancyPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	set := func(ctx context.Context, methods dbx.Methods) (*dbx.RedundancyPolicy, error) {
+		_, err := methods.Delete_RedundancyPolicy_By_ProjectId_And_BucketName(ctx,
+			dbx.RedundancyPolicy_ProjectId(policy.ProjectID[:]),
+			dbx.RedundancyPolicy_BucketName(policyBucketName(policy.BucketName)))
+		if err != nil {
+			return nil, err
+		}
+
+		return methods.Create_RedundancyPolicy(ctx,
+			dbx.RedundancyPolicy_ProjectId(policy.ProjectID[:]),
+			dbx.RedundancyPolicy_BucketName(policyBucketName(policy.BucketName)),
+			dbx.RedundancyPolicy_RequiredShares(policy.RequiredShares),
+			dbx.RedundancyPolicy_RepairShares(policy.RepairShares),
+			dbx.RedundancyPolicy_OptimalShares(policy.OptimalShares),
+			dbx.RedundancyPolicy_TotalShares(policy.TotalShares),
+			dbx.RedundancyPolicy_ShareSize(policy.ShareSize))
+	}
+
+	var dbxPolicy *dbx.RedundancyPolicy
+	if policies.db == nil {
+		// already within a transaction
+		dbxPolicy, err = set(ctx, policies.methods)
+	} else {
+		err = policies.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+			dbxPolicy, err = set(ctx, tx)
+			return err
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXRedundancyPolicy(dbxPolicy)
+}
+
+// Delete is a method for deleting the redundancy policy of a bucket or project from the database.
+func (policies *redundancyPolicies) Delete(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = policies.methods.Delete_RedundancyPolicy_By_ProjectId_And_BucketName(ctx,
+		dbx.RedundancyPolicy_ProjectId(projectID[:]),
+		dbx.RedundancyPolicy_BucketName(policyBucketName(bucketName)))
+	return err
+}
+
+// policyBucketName returns the bucket name as it is stored in the database,
+// where the policy of the whole project has an empty, but not null, bucket name.
+func policyBucketName(bucketName []byte) []byte {
+	if bucketName == nil {
+		return []byte{}
+	}
+	return bucketName
+}
+
+// fromDBXRedundancyPolicy converts *dbx.RedundancyPolicy to *console.RedundancyPolicy
+func fromDBXRedundancyPolicy(dbxPolicy *dbx.RedundancyPolicy) (*console.RedundancyPolicy, error) {
+	projectID, err := bytesToUUID(dbxPolicy.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.RedundancyPolicy{
+		ProjectID:      projectID,
+		BucketName:     dbxPolicy.BucketName,
+		RequiredShares: dbxPolicy.RequiredShares,
+		RepairShares:   dbxPolicy.RepairShares,
+		OptimalShares:  dbxPolicy.OptimalShares,
+		TotalShares:    dbxPolicy.TotalShares,
+		ShareSize:      dbxPolicy.ShareSize,
+		CreatedAt:      dbxPolicy.CreatedAt,
+	}, nil
+}
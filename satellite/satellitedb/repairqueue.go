@@ -10,9 +10,8 @@ import (
 
 	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/zeebo/errs"
 
-	"storj.io/storj/internal/dbutil/pgutil"
-	"storj.io/storj/internal/dbutil/sqliteutil"
 	"storj.io/storj/pkg/pb"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -22,16 +21,14 @@ type repairQueue struct {
 	db *dbx.DB
 }
 
-func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment) (err error) {
+func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment, numHealthy int) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = r.db.ExecContext(ctx, r.db.Rebind(`INSERT INTO injuredsegments ( path, data ) VALUES ( ?, ? )`), seg.Path, seg)
-	if err != nil {
-		if pgutil.IsConstraintError(err) || sqliteutil.IsConstraintError(err) {
-			return nil // quietly fail on reinsert
-		}
-		return err
-	}
-	return nil
+	// on reinsert only the priority is updated, the segment keeps its attempted time
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`
+		INSERT INTO injuredsegments ( path, data, num_healthy_pieces ) VALUES ( ?, ?, ? )
+		ON CONFLICT ( path ) DO UPDATE SET num_healthy_pieces = ?`),
+		seg.Path, seg, numHealthy, numHealthy)
+	return err
 }
 
 func (r *repairQueue) postgresSelect(ctx context.Context) (seg *pb.InjuredSegment, err error) {
@@ -40,7 +37,7 @@ func (r *repairQueue) postgresSelect(ctx context.Context) (seg *pb.InjuredSegmen
 	UPDATE injuredsegments SET attempted = timezone('utc', now()) WHERE path = (
 		SELECT path FROM injuredsegments
 		WHERE attempted IS NULL OR attempted < timezone('utc', now()) - interval '1 hour'
-		ORDER BY attempted NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
+		ORDER BY num_healthy_pieces ASC, attempted NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
 	) RETURNING data`).Scan(&seg)
 	if err == sql.ErrNoRows {
 		err = storage.ErrEmptyQueue.New("")
@@ -56,7 +53,7 @@ func (r *repairQueue) sqliteSelect(ctx context.Context) (seg *pb.InjuredSegment,
 			SELECT path, data FROM injuredsegments
 			WHERE attempted IS NULL
 			OR attempted < datetime('now','-1 hours')
-			ORDER BY attempted LIMIT 1`)).Scan(&path, &seg)
+			ORDER BY num_healthy_pieces ASC, attempted LIMIT 1`)).Scan(&path, &seg)
 		if err != nil {
 			return err
 		}
@@ -102,8 +99,12 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []pb.Injured
 	if limit <= 0 || limit > storage.LookupLimit {
 		limit = storage.LookupLimit
 	}
-	//todo: strictly enforce order-by or change tests
-	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`SELECT data FROM injuredsegments LIMIT ?`), limit)
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`SELECT data FROM injuredsegments ORDER BY num_healthy_pieces ASC, path LIMIT ?`), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
 	for rows.Next() {
 		var seg pb.InjuredSegment
 		err = rows.Scan(&seg)
@@ -114,3 +115,28 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []pb.Injured
 	}
 	return segs, rows.Err()
 }
+
+func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM injuredsegments`).Scan(&count)
+	return count, err
+}
+
+func (r *repairQueue) CountByHealthyPieces(ctx context.Context) (counts map[int]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+	rows, err := r.db.QueryContext(ctx, `SELECT num_healthy_pieces, COUNT(*) FROM injuredsegments GROUP BY num_healthy_pieces`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts = make(map[int]int)
+	for rows.Next() {
+		var numHealthy, count int
+		if err := rows.Scan(&numHealthy, &count); err != nil {
+			return nil, err
+		}
+		counts[numHealthy] = count
+	}
+	return counts, rows.Err()
+}
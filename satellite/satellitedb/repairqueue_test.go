@@ -28,11 +28,15 @@ func TestRepairQueue(t *testing.T) {
 		for i := 0; i < 100; i++ {
 			path := "/path/" + string(i)
 			injuredSeg := &pb.InjuredSegment{Path: []byte(path)}
-			err := repairQueue.Insert(ctx, injuredSeg)
+			err := repairQueue.Insert(ctx, injuredSeg, 10)
 			require.NoError(t, err)
 			pathsMap[path] = 0
 		}
 
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 100, count)
+
 		// select segments until no more are returned, and we should get each one exactly once
 		for {
 			injuredSeg, err := repairQueue.Select(ctx)
@@ -222,7 +222,7 @@ func (db *StoragenodeAccounting) QueryNodeDailySpaceUsage(ctx context.Context, n
 		var atRestTotal float64
 		var startTime time.Time
 
-		err = rows.Scan(atRestTotal, startTime)
+		err = rows.Scan(&atRestTotal, &startTime)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
@@ -30,13 +30,36 @@ UPLINK_DEBUG_ADDR=""
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" mb "sj://$BUCKET/"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "$SRC_DIR/small-upload-testfile" "sj://$BUCKET/"
-uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "$SRC_DIR/big-upload-testfile" "sj://$BUCKET/"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp --resume "$SRC_DIR/big-upload-testfile" "sj://$BUCKET/"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "sj://$BUCKET/small-upload-testfile" "$DST_DIR"
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "sj://$BUCKET/big-upload-testfile" "$DST_DIR"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/small-upload-testfile"
-uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/big-upload-testfile"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" mv "sj://$BUCKET/big-upload-testfile" "sj://$BUCKET/big-moved-testfile"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "sj://$BUCKET/big-moved-testfile" "$DST_DIR"
+
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/big-moved-testfile"
+
+SYNC_SRC_DIR=$TMPDIR/sync-source
+SYNC_DST_DIR=$TMPDIR/sync-dst
+mkdir -p "$SYNC_SRC_DIR/nested"
+cp "$SRC_DIR/small-upload-testfile" "$SYNC_SRC_DIR/"
+cp "$SRC_DIR/big-upload-testfile" "$SYNC_SRC_DIR/nested/"
+
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync "$SYNC_SRC_DIR" "sj://$BUCKET/sync"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync "sj://$BUCKET/sync" "$SYNC_DST_DIR"
+
+if diff -r "$SYNC_SRC_DIR" "$SYNC_DST_DIR"
+then
+    echo "synced directory matches source directory"
+else
+    echo "synced directory does not match source directory"
+    exit 1
+fi
+
+rm -rf "${SYNC_SRC_DIR:?}"/*
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync --delete "$SYNC_SRC_DIR" "sj://$BUCKET/sync"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" ls "sj://$BUCKET"
 
@@ -58,6 +81,14 @@ else
     exit 1
 fi
 
+if cmp "$SRC_DIR/big-upload-testfile" "$DST_DIR/big-moved-testfile"
+then
+    echo "big moved testfile matches uploaded file"
+else
+    echo "big moved testfile does not match uploaded file"
+    exit 1
+fi
+
 # check if all data files were removed
 # FILES=$(find "$STORAGENODE_0_DIR/../" -type f -path "*/blob/*" ! -name "info.*")
 # if [ -z "$FILES" ];
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -54,6 +55,13 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Trash moves the blob with the namespace and key to the trash
+	Trash(ctx context.Context, ref BlobRef) error
+	// RestoreTrash moves all blobs in the trash of the namespace back to the permanent storage
+	RestoreTrash(ctx context.Context, namespace []byte) error
+	// EmptyTrash deletes all blobs of the namespace that were moved to the trash before trashedBefore,
+	// it returns the keys of the deleted blobs
+	EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (deletedKeys [][]byte, err error)
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
 }
@@ -153,6 +153,36 @@ func (client *Client) PutAndCommit(ctx context.Context, key storage.Key, value s
 	})
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		if data == nil {
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New(key.String())
+			}
+			if newValue == nil {
+				return nil
+			}
+			return Error.Wrap(bucket.Put(key, newValue))
+		}
+
+		if oldValue == nil || !bytes.Equal(storage.Value(data), oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		if newValue == nil {
+			return Error.Wrap(bucket.Delete(key))
+		}
+		return Error.Wrap(bucket.Put(key, newValue))
+	})
+}
+
 // Get looks up the provided key from boltdb returning either an error or the result.
 func (client *Client) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -11,7 +11,9 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -43,6 +45,7 @@ func NewDir(path string) (*Dir, error) {
 		os.MkdirAll(dir.blobsdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.garbagedir(), dirPermission),
+		os.MkdirAll(dir.trashdir(), dirPermission),
 	)
 }
 
@@ -52,6 +55,7 @@ func (dir *Dir) Path() string { return dir.path }
 func (dir *Dir) blobsdir() string   { return filepath.Join(dir.path, "blobs") }
 func (dir *Dir) tempdir() string    { return filepath.Join(dir.path, "temp") }
 func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage") }
+func (dir *Dir) trashdir() string   { return filepath.Join(dir.path, "trash") }
 
 // CreateTemporaryFile creates a preallocated temporary file in the temp directory
 // prealloc preallocates file to make writing faster
@@ -83,6 +87,17 @@ func (dir *Dir) DeleteTemporary(ctx context.Context, file *os.File) (err error)
 
 // blobToPath converts blob reference to a filepath in permanent storage
 func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
+	return refToDirPath(ref, dir.blobsdir())
+}
+
+// blobToTrashedPath converts blob reference to a filepath in the trash,
+// where it's kept until it's either restored or the trash is emptied
+func (dir *Dir) blobToTrashedPath(ref storage.BlobRef) (string, error) {
+	return refToDirPath(ref, dir.trashdir())
+}
+
+// refToDirPath converts blob reference to a filepath in the specified sub-directory
+func refToDirPath(ref storage.BlobRef, subDir string) (string, error) {
 	if !ref.IsValid() {
 		return "", storage.ErrInvalidBlobRef.New("")
 	}
@@ -93,7 +108,16 @@ func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
 		// ensure we always have at least
 		key = "11" + key
 	}
-	return filepath.Join(dir.blobsdir(), namespace, key[:2], key[2:]), nil
+	return filepath.Join(subDir, namespace, key[:2], key[2:]), nil
+}
+
+// pathToKey converts the key directory and file name back to a blob key
+func pathToKey(keyPrefix, name string) ([]byte, error) {
+	key := keyPrefix + name
+	if strings.HasPrefix(key, "11") {
+		key = key[2:]
+	}
+	return pathEncoding.DecodeString(key)
 }
 
 // blobToTrashPath converts blob reference to a filepath in transient storage
@@ -206,6 +230,152 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	return err
 }
 
+// Trash moves the file with the specified ref to the trash
+func (dir *Dir) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+
+	trashPath, err := dir.blobToTrashedPath(ref)
+	if err != nil {
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(trashPath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, trashPath)
+	// ignore concurrent deletes and files that are already in the trash
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the modification time is used to determine how long the file has been in the trash
+	now := time.Now()
+	return os.Chtimes(trashPath, now, now)
+}
+
+// RestoreTrash moves all files in the trash of the namespace back to the permanent storage
+func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.walkTrash(ctx, namespace, func(key []byte, path string, info os.FileInfo) error {
+		blobPath, err := dir.blobToPath(storage.BlobRef{
+			Namespace: namespace,
+			Key:       key,
+		})
+		if err != nil {
+			return err
+		}
+
+		mkdirErr := os.MkdirAll(filepath.Dir(blobPath), dirPermission)
+		if mkdirErr != nil && !os.IsExist(mkdirErr) {
+			return mkdirErr
+		}
+
+		err = rename(path, blobPath)
+		// ignore concurrent restores
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	})
+}
+
+// EmptyTrash deletes all files in the trash of the namespace that were trashed before trashedBefore
+func (dir *Dir) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (deletedKeys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = dir.walkTrash(ctx, namespace, func(key []byte, path string, info os.FileInfo) error {
+		if !info.ModTime().Before(trashedBefore) {
+			return nil
+		}
+
+		err := os.Remove(path)
+		// ignore concurrent deletes
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		deletedKeys = append(deletedKeys, key)
+		return nil
+	})
+	return deletedKeys, err
+}
+
+// walkTrash calls fn for every file in the trash of the namespace,
+// errors returned by fn are collected and don't stop the walk
+func (dir *Dir) walkTrash(ctx context.Context, namespace []byte, fn func(key []byte, path string, info os.FileInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	nsdir := filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(namespace))
+
+	keyPrefixes, err := readDirNames(nsdir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, keyPrefix := range keyPrefixes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		keydir := filepath.Join(nsdir, keyPrefix)
+		names, err := readDirNames(keydir)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		for _, name := range names {
+			path := filepath.Join(keydir, name)
+			info, err := os.Lstat(path)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				errlist.Add(err)
+				continue
+			}
+			if !info.Mode().IsRegular() {
+				continue
+			}
+
+			key, err := pathToKey(keyPrefix, name)
+			if err != nil {
+				errlist.Add(Error.New("invalid trash file name %q: %v", path, err))
+				continue
+			}
+
+			errlist.Add(fn(key, path, info))
+		}
+	}
+
+	return errlist.Err()
+}
+
+// readDirNames returns the names of all entries in the directory
+func readDirNames(path string) (_ []string, err error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, dir.Close()) }()
+
+	return dir.Readdirnames(-1)
+}
+
 // GarbageCollect collects files that are pending deletion
 func (dir *Dir) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
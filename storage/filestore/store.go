@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -64,6 +65,27 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error)
 	return Error.Wrap(err)
 }
 
+// Trash moves the blob with the specified ref to the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.Trash(ctx, ref)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all blobs in the trash of the namespace back to the permanent storage
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.RestoreTrash(ctx, namespace)
+	return Error.Wrap(err)
+}
+
+// EmptyTrash deletes all blobs of the namespace that were trashed before trashedBefore
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	deletedKeys, err := store.dir.EmptyTrash(ctx, namespace, trashedBefore)
+	return deletedKeys, Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -219,3 +220,75 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTrashAndRestore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	otherNamespace := testrand.Bytes(32)
+
+	writeBlob := func(ref storage.BlobRef, data []byte) {
+		writer, err := store.Create(ctx, ref, int64(len(data)))
+		require.NoError(t, err)
+
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	data := testrand.Bytes(1 << 10)
+	trashed := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	kept := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	other := storage.BlobRef{Namespace: otherNamespace, Key: testrand.Bytes(32)}
+	for _, ref := range []storage.BlobRef{trashed, kept, other} {
+		writeBlob(ref, data)
+	}
+
+	// trashing removes the blob from the permanent storage
+	require.NoError(t, store.Trash(ctx, trashed))
+	_, err = store.Open(ctx, trashed)
+	require.True(t, os.IsNotExist(err))
+
+	// trashing a missing blob is not an error
+	require.NoError(t, store.Trash(ctx, trashed))
+
+	// restoring brings the blob back
+	require.NoError(t, store.RestoreTrash(ctx, namespace))
+	reader, err := store.Open(ctx, trashed)
+	require.NoError(t, err)
+	restored, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, data, restored)
+
+	// blobs trashed after trashedBefore are not deleted
+	require.NoError(t, store.Trash(ctx, trashed))
+	require.NoError(t, store.Trash(ctx, other))
+	deleted, err := store.EmptyTrash(ctx, namespace, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+
+	// emptying deletes only the blobs of the namespace
+	deleted, err = store.EmptyTrash(ctx, namespace, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{trashed.Key}, deleted)
+
+	require.NoError(t, store.RestoreTrash(ctx, namespace))
+	_, err = store.Open(ctx, trashed)
+	require.True(t, os.IsNotExist(err))
+
+	// blobs that were never trashed are not affected
+	reader, err = store.Open(ctx, kept)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	// the other namespace still has its trash
+	require.NoError(t, store.RestoreTrash(ctx, otherNamespace))
+	reader, err = store.Open(ctx, other)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+}
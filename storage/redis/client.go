@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"bytes"
 	"context"
 	"net/url"
 	"sort"
@@ -105,6 +106,60 @@ func (client *Client) Put(ctx context.Context, key storage.Key, value storage.Va
 	return nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	txf := func(tx *redis.Tx) error {
+		value, err := tx.Get(key.String()).Bytes()
+		if err == redis.Nil {
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New(key.String())
+			}
+		} else if err != nil {
+			return Error.New("get error: %v", err)
+		} else if oldValue == nil || !bytes.Equal(value, oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if newValue == nil {
+				pipe.Del(key.String())
+			} else {
+				pipe.Set(key.String(), []byte(newValue), client.TTL)
+			}
+			return nil
+		})
+		if err == redis.TxFailedErr {
+			return storage.ErrValueChanged.New(key.String())
+		}
+		if err != nil {
+			return Error.New("compare and swap error: %v", err)
+		}
+		return nil
+	}
+
+	return client.db.Watch(txf, key.String())
+}
+
+// IncrBy atomically increments the integer value stored at key by value,
+// creating the key with a value of 0 first if it does not exist.
+func (client *Client) IncrBy(ctx context.Context, key storage.Key, value int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	err = client.db.IncrBy(key.String(), value).Err()
+	if err != nil {
+		return Error.New("incrby error: %v", err)
+	}
+	return nil
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -187,6 +242,27 @@ func (client *Client) Iterate(ctx context.Context, opts storage.IterateOptions,
 	})
 }
 
+// DeletePrefix deletes all keys starting with prefix, other keys in the
+// database are left untouched.
+func (client *Client) DeletePrefix(ctx context.Context, prefix storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if prefix.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	match := string(escapeMatch([]byte(prefix))) + "*"
+	it := client.db.Scan(0, match, 0).Iterator()
+	for it.Next() {
+		if err := client.db.Del(it.Val()).Err(); err != nil {
+			return Error.New("delete error: %v", err)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return Error.New("scan error: %v", err)
+	}
+	return nil
+}
+
 // FlushDB deletes all keys in the currently selected DB.
 func (client *Client) FlushDB() error {
 	_, err := client.db.FlushDB().Result()
@@ -59,6 +59,15 @@ func (store *Logger) Delete(ctx context.Context, key storage.Key) (err error) {
 	return store.store.Delete(ctx, key)
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (store *Logger) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	store.log.Debug("CompareAndSwap", zap.ByteString("key", key),
+		zap.Int("old value length", len(oldValue)), zap.Int("new value length", len(newValue)),
+		zap.Binary("truncated old value", truncate(oldValue)), zap.Binary("truncated new value", truncate(newValue)))
+	return store.store.CompareAndSwap(ctx, key, oldValue, newValue)
+}
+
 // List lists all keys starting from first and upto limit items
 func (store *Logger) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("compare-and-swap")
+	defer func() { _ = store.Delete(ctx, key) }()
+
+	t.Run("missing key", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("old"), storage.Value("new"))
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("swapping a missing key should fail with key not found: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, nil, nil)
+		if err != nil {
+			t.Fatalf("deleting a missing key should succeed: %v", err)
+		}
+	})
+
+	t.Run("create", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, nil, storage.Value("first"))
+		if err != nil {
+			t.Fatalf("failed to create %q: %v", key, err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, nil, storage.Value("again"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("creating an existing key should fail with value changed: %v", err)
+		}
+		checkValue(t, store, key, storage.Value("first"))
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("other"), storage.Value("second"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("swapping a different value should fail with value changed: %v", err)
+		}
+		checkValue(t, store, key, storage.Value("first"))
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("first"), storage.Value("second"))
+		if err != nil {
+			t.Fatalf("failed to swap %q: %v", key, err)
+		}
+		checkValue(t, store, key, storage.Value("second"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("first"), nil)
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("deleting a different value should fail with value changed: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("second"), nil)
+		if err != nil {
+			t.Fatalf("failed to delete %q: %v", key, err)
+		}
+
+		_, err = store.Get(ctx, key)
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("%q should be deleted: %v", key, err)
+		}
+	})
+}
+
+func checkValue(t *testing.T, store storage.KeyValueStore, key storage.Key, expected storage.Value) {
+	value, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("failed to get %q: %v", key, err)
+	}
+	if !bytes.Equal(value, expected) {
+		t.Fatalf("invalid value for %q: got %q, expected %q", key, value, expected)
+	}
+}
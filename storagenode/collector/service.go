@@ -1,13 +1,14 @@
 // Copyright (C) 2019 Storj Labs, Inc.
 // See LICENSE for copying information.
 
-// Package collector implements expired piece deletion from storage node.
+// Package collector implements expired and trashed piece deletion from storage node.
 package collector
 
 import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
@@ -15,13 +16,15 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/trust"
 )
 
 var mon = monkit.Package()
 
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	Interval       time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	TrashRetention time.Duration `help:"how long pieces are kept in the trash before they are permanently deleted" default:"168h0m0s"`
 }
 
 // Service implements collecting expired pieces on the storage node.
@@ -30,18 +33,23 @@ type Service struct {
 	pieces      *pieces.Store
 	pieceinfos  pieces.DB
 	usedSerials piecestore.UsedSerials
+	trust       *trust.Pool
+
+	trashRetention time.Duration
 
 	Loop sync2.Cycle
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, usedSerials piecestore.UsedSerials, config Config) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, usedSerials piecestore.UsedSerials, trust *trust.Pool, config Config) *Service {
 	return &Service{
-		log:         log,
-		pieces:      pieces,
-		pieceinfos:  pieceinfos,
-		usedSerials: usedSerials,
-		Loop:        *sync2.NewCycle(config.Interval),
+		log:            log,
+		pieces:         pieces,
+		pieceinfos:     pieceinfos,
+		usedSerials:    usedSerials,
+		trust:          trust,
+		trashRetention: config.TrashRetention,
+		Loop:           *sync2.NewCycle(config.Interval),
 	}
 }
 
@@ -50,10 +58,15 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
-		err := service.Collect(ctx, time.Now())
+		now := time.Now()
+		err := service.Collect(ctx, now)
 		if err != nil {
 			service.log.Error("error during collecting pieces: ", zap.Error(err))
 		}
+		err = service.EmptyTrash(ctx, now)
+		if err != nil {
+			service.log.Error("error during emptying trash: ", zap.Error(err))
+		}
 		return nil
 	})
 }
@@ -116,3 +129,37 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 
 	return nil
 }
+
+// EmptyTrash permanently deletes pieces that have been in the trash longer than the retention period.
+func (service *Service) EmptyTrash(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trashedBefore := now.Add(-service.trashRetention)
+
+	var count int64
+	defer func() {
+		if count > 0 {
+			service.log.Info("empty trash", zap.Int64("count", count))
+		}
+	}()
+
+	var errlist errs.Group
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		pieceIDs, err := service.pieces.EmptyTrash(ctx, satelliteID, trashedBefore)
+		if err != nil {
+			// some pieces may have been deleted regardless of the error
+			errlist.Add(err)
+		}
+
+		for _, pieceID := range pieceIDs {
+			err := service.pieceinfos.Delete(ctx, satelliteID, pieceID)
+			if err != nil {
+				service.log.Error("unable to delete piece info", zap.Stringer("satellite id", satelliteID), zap.Stringer("piece id", pieceID), zap.Error(err))
+				continue
+			}
+			count++
+		}
+	}
+
+	return errlist.Err()
+}
@@ -125,3 +125,54 @@ func TestCollector(t *testing.T) {
 		require.Equal(t, 0, serialsPresent)
 	})
 }
+
+func TestCollectorEmptyTrash(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		storageNode.Collector.Loop.Pause()
+
+		err := planet.Uplinks[0].UploadWithConfig(ctx, satellite,
+			&uplink.RSConfig{
+				MinThreshold:     1,
+				RepairThreshold:  1,
+				SuccessThreshold: 1,
+				MaxThreshold:     1,
+			},
+			"testbucket", "test/path",
+			testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		pieceinfos := storageNode.DB.PieceInfo()
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, satellite.ID(), time.Now().Add(time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Len(t, pieceIDs, 1)
+		pieceID := pieceIDs[0]
+
+		err = storageNode.Storage2.Store.Trash(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+
+		// pieces still within the retention period are kept
+		err = storageNode.Collector.EmptyTrash(ctx, time.Now())
+		require.NoError(t, err)
+
+		_, err = pieceinfos.Get(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+
+		// imagine we are 8 days in the future
+		err = storageNode.Collector.EmptyTrash(ctx, time.Now().Add(8*24*time.Hour))
+		require.NoError(t, err)
+
+		_, err = pieceinfos.Get(ctx, satellite.ID(), pieceID)
+		require.Error(t, err)
+
+		// nothing is left to restore
+		err = storageNode.Storage2.Store.RestoreTrash(ctx, satellite.ID())
+		require.NoError(t, err)
+
+		_, err = storageNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+		require.Error(t, err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/nodestats"
+	"storj.io/storj/storagenode/payouts"
 )
 
 const (
@@ -49,18 +51,39 @@ type DashboardResponse struct {
 
 // DashboardData stores all needed information about storagenode
 type DashboardData struct {
-	Bandwidth          console.BandwidthInfo       `json:"bandwidth"`
-	DiskSpace          console.DiskSpaceInfo       `json:"diskSpace"`
-	WalletAddress      string                      `json:"walletAddress"`
-	VersionInfo        version.Info                `json:"versionInfo"`
-	IsLastVersion      bool                        `json:"isLastVersion"`
-	Uptime             time.Duration               `json:"uptime"`
-	NodeID             string                      `json:"nodeId"`
-	Satellites         storj.NodeIDList            `json:"satellites"`
-	UptimeCheck        nodestats.ReputationStats   `json:"uptimeCheck"`
-	AuditCheck         nodestats.ReputationStats   `json:"auditCheck"`
-	BandwidthChartData []console.BandwidthUsed     `json:"bandwidthChartData"`
-	DiskSpaceChartData []nodestats.SpaceUsageStamp `json:"diskSpaceChartData"`
+	Bandwidth              console.BandwidthInfo       `json:"bandwidth"`
+	DiskSpace              console.DiskSpaceInfo       `json:"diskSpace"`
+	WalletAddress          string                      `json:"walletAddress"`
+	VersionInfo            version.Info                `json:"versionInfo"`
+	IsLastVersion          bool                        `json:"isLastVersion"`
+	Uptime                 time.Duration               `json:"uptime"`
+	NodeID                 string                      `json:"nodeId"`
+	Satellites             storj.NodeIDList            `json:"satellites"`
+	UptimeCheck            nodestats.ReputationStats   `json:"uptimeCheck"`
+	AuditCheck             nodestats.ReputationStats   `json:"auditCheck"`
+	Disqualified           *time.Time                  `json:"disqualified"`
+	DisqualificationReason string                      `json:"disqualificationReason,omitempty"`
+	Suspended              *time.Time                  `json:"suspended"`
+	BandwidthChartData     []console.BandwidthUsed     `json:"bandwidthChartData"`
+	DiskSpaceChartData     []nodestats.SpaceUsageStamp `json:"diskSpaceChartData"`
+}
+
+// PayoutsResponse stores payout estimates and error message
+type PayoutsResponse struct {
+	Data  []payouts.Estimate `json:"data"`
+	Error string             `json:"error,omitempty"`
+}
+
+// SatellitesResponse stores trusted satellites and error message
+type SatellitesResponse struct {
+	Data  []console.SatelliteInfo `json:"data"`
+	Error string                  `json:"error,omitempty"`
+}
+
+// SatelliteHistoryResponse stores satellite history and error message
+type SatelliteHistoryResponse struct {
+	Data  *console.SatelliteHistory `json:"data"`
+	Error string                    `json:"error,omitempty"`
 }
 
 // Server represents storagenode console web server
@@ -93,6 +116,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, list
 		mux.Handle("/static/", http.StripPrefix("/static", fs))
 		mux.Handle("/", http.HandlerFunc(server.appHandler))
 		mux.Handle("/api/dashboard/", http.HandlerFunc(server.dashboardHandler))
+		mux.Handle("/api/payouts/estimate/", http.HandlerFunc(server.payoutEstimateHandler))
+		mux.Handle("/api/payouts/history/", http.HandlerFunc(server.payoutHistoryHandler))
+		mux.Handle("/api/satellites/", http.HandlerFunc(server.satellitesHandler))
+		mux.Handle("/api/satellite/history/", http.HandlerFunc(server.satelliteHistoryHandler))
 	}
 
 	server.server = http.Server{
@@ -172,6 +199,140 @@ func (server *Server) dashboardHandler(writer http.ResponseWriter, request *http
 	writer.WriteHeader(http.StatusOK)
 }
 
+// payoutEstimateHandler returns estimated payouts of the current month
+func (server *Server) payoutEstimateHandler(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	defer mon.Task()(&ctx)(nil)
+	server.servePayouts(writer, request, server.service.GetPayoutEstimates)
+}
+
+// payoutHistoryHandler returns stored monthly payout estimates
+func (server *Server) payoutHistoryHandler(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	defer mon.Task()(&ctx)(nil)
+	server.servePayouts(writer, request, server.service.GetPayoutHistory)
+}
+
+// servePayouts writes the estimates returned by get for the optional satelliteId query parameter
+func (server *Server) servePayouts(writer http.ResponseWriter, request *http.Request, get func(context.Context, *storj.NodeID) ([]payouts.Estimate, error)) {
+	ctx := request.Context()
+	writer.Header().Set(contentType, applicationJSON)
+
+	var response = PayoutsResponse{}
+
+	defer func() {
+		err := json.NewEncoder(writer).Encode(&response)
+		if err != nil {
+			server.log.Error(err.Error())
+		}
+	}()
+
+	if request.Method != http.MethodGet {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	satelliteIDParam := request.URL.Query().Get("satelliteId")
+	satelliteID, err := server.parseSatelliteIDParam(satelliteIDParam)
+	if err != nil {
+		server.log.Error("satellite id is not valid", zap.Error(err))
+		response.Error = "satellite id is not valid"
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	estimates, err := get(ctx, satelliteID)
+	if err != nil {
+		server.log.Error("can not get payouts", zap.Error(err))
+		response.Error = err.Error()
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Data = estimates
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// satellitesHandler returns the most recent cached stats of every trusted satellite
+func (server *Server) satellitesHandler(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	defer mon.Task()(&ctx)(nil)
+	writer.Header().Set(contentType, applicationJSON)
+
+	var response = SatellitesResponse{}
+
+	defer func() {
+		err := json.NewEncoder(writer).Encode(&response)
+		if err != nil {
+			server.log.Error(err.Error())
+		}
+	}()
+
+	if request.Method != http.MethodGet {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	satellites, err := server.service.GetTrustedSatellites(ctx)
+	if err != nil {
+		server.log.Error("can not get satellites", zap.Error(err))
+		response.Error = err.Error()
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Data = satellites
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// satelliteHistoryHandler returns daily reputation and usage of a satellite for the current month
+func (server *Server) satelliteHistoryHandler(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	defer mon.Task()(&ctx)(nil)
+	writer.Header().Set(contentType, applicationJSON)
+
+	var response = SatelliteHistoryResponse{}
+
+	defer func() {
+		err := json.NewEncoder(writer).Encode(&response)
+		if err != nil {
+			server.log.Error(err.Error())
+		}
+	}()
+
+	if request.Method != http.MethodGet {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	satelliteID, err := server.parseSatelliteIDParam(request.URL.Query().Get("satelliteId"))
+	if err != nil || satelliteID == nil {
+		server.log.Error("satellite id is not valid", zap.Error(err))
+		response.Error = "satellite id is not valid"
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	from, to := date.MonthBoundary()
+	history, err := server.service.GetSatelliteHistory(ctx, *satelliteID, from, to)
+	if err != nil {
+		server.log.Error("can not get satellite history", zap.Error(err))
+		response.Error = err.Error()
+		if console.ErrUntrustedSatellite.Has(err) {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Data = history
+
+	writer.WriteHeader(http.StatusOK)
+}
+
 func (server *Server) getDashboardData(ctx context.Context, satelliteID *storj.NodeID) (DashboardData, error) {
 	var response = DashboardData{}
 
@@ -211,25 +372,31 @@ func (server *Server) getDashboardData(ctx context.Context, satelliteID *storj.N
 		return response, err
 	}
 
-	// TODO: uncomment in future, when caching will be implemented
-	// diskSpaceChartData, err := server.getDiskSpaceChartData(ctx, satelliteID, satellites)
-	// if err != nil {
-	// 	return response, err
-	// }
+	diskSpaceChartData, err := server.getDiskSpaceChartData(ctx, satelliteID, satellites)
+	if err != nil {
+		return response, err
+	}
 
 	uptime := server.service.GetUptime(ctx)
 	nodeID := server.service.GetNodeID(ctx)
 
-	// TODO: uncomment in future, when caching will be implemented
-	// if satelliteID != nil {
-	// 	satelliteStats, err := server.service.GetStatsFromSatellite(ctx, *satelliteID)
-	// 	if err != nil {
-	// 		return response, err
-	// 	}
-	//
-	// 	response.UptimeCheck = satelliteStats.UptimeCheck
-	// 	response.AuditCheck = satelliteStats.AuditCheck
-	// }
+	if satelliteID != nil {
+		satelliteStats, err := server.service.GetStatsFromSatellite(ctx, *satelliteID)
+		if err != nil {
+			return response, err
+		}
+
+		// stats are missing until the satellite is queried for the first time
+		if satelliteStats != nil {
+			response.UptimeCheck = satelliteStats.UptimeCheck
+			response.AuditCheck = satelliteStats.AuditCheck
+			response.Disqualified = satelliteStats.Disqualified
+			if satelliteStats.Disqualified != nil {
+				response.DisqualificationReason = satelliteStats.DisqualificationReason.String()
+			}
+			response.Suspended = satelliteStats.Suspended
+		}
+	}
 
 	response.DiskSpace = *space
 	response.Bandwidth = *usage
@@ -240,7 +407,7 @@ func (server *Server) getDashboardData(ctx context.Context, satelliteID *storj.N
 	response.NodeID = nodeID.String()
 	response.Satellites = satellites
 	response.BandwidthChartData = bandwidthChartData
-	//response.DiskSpaceChartData = diskSpaceChartData
+	response.DiskSpaceChartData = diskSpaceChartData
 
 	return response, nil
 }
@@ -263,6 +430,44 @@ func (server *Server) getBandwidthChartData(ctx context.Context, satelliteID *st
 	return server.service.GetDailyTotalBandwidthUsed(ctx, from, to)
 }
 
+func (server *Server) getDiskSpaceChartData(ctx context.Context, satelliteID *storj.NodeID, satellites storj.NodeIDList) (_ []nodestats.SpaceUsageStamp, err error) {
+	from, to := date.MonthBoundary()
+
+	if satelliteID != nil {
+		return server.service.GetDailyStorageUsedForSatellite(ctx, *satelliteID, from, to)
+	}
+
+	// sum the daily usage of all satellites
+	var days []time.Time
+	totals := make(map[time.Time]float64)
+	for _, id := range satellites {
+		stamps, err := server.service.GetDailyStorageUsedForSatellite(ctx, id, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stamp := range stamps {
+			day, _ := date.DayBoundary(stamp.TimeStamp)
+			if _, ok := totals[day]; !ok {
+				days = append(days, day)
+			}
+			totals[day] += stamp.AtRestTotal
+		}
+	}
+
+	sort.Slice(days, func(i, k int) bool { return days[i].Before(days[k]) })
+
+	var diskSpaceChartData []nodestats.SpaceUsageStamp
+	for _, day := range days {
+		diskSpaceChartData = append(diskSpaceChartData, nodestats.SpaceUsageStamp{
+			AtRestTotal: totals[day],
+			TimeStamp:   day,
+		})
+	}
+
+	return diskSpaceChartData, nil
+}
+
 func (server *Server) getStorage(ctx context.Context, satelliteID *storj.NodeID) (_ *console.DiskSpaceInfo, err error) {
 	if satelliteID != nil {
 		return server.service.GetUsedStorageBySatellite(ctx, *satelliteID)
@@ -0,0 +1,24 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/nodestats"
+)
+
+// SatelliteInfo stores the most recent cached stats of a trusted satellite
+type SatelliteInfo struct {
+	ID storj.NodeID `json:"id"`
+	// Stats is nil when the satellite hasn't been queried yet
+	Stats *nodestats.Stats `json:"stats"`
+}
+
+// SatelliteHistory stores daily reputation and usage history of a satellite
+type SatelliteHistory struct {
+	ID             storj.NodeID                `json:"id"`
+	Reputation     []nodestats.Stats           `json:"reputation"`
+	StorageDaily   []nodestats.SpaceUsageStamp `json:"storageDaily"`
+	BandwidthDaily []BandwidthUsed             `json:"bandwidthDaily"`
+}
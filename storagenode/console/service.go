@@ -5,6 +5,7 @@ package console
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -17,12 +18,16 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/nodestats"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
 	// SNOServiceErr defines sno service error
 	SNOServiceErr = errs.Class("storage node dashboard service error")
+	// ErrUntrustedSatellite is returned when the requested satellite isn't trusted
+	ErrUntrustedSatellite = errs.Class("untrusted satellite")
 
 	mon = monkit.Package()
 )
@@ -49,7 +54,9 @@ type Service struct {
 	pieceInfoDB pieces.DB
 	kademlia    *kademlia.Kademlia
 	version     *version.Service
-	nodestats   *nodestats.Service
+	nodestatsDB nodestats.DB
+	trust       *trust.Pool
+	payouts     *payouts.Service
 
 	allocatedBandwidth memory.Size
 	allocatedDiskSpace memory.Size
@@ -60,7 +67,7 @@ type Service struct {
 
 // NewService returns new instance of Service
 func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceInfo pieces.DB, kademlia *kademlia.Kademlia, version *version.Service,
-	nodestats *nodestats.Service, allocatedBandwidth, allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info) (*Service, error) {
+	nodestatsDB nodestats.DB, trust *trust.Pool, payouts *payouts.Service, allocatedBandwidth, allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -85,6 +92,18 @@ func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceInfo
 		return nil, errs.New("kademlia can't be nil")
 	}
 
+	if nodestatsDB == nil {
+		return nil, errs.New("nodestatsDB can't be nil")
+	}
+
+	if trust == nil {
+		return nil, errs.New("trust can't be nil")
+	}
+
+	if payouts == nil {
+		return nil, errs.New("payouts can't be nil")
+	}
+
 	return &Service{
 		log:                log,
 		consoleDB:          consoleDB,
@@ -92,7 +111,9 @@ func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceInfo
 		pieceInfoDB:        pieceInfo,
 		kademlia:           kademlia,
 		version:            version,
-		nodestats:          nodestats,
+		nodestatsDB:        nodestatsDB,
+		trust:              trust,
+		payouts:            payouts,
 		allocatedBandwidth: allocatedBandwidth,
 		allocatedDiskSpace: allocatedDiskSpace,
 		walletAddress:      walletAddress,
@@ -178,11 +199,12 @@ func (s *Service) GetUptime(ctx context.Context) time.Duration {
 	return time.Now().Sub(s.startedAt)
 }
 
-// GetStatsFromSatellite returns storagenode stats from the satellite
+// GetStatsFromSatellite returns the most recent storagenode stats cached from the satellite,
+// nil when the satellite hasn't been queried yet
 func (s *Service) GetStatsFromSatellite(ctx context.Context, satelliteID storj.NodeID) (_ *nodestats.Stats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	stats, err := s.nodestats.GetStatsFromSatellite(ctx, satelliteID)
+	stats, err := s.nodestatsDB.GetStats(ctx, satelliteID)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
 	}
@@ -190,11 +212,11 @@ func (s *Service) GetStatsFromSatellite(ctx context.Context, satelliteID storj.N
 	return stats, nil
 }
 
-// GetDailyStorageUsedForSatellite returns daily SpaceUsageStamps for a particular satellite
+// GetDailyStorageUsedForSatellite returns daily SpaceUsageStamps cached from a particular satellite
 func (s *Service) GetDailyStorageUsedForSatellite(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []nodestats.SpaceUsageStamp, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	stamps, err := s.nodestats.GetDailyStorageUsedForSatellite(ctx, satelliteID, from, to)
+	stamps, err := s.nodestatsDB.GetDailyStorageUsage(ctx, satelliteID, from, to)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
 	}
@@ -202,6 +224,96 @@ func (s *Service) GetDailyStorageUsedForSatellite(ctx context.Context, satellite
 	return stamps, nil
 }
 
+// GetTrustedSatellites returns the most recent cached stats of every trusted satellite
+func (s *Service) GetTrustedSatellites(ctx context.Context) (_ []SatelliteInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteIDs := storj.NodeIDList(s.trust.GetSatellites(ctx))
+	sort.Sort(satelliteIDs)
+
+	satellites := make([]SatelliteInfo, 0, len(satelliteIDs))
+	for _, satelliteID := range satelliteIDs {
+		stats, err := s.nodestatsDB.GetStats(ctx, satelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+
+		satellites = append(satellites, SatelliteInfo{
+			ID:    satelliteID,
+			Stats: stats,
+		})
+	}
+
+	return satellites, nil
+}
+
+// GetSatelliteHistory returns daily reputation, storage and bandwidth usage
+// of a trusted satellite for provided time range, sorted in ascending order
+func (s *Service) GetSatelliteHistory(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ *SatelliteHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.trust.VerifySatelliteID(ctx, satelliteID); err != nil {
+		return nil, ErrUntrustedSatellite.Wrap(err)
+	}
+
+	reputation, err := s.nodestatsDB.GetStatsHistory(ctx, satelliteID, from, to)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	storage, err := s.nodestatsDB.GetDailyStorageUsage(ctx, satelliteID, from, to)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	bandwidth, err := s.consoleDB.GetDailyBandwidthUsed(ctx, satelliteID, from, to)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	return &SatelliteHistory{
+		ID:             satelliteID,
+		Reputation:     reputation,
+		StorageDaily:   storage,
+		BandwidthDaily: bandwidth,
+	}, nil
+}
+
+// GetPayoutEstimates returns estimated payouts of the current month for every satellite,
+// or for the provided satellite only
+func (s *Service) GetPayoutEstimates(ctx context.Context, satelliteID *storj.NodeID) (_ []payouts.Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	estimates, err := s.payouts.Estimate(ctx, time.Now())
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	if satelliteID == nil {
+		return estimates, nil
+	}
+
+	for _, estimate := range estimates {
+		if estimate.SatelliteID == *satelliteID {
+			return []payouts.Estimate{estimate}, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetPayoutHistory returns stored monthly payout estimates for every satellite,
+// or for the provided satellite only, most recent month first
+func (s *Service) GetPayoutHistory(ctx context.Context, satelliteID *storj.NodeID) (_ []payouts.Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	estimates, err := s.payouts.History(ctx, satelliteID)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	return estimates, nil
+}
+
 // GetNodeID return current node id
 func (s *Service) GetNodeID(ctx context.Context) storj.NodeID {
 	defer mon.Task()(&ctx)(nil)
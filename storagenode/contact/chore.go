@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for contact
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Config defines configuration for checking in with satellites.
+type Config struct {
+	Interval time.Duration `help:"how frequently the node checks in with the satellites" default:"1h0m0s"`
+	Timeout  time.Duration `help:"timeout for checking in with a satellite" default:"1m0s"`
+}
+
+// Chore periodically checks in with all trusted satellites,
+// announcing the address, capacity, operator and version of the node.
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new contact chore
+func NewChore(log *zap.Logger, config Config, transport transport.Client, kademlia *kademlia.Kademlia, trust *trust.Pool) *Chore {
+	return &Chore{
+		log:       log,
+		config:    config,
+		transport: transport,
+		kademlia:  kademlia,
+		trust:     trust,
+		Loop:      *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks in with the satellites on every interval
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, chore.RunOnce)
+}
+
+// RunOnce checks in with all trusted satellites concurrently
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites := chore.trust.GetSatellites(ctx)
+	if len(satellites) == 0 {
+		chore.log.Debug("No trusted satellites configured. No satellites to check in with")
+		return nil
+	}
+
+	var group errgroup.Group
+	for _, satellite := range satellites {
+		satellite := satellite
+		group.Go(func() error {
+			err := chore.CheckIn(ctx, satellite)
+			if err != nil {
+				chore.log.Error("Error checking in with satellite", zap.Stringer("satellite", satellite), zap.Error(err))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+
+	return nil
+}
+
+// CheckIn sends the current node info to a satellite
+func (chore *Chore) CheckIn(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, chore.config.Timeout)
+	defer cancel()
+
+	address, err := chore.trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	self := chore.kademlia.Local()
+	resp, err := pb.NewNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+		Address:  self.Address.GetAddress(),
+		Capacity: &self.Capacity,
+		Operator: &self.Operator,
+		Version:  &self.Version,
+	})
+	if err != nil {
+		return Error.New("failed to check in: %v", err)
+	}
+
+	if !resp.PingNodeSuccess {
+		chore.log.Warn("Satellite was unable to ping the node back",
+			zap.Stringer("satellite", satelliteID),
+			zap.String("reason", resp.PingErrorMessage))
+	}
+	return nil
+}
+
+// Close stops the contact chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
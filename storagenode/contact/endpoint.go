@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint answers pings from satellites verifying that the node is reachable
+type Endpoint struct {
+	log      *zap.Logger
+	kademlia *kademlia.Kademlia
+}
+
+// NewEndpoint creates a new contact endpoint
+func NewEndpoint(log *zap.Logger, kademlia *kademlia.Kademlia) *Endpoint {
+	return &Endpoint{
+		log:      log,
+		kademlia: kademlia,
+	}
+}
+
+// PingNode responds to a ping from a satellite
+func (endpoint *Endpoint) PingNode(ctx context.Context, req *pb.ContactPingRequest) (_ *pb.ContactPingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	endpoint.log.Debug("pinged", zap.Stringer("by", peer.ID))
+
+	endpoint.kademlia.Pinged()
+	return &pb.ContactPingResponse{}, nil
+}
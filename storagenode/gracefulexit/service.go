@@ -0,0 +1,282 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error is the default error class for graceful exit
+	Error = errs.Class("graceful exit error")
+	// ErrExitFailed is returned when the satellite reports a failed graceful exit
+	ErrExitFailed = errs.Class("graceful exit failed")
+	// ErrUntrusted is returned when exiting a satellite that isn't trusted
+	ErrUntrusted = errs.Class("graceful exit untrusted satellite")
+	// ErrInProgress is returned when starting an exit from a satellite that is already being exited
+	ErrInProgress = errs.Class("graceful exit in progress")
+
+	mon = monkit.Package()
+)
+
+// Service transfers the pieces of a satellite to other storage nodes when gracefully exiting
+type Service struct {
+	log        *zap.Logger
+	store      *pieces.Store
+	pieceinfos pieces.DB
+	trust      *trust.Pool
+	transport  transport.Client
+
+	start   chan storj.NodeID
+	mu      sync.Mutex
+	exiting map[storj.NodeID]struct{}
+}
+
+// NewService creates a new graceful exit service
+func NewService(log *zap.Logger, store *pieces.Store, pieceinfos pieces.DB, trust *trust.Pool, transport transport.Client) *Service {
+	return &Service{
+		log:        log,
+		store:      store,
+		pieceinfos: pieceinfos,
+		trust:      trust,
+		transport:  transport,
+
+		start:   make(chan storj.NodeID),
+		exiting: make(map[storj.NodeID]struct{}),
+	}
+}
+
+// Run runs the graceful exits started with Start until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	defer func() { _ = group.Wait() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case satelliteID := <-service.start:
+			group.Go(func() error {
+				defer service.finished(satelliteID)
+
+				if _, err := service.Exit(ctx, satelliteID); err != nil {
+					service.log.Error("graceful exit failed", zap.Stringer("satellite ID", satelliteID), zap.Error(err))
+				}
+				return nil
+			})
+		}
+	}
+}
+
+// Start starts gracefully exiting the satellite in the background, the outcome is logged.
+func (service *Service) Start(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.trust.VerifySatelliteID(ctx, satelliteID); err != nil {
+		return ErrUntrusted.Wrap(err)
+	}
+
+	service.mu.Lock()
+	_, exiting := service.exiting[satelliteID]
+	if !exiting {
+		service.exiting[satelliteID] = struct{}{}
+	}
+	service.mu.Unlock()
+	if exiting {
+		return ErrInProgress.New("satellite %s", satelliteID)
+	}
+
+	select {
+	case service.start <- satelliteID:
+		return nil
+	case <-ctx.Done():
+		service.finished(satelliteID)
+		return ctx.Err()
+	}
+}
+
+// finished allows exiting the satellite again.
+func (service *Service) finished(satelliteID storj.NodeID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	delete(service.exiting, satelliteID)
+}
+
+// Exit gracefully exits the satellite by transferring all pieces requested by the satellite.
+// It returns the exit completed receipt signed by the satellite.
+func (service *Service) Exit(ctx context.Context, satelliteID storj.NodeID) (_ *pb.ExitCompleted, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.trust.VerifySatelliteID(ctx, satelliteID); err != nil {
+		return nil, ErrUntrusted.Wrap(err)
+	}
+
+	address, err := service.trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return nil, Error.New("unable to connect to the satellite %s: %v", satelliteID, err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	stream, err := pb.NewSatelliteGracefulExitClient(conn).Process(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(ignoreEOF(stream.CloseSend()))) }()
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		switch m := response.GetMessage().(type) {
+		case *pb.SatelliteMessage_TransferPiece:
+			message := service.transfer(ctx, satelliteID, m.TransferPiece)
+			if err := stream.Send(message); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		case *pb.SatelliteMessage_ExitCompleted:
+			signee, err := service.trust.GetSignee(ctx, satelliteID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if err := signing.VerifyExitCompleted(ctx, signee, m.ExitCompleted); err != nil {
+				return nil, Error.Wrap(err)
+			}
+			service.log.Info("graceful exit completed", zap.Stringer("satellite ID", satelliteID))
+			return m.ExitCompleted, nil
+		case *pb.SatelliteMessage_ExitFailed:
+			signee, err := service.trust.GetSignee(ctx, satelliteID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if err := signing.VerifyExitFailed(ctx, signee, m.ExitFailed); err != nil {
+				return nil, Error.Wrap(err)
+			}
+			return nil, ErrExitFailed.New("satellite %s: %s", satelliteID, m.ExitFailed.Reason)
+		default:
+			return nil, Error.New("unknown satellite message: %v", response)
+		}
+	}
+}
+
+// transfer uploads a single piece to the storage node given in the order limit
+// and returns the message reporting the result to the satellite.
+func (service *Service) transfer(ctx context.Context, satelliteID storj.NodeID, transfer *pb.TransferPiece) *pb.StorageNodeMessage {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	pieceID := transfer.OriginalPieceId
+	log := service.log.With(zap.Stringer("satellite ID", satelliteID), zap.Stringer("piece ID", pieceID))
+
+	failed := func(code pb.TransferFailed_Error) *pb.StorageNodeMessage {
+		log.Warn("piece transfer failed", zap.Stringer("error", code), zap.Error(err))
+		return &pb.StorageNodeMessage{
+			Message: &pb.StorageNodeMessage_Failed{
+				Failed: &pb.TransferFailed{
+					OriginalPieceId: pieceID,
+					Error:           code,
+				},
+			},
+		}
+	}
+
+	addressedLimit := transfer.GetAddressedOrderLimit()
+	if addressedLimit.GetLimit() == nil {
+		err = Error.New("missing order limit")
+		return failed(pb.TransferFailed_UNKNOWN)
+	}
+	limit := addressedLimit.GetLimit()
+
+	// the satellite compares the original hash signed by the uplink with the replacement hash
+	info, err := service.pieceinfos.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return failed(pb.TransferFailed_NOT_FOUND)
+		}
+		return failed(pb.TransferFailed_UNKNOWN)
+	}
+
+	reader, err := service.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return failed(pb.TransferFailed_NOT_FOUND)
+		}
+		return failed(pb.TransferFailed_UNKNOWN)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	client, err := piecestore.Dial(ctx, service.transport, &pb.Node{
+		Id:      limit.StorageNodeId,
+		Address: addressedLimit.GetStorageNodeAddress(),
+	}, log, piecestore.DefaultConfig)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	upload, err := client.Upload(ctx, limit, transfer.PrivateKey)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE)
+	}
+
+	_, err = io.CopyN(upload, reader, reader.Size())
+	if err != nil {
+		err = errs.Combine(err, upload.Cancel(ctx))
+		return failed(pb.TransferFailed_UNKNOWN)
+	}
+
+	hash, err := upload.Commit(ctx)
+	if err != nil {
+		return failed(pb.TransferFailed_UNKNOWN)
+	}
+
+	return &pb.StorageNodeMessage{
+		Message: &pb.StorageNodeMessage_Succeeded{
+			Succeeded: &pb.TransferSucceeded{
+				OriginalPieceId:      pieceID,
+				ReplacementPieceHash: hash,
+				OriginalOrderLimit:   info.OrderLimit,
+				OriginalPieceHash:    info.UplinkPieceHash,
+			},
+		},
+	}
+}
+
+func ignoreEOF(err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
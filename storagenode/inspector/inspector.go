@@ -12,12 +12,16 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 )
@@ -35,6 +39,8 @@ type Endpoint struct {
 	pieceInfo pieces.DB
 	kademlia  *kademlia.Kademlia
 	usageDB   bandwidth.DB
+	payouts   *payouts.Service
+	exits     *gracefulexit.Service
 
 	startTime        time.Time
 	pieceStoreConfig piecestore.OldConfig
@@ -47,6 +53,8 @@ func NewEndpoint(
 	pieceInfo pieces.DB,
 	kademlia *kademlia.Kademlia,
 	usageDB bandwidth.DB,
+	payouts *payouts.Service,
+	exits *gracefulexit.Service,
 	pieceStoreConfig piecestore.OldConfig,
 	dashbaordAddress net.Addr) *Endpoint {
 
@@ -55,6 +63,8 @@ func NewEndpoint(
 		pieceInfo:        pieceInfo,
 		kademlia:         kademlia,
 		usageDB:          usageDB,
+		payouts:          payouts,
+		exits:            exits,
 		pieceStoreConfig: pieceStoreConfig,
 		dashboardAddress: dashbaordAddress,
 		startTime:        time.Now(),
@@ -118,6 +128,23 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		return &pb.DashboardResponse{}, Error.Wrap(err)
 	}
 
+	estimates, err := inspector.payouts.Estimate(ctx, time.Now())
+	if err != nil {
+		return &pb.DashboardResponse{}, Error.Wrap(err)
+	}
+
+	estimatedPayouts := make([]*pb.PayoutEstimate, len(estimates))
+	for i, estimate := range estimates {
+		estimatedPayouts[i] = &pb.PayoutEstimate{
+			SatelliteId:  estimate.SatelliteID,
+			Egress:       estimate.EgressPayout,
+			RepairEgress: estimate.RepairEgressPayout,
+			AuditEgress:  estimate.AuditEgressPayout,
+			Storage:      estimate.StoragePayout,
+			Total:        estimate.Total,
+		}
+	}
+
 	bootstrapNodes := inspector.kademlia.GetBootstrapNodes()
 	bsNodes := make([]string, len(bootstrapNodes))
 	for i, node := range bootstrapNodes {
@@ -135,6 +162,7 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		DashboardAddress: inspector.dashboardAddress.String(),
 		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
 		Stats:            statsSummary,
+		Payouts:          estimatedPayouts,
 	}, nil
 }
 
@@ -149,3 +177,22 @@ func (inspector *Endpoint) Dashboard(ctx context.Context, in *pb.DashboardReques
 	}
 	return data, nil
 }
+
+// StartGracefulExit starts gracefully exiting a satellite in the background
+func (inspector *Endpoint) StartGracefulExit(ctx context.Context, in *pb.StartGracefulExitRequest) (out *pb.StartGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = inspector.exits.Start(ctx, in.SatelliteId)
+	switch {
+	case err == nil:
+	case gracefulexit.ErrUntrusted.Has(err):
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	case gracefulexit.ErrInProgress.Has(err):
+		return nil, status.Errorf(codes.AlreadyExists, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	inspector.log.Info("graceful exit started", zap.Stringer("satellite ID", in.SatelliteId))
+	return &pb.StartGracefulExitResponse{}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/date"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/trust"
+)
+
+// DB caches the stats and storage usage received from the satellites.
+type DB interface {
+	// StoreStats inserts or replaces the stats of the satellite for the day of stats.UpdatedAt
+	StoreStats(ctx context.Context, stats Stats) error
+	// GetStats returns the most recent stats of the satellite, nil when there are none
+	GetStats(ctx context.Context, satelliteID storj.NodeID) (*Stats, error)
+	// GetStatsHistory returns daily stats of the satellite for provided time range,
+	// sorted in ascending order
+	GetStatsHistory(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) ([]Stats, error)
+	// StoreDailyStorageUsage inserts or replaces daily storage usage stamps
+	StoreDailyStorageUsage(ctx context.Context, stamps []SpaceUsageStamp) error
+	// GetDailyStorageUsage returns daily storage usage of the satellite for provided time range,
+	// sorted in ascending order
+	GetDailyStorageUsage(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) ([]SpaceUsageStamp, error)
+}
+
+// Config defines configuration for caching node stats.
+type Config struct {
+	Interval time.Duration `help:"how frequently the node stats are fetched from the satellites" default:"1h0m0s"`
+	Timeout  time.Duration `help:"timeout for fetching node stats from a satellite" default:"1m0s"`
+}
+
+// Cache periodically fetches the stats and daily storage usage
+// from all trusted satellites and stores them in the database.
+type Cache struct {
+	log    *zap.Logger
+	config Config
+
+	db      DB
+	service *Service
+	trust   *trust.Pool
+
+	Loop sync2.Cycle
+}
+
+// NewCache creates a new node stats cache
+func NewCache(log *zap.Logger, config Config, db DB, service *Service, trust *trust.Pool) *Cache {
+	return &Cache{
+		log:     log,
+		config:  config,
+		db:      db,
+		service: service,
+		trust:   trust,
+		Loop:    *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run periodically caches the node stats of all trusted satellites
+func (cache *Cache) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.Loop.Run(ctx, func(ctx context.Context) error {
+		for _, satelliteID := range cache.trust.GetSatellites(ctx) {
+			if err := cache.CacheSatellite(ctx, satelliteID); err != nil {
+				cache.log.Error("unable to cache node stats", zap.Stringer("satellite", satelliteID), zap.Error(err))
+			}
+		}
+		return nil
+	})
+}
+
+// CacheSatellite fetches and stores the stats and the daily storage usage
+// of the current month from the satellite
+func (cache *Cache) CacheSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, cache.config.Timeout)
+	defer cancel()
+
+	stats, err := cache.service.GetStatsFromSatellite(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+
+	from, to := date.MonthBoundary()
+	stamps, err := cache.service.GetDailyStorageUsedForSatellite(ctx, satelliteID, from, to)
+	if err != nil {
+		return err
+	}
+
+	return NodeStatsServiceErr.Wrap(errs.Combine(
+		cache.db.StoreStats(ctx, *stats),
+		cache.db.StoreDailyStorageUsage(ctx, stamps),
+	))
+}
+
+// Close stops the cache loop
+func (cache *Cache) Close() error {
+	cache.Loop.Close()
+	return nil
+}
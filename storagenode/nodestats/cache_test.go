@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/date"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestCache(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Discovery.Pause()
+		node.Contact.Chore.Loop.Pause()
+		node.NodeStats.Cache.Loop.Pause()
+
+		_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       node.ID(),
+			AuditSuccess: true,
+			IsUp:         true,
+		})
+		require.NoError(t, err)
+
+		today, _ := date.DayBoundary(time.Now().UTC())
+		err = satellite.DB.StoragenodeAccounting().SaveRollup(ctx, today, accounting.RollupStats{
+			today: {
+				node.ID(): &accounting.Rollup{
+					NodeID:      node.ID(),
+					StartTime:   today,
+					AtRestTotal: 1234,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		node.NodeStats.Cache.Loop.TriggerWait()
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+
+		stats, err := node.DB.NodeStats().GetStats(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+		require.Equal(t, satellite.ID(), stats.SatelliteID)
+		require.Equal(t, dossier.Reputation.AuditCount, stats.AuditCheck.TotalCount)
+		require.Equal(t, dossier.Reputation.AuditReputationScore(), stats.AuditCheck.ReputationScore)
+		require.Equal(t, dossier.Reputation.UptimeReputationScore(), stats.UptimeCheck.ReputationScore)
+		require.Nil(t, stats.Disqualified)
+
+		history, err := node.DB.NodeStats().GetStatsHistory(ctx, satellite.ID(), today, today)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+
+		from, to := date.MonthBoundary()
+		stamps, err := node.DB.NodeStats().GetDailyStorageUsage(ctx, satellite.ID(), from, to)
+		require.NoError(t, err)
+		require.Len(t, stamps, 1)
+		require.Equal(t, satellite.ID(), stamps[0].SatelliteID)
+		require.Equal(t, 1234.0, stamps[0].AtRestTotal)
+		require.True(t, today.Equal(stamps[0].TimeStamp))
+
+		{ // refreshing on the same day replaces the cached stats
+			node.NodeStats.Cache.Loop.TriggerWait()
+
+			history, err := node.DB.NodeStats().GetStatsHistory(ctx, satellite.ID(), today, today)
+			require.NoError(t, err)
+			require.Len(t, history, 1)
+			require.True(t, history[0].UpdatedAt.After(stats.UpdatedAt))
+		}
+
+		{ // the console serves the cached stats of trusted satellites only
+			satellites, err := node.Console.Service.GetTrustedSatellites(ctx)
+			require.NoError(t, err)
+			require.Len(t, satellites, 1)
+			require.Equal(t, satellite.ID(), satellites[0].ID)
+			require.NotNil(t, satellites[0].Stats)
+
+			history, err := node.Console.Service.GetSatelliteHistory(ctx, satellite.ID(), from, to)
+			require.NoError(t, err)
+			require.Len(t, history.Reputation, 1)
+			require.Len(t, history.StorageDaily, 1)
+
+			_, err = node.Console.Service.GetSatelliteHistory(ctx, storj.NodeID{1}, from, to)
+			require.Error(t, err)
+		}
+	})
+}
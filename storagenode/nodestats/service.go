@@ -12,10 +12,10 @@ import (
 	"google.golang.org/grpc"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
-	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
@@ -31,6 +31,15 @@ type Stats struct {
 
 	UptimeCheck ReputationStats
 	AuditCheck  ReputationStats
+
+	// Disqualified is nil when the node is in good standing with the satellite.
+	Disqualified           *time.Time
+	DisqualificationReason pb.DisqualificationReason
+	// Suspended is nil unless the satellite no longer selects the node for uploads
+	// because of its low reputation.
+	Suspended *time.Time
+
+	UpdatedAt time.Time
 }
 
 // ReputationStats encapsulates storagenode reputation metrics
@@ -67,15 +76,15 @@ type Service struct {
 	log *zap.Logger
 
 	transport transport.Client
-	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
 }
 
 // NewService creates new instance of service
-func NewService(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia) *Service {
+func NewService(log *zap.Logger, transport transport.Client, trust *trust.Pool) *Service {
 	return &Service{
 		log:       log,
 		transport: transport,
-		kademlia:  kademlia,
+		trust:     trust,
 	}
 }
 
@@ -118,6 +127,10 @@ func (s *Service) GetStatsFromSatellite(ctx context.Context, satelliteID storj.N
 			ReputationBeta:  audit.GetReputationBeta(),
 			ReputationScore: audit.GetReputationScore(),
 		},
+		Disqualified:           resp.GetDisqualified(),
+		DisqualificationReason: resp.GetDisqualificationReason(),
+		Suspended:              resp.GetSuspended(),
+		UpdatedAt:              time.Now().UTC(),
 	}, nil
 }
 
@@ -136,7 +149,10 @@ func (s *Service) GetDailyStorageUsedForSatellite(ctx context.Context, satellite
 		}
 	}()
 
-	resp, err := client.DailyStorageUsage(ctx, &pb.DailyStorageUsageRequest{})
+	resp, err := client.DailyStorageUsage(ctx, &pb.DailyStorageUsageRequest{
+		From: from,
+		To:   to,
+	})
 	if err != nil {
 		return nil, NodeStatsServiceErr.Wrap(err)
 	}
@@ -146,12 +162,18 @@ func (s *Service) GetDailyStorageUsedForSatellite(ctx context.Context, satellite
 
 // DialNodeStats dials GRPC NodeStats client for the satellite by id
 func (s *Service) DialNodeStats(ctx context.Context, satelliteID storj.NodeID) (*Client, error) {
-	satellite, err := s.kademlia.FindNode(ctx, satelliteID)
+	address, err := s.trust.GetAddress(ctx, satelliteID)
 	if err != nil {
 		return nil, errs.New("unable to find satellite %s: %v", satelliteID, err)
 	}
 
-	conn, err := s.transport.DialNode(ctx, &satellite)
+	conn, err := s.transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
 	if err != nil {
 		return nil, errs.New("unable to connect to the satellite %s: %v", satelliteID, err)
 	}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// DB persists monthly payout estimates.
+type DB interface {
+	// Store inserts or replaces the estimate for the satellite and period.
+	Store(ctx context.Context, estimate Estimate) error
+	// GetAll returns all stored estimates, most recent period first.
+	GetAll(ctx context.Context) ([]Estimate, error)
+	// GetBySatellite returns all stored estimates for the satellite, most recent period first.
+	GetBySatellite(ctx context.Context, satelliteID storj.NodeID) ([]Estimate, error)
+}
+
+// Estimate is the estimated payout from a single satellite for a single month.
+type Estimate struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	// Period is the first moment of the month in UTC.
+	Period time.Time `json:"period"`
+
+	Egress       int64 `json:"egress"`
+	RepairEgress int64 `json:"repairEgress"`
+	AuditEgress  int64 `json:"auditEgress"`
+	// AtRest is the amount of data stored for the satellite in byte-hours.
+	AtRest float64 `json:"atRest"`
+
+	// Payouts are in USD.
+	EgressPayout       float64 `json:"egressPayout"`
+	RepairEgressPayout float64 `json:"repairEgressPayout"`
+	AuditEgressPayout  float64 `json:"auditEgressPayout"`
+	StoragePayout      float64 `json:"storagePayout"`
+	Total              float64 `json:"total"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PeriodOf returns the first moment of the month containing t in UTC.
+func PeriodOf(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for payouts
+	Error = errs.Class("payouts")
+
+	mon = monkit.Package()
+)
+
+// Config contains the rates used for estimating payouts.
+type Config struct {
+	Interval time.Duration `help:"how frequently the payout estimate of the current month is stored" default:"1h0m0s"`
+
+	EgressPrice       float64 `help:"estimated payout in USD per TB of egress" default:"20"`
+	RepairEgressPrice float64 `help:"estimated payout in USD per TB of repair egress" default:"10"`
+	AuditEgressPrice  float64 `help:"estimated payout in USD per TB of audit egress" default:"10"`
+	StoragePrice      float64 `help:"estimated payout in USD per TB-month of stored data" default:"1.5"`
+}
+
+// Service estimates payouts from the local bandwidth and storage usage
+// and keeps a snapshot of the estimate for every month.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	db          DB
+	bandwidthDB bandwidth.DB
+	pieceInfoDB pieces.DB
+	trust       *trust.Pool
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new payouts service.
+func NewService(log *zap.Logger, config Config, db DB, bandwidthDB bandwidth.DB, pieceInfoDB pieces.DB, trust *trust.Pool) *Service {
+	return &Service{
+		log:         log,
+		config:      config,
+		db:          db,
+		bandwidthDB: bandwidthDB,
+		pieceInfoDB: pieceInfoDB,
+		trust:       trust,
+		Loop:        *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run periodically stores the estimate of the current month.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.StoreEstimates(ctx, time.Now())
+		if err != nil {
+			service.log.Error("unable to store payout estimates", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// StoreEstimates calculates the estimates of the month containing now
+// and stores them, replacing the previous snapshot of that month.
+func (service *Service) StoreEstimates(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	estimates, err := service.Estimate(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, estimate := range estimates {
+		errlist.Add(service.db.Store(ctx, estimate))
+	}
+	return Error.Wrap(errlist.Err())
+}
+
+// Estimate calculates the payout of every satellite for the month containing now,
+// using the usage from the beginning of the month until now.
+func (service *Service) Estimate(ctx context.Context, now time.Time) (_ []Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now = now.UTC()
+	period := PeriodOf(now)
+
+	usages, err := service.bandwidthDB.SummaryBySatellite(ctx, period, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	satellites := storj.NodeIDList(service.trust.GetSatellites(ctx))
+	for satelliteID := range usages {
+		if !containsID(satellites, satelliteID) {
+			satellites = append(satellites, satelliteID)
+		}
+	}
+	sort.Sort(satellites)
+
+	month := period.AddDate(0, 1, 0).Sub(period).Hours()
+
+	var estimates []Estimate
+	for _, satelliteID := range satellites {
+		// pieces uploaded during the month are only paid since their upload
+		atRest, err := service.pieceInfoDB.ByteHoursBySatellite(ctx, satelliteID, period, now)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		usage := usages[satelliteID]
+		if usage == nil {
+			usage = &bandwidth.Usage{}
+		}
+
+		estimate := Estimate{
+			SatelliteID:  satelliteID,
+			Period:       period,
+			Egress:       usage.Get,
+			RepairEgress: usage.GetRepair,
+			AuditEgress:  usage.GetAudit,
+			AtRest:       atRest,
+			UpdatedAt:    now,
+		}
+
+		estimate.EgressPayout = memory.Size(estimate.Egress).TB() * service.config.EgressPrice
+		estimate.RepairEgressPayout = memory.Size(estimate.RepairEgress).TB() * service.config.RepairEgressPrice
+		estimate.AuditEgressPayout = memory.Size(estimate.AuditEgress).TB() * service.config.AuditEgressPrice
+		estimate.StoragePayout = estimate.AtRest / month / memory.TB.Float64() * service.config.StoragePrice
+		estimate.Total = estimate.EgressPayout + estimate.RepairEgressPayout + estimate.AuditEgressPayout + estimate.StoragePayout
+
+		estimates = append(estimates, estimate)
+	}
+
+	return estimates, nil
+}
+
+// History returns the stored monthly estimates, most recent period first.
+// When satelliteID is nil estimates of all satellites are returned.
+func (service *Service) History(ctx context.Context, satelliteID *storj.NodeID) (_ []Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var estimates []Estimate
+	if satelliteID != nil {
+		estimates, err = service.db.GetBySatellite(ctx, *satelliteID)
+	} else {
+		estimates, err = service.db.GetAll(ctx)
+	}
+	return estimates, Error.Wrap(err)
+}
+
+func containsID(ids storj.NodeIDList, id storj.NodeID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestEstimate(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		trusted, untrusted := testrand.NodeID(), testrand.NodeID()
+		if untrusted.Less(trusted) {
+			trusted, untrusted = untrusted, trusted
+		}
+
+		pool, err := trust.NewPool(nil, storj.NodeURLs{{ID: trusted}})
+		require.NoError(t, err)
+
+		service := payouts.NewService(zaptest.NewLogger(t), payouts.Config{
+			Interval:          time.Hour,
+			EgressPrice:       20,
+			RepairEgressPrice: 10,
+			AuditEgressPrice:  10,
+			StoragePrice:      1.5,
+		}, db.Payouts(), db.Bandwidth(), db.PieceInfo(), pool)
+
+		// half of June has passed
+		now := time.Date(2019, time.June, 16, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC)
+		may := time.Date(2019, time.May, 20, 0, 0, 0, 0, time.UTC)
+
+		err = db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     trusted,
+			PieceID:         testrand.PieceID(),
+			PieceSize:       memory.TB.Int64(),
+			PieceCreation:   june,
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{},
+		})
+		require.NoError(t, err)
+
+		bandwidth := db.Bandwidth()
+		require.NoError(t, bandwidth.Add(ctx, trusted, pb.PieceAction_GET, memory.TB.Int64(), june.Add(time.Hour)))
+		require.NoError(t, bandwidth.Add(ctx, trusted, pb.PieceAction_GET_REPAIR, memory.TB.Int64()/2, june.Add(time.Hour)))
+		require.NoError(t, bandwidth.Add(ctx, trusted, pb.PieceAction_GET_AUDIT, memory.GB.Int64(), june.Add(time.Hour)))
+		require.NoError(t, bandwidth.Add(ctx, trusted, pb.PieceAction_PUT, memory.TB.Int64(), june.Add(time.Hour)))
+		require.NoError(t, bandwidth.Add(ctx, untrusted, pb.PieceAction_GET, memory.TB.Int64(), june.Add(time.Hour)))
+		// usage of previous months is not included
+		require.NoError(t, bandwidth.Add(ctx, untrusted, pb.PieceAction_GET, memory.TB.Int64(), may))
+
+		estimates, err := service.Estimate(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, estimates, 2)
+
+		estimate := estimates[0]
+		require.Equal(t, trusted, estimate.SatelliteID)
+		require.Equal(t, june, estimate.Period.UTC())
+		require.Equal(t, memory.TB.Int64(), estimate.Egress)
+		require.Equal(t, memory.TB.Int64()/2, estimate.RepairEgress)
+		require.Equal(t, memory.GB.Int64(), estimate.AuditEgress)
+		require.InDelta(t, 20, estimate.EgressPayout, 1e-9)
+		require.InDelta(t, 5, estimate.RepairEgressPayout, 1e-9)
+		require.InDelta(t, 0.01, estimate.AuditEgressPayout, 1e-9)
+		require.InDelta(t, 0.75, estimate.StoragePayout, 1e-9)
+		require.InDelta(t, 25.76, estimate.Total, 1e-9)
+
+		estimate = estimates[1]
+		require.Equal(t, untrusted, estimate.SatelliteID)
+		require.Equal(t, memory.TB.Int64(), estimate.Egress)
+		require.Zero(t, estimate.StoragePayout)
+		require.InDelta(t, 20, estimate.Total, 1e-9)
+
+		{ // snapshots are kept per month
+			require.NoError(t, service.StoreEstimates(ctx, now))
+			require.NoError(t, service.StoreEstimates(ctx, now.Add(time.Hour)))
+			require.NoError(t, service.StoreEstimates(ctx, now.AddDate(0, 1, 0)))
+
+			// the untrusted satellite has no usage in July
+			history, err := service.History(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, history, 3)
+			require.Equal(t, june.AddDate(0, 1, 0), history[0].Period.UTC())
+			require.Equal(t, june, history[1].Period.UTC())
+			require.Equal(t, now.Add(time.Hour), history[1].UpdatedAt.UTC())
+			require.Equal(t, june, history[2].Period.UTC())
+
+			history, err = service.History(ctx, &trusted)
+			require.NoError(t, err)
+			require.Len(t, history, 2)
+			for _, estimate := range history {
+				require.Equal(t, trusted, estimate.SatelliteID)
+			}
+			// the last snapshot of June includes one more hour of storage
+			require.InDelta(t, 25.76+1.5/(30*24), history[1].Total, 1e-9)
+		}
+	})
+}
+
+func TestEstimateMidMonthPieces(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		satelliteID := testrand.NodeID()
+
+		pool, err := trust.NewPool(nil, storj.NodeURLs{{ID: satelliteID}})
+		require.NoError(t, err)
+
+		service := payouts.NewService(zaptest.NewLogger(t), payouts.Config{
+			Interval:     time.Hour,
+			StoragePrice: 1.5,
+		}, db.Payouts(), db.Bandwidth(), db.PieceInfo(), pool)
+
+		now := time.Date(2019, time.June, 16, 0, 0, 0, 0, time.UTC)
+
+		addPiece := func(created, expires time.Time) {
+			err := db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         testrand.PieceID(),
+				PieceSize:       memory.TB.Int64(),
+				PieceCreation:   created,
+				PieceExpiration: expires,
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{},
+			})
+			require.NoError(t, err)
+		}
+
+		// stored since the previous month, only June is paid: 15 days
+		addPiece(time.Date(2019, time.May, 20, 0, 0, 0, 0, time.UTC), time.Time{})
+		// uploaded in the middle of the month: 6 days
+		addPiece(time.Date(2019, time.June, 10, 0, 0, 0, 0, time.UTC), time.Time{})
+		// expired in the middle of the month: 3 days
+		addPiece(time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.June, 4, 0, 0, 0, 0, time.UTC))
+		// uploaded after now: not paid yet
+		addPiece(now.Add(time.Hour), time.Time{})
+
+		estimates, err := service.Estimate(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, estimates, 1)
+
+		estimate := estimates[0]
+		require.InDelta(t, memory.TB.Float64()*24*(15+6+3), estimate.AtRest, 1)
+		require.InDelta(t, 1.5*(15+6+3)/30.0, estimate.StoragePayout, 1e-9)
+	})
+}
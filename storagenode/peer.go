@@ -29,10 +29,13 @@ import (
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/trust"
@@ -58,6 +61,8 @@ type DB interface {
 	UsedSerials() piecestore.UsedSerials
 	Vouchers() vouchers.DB
 	Console() console.DB
+	Payouts() payouts.DB
+	NodeStats() nodestats.DB
 
 	// TODO: use better interfaces
 	RoutingTable() (kdb, ndb, adb storage.KeyValueStore)
@@ -69,6 +74,7 @@ type Config struct {
 
 	Server   server.Config
 	Kademlia kademlia.Config
+	Contact  contact.Config
 
 	// TODO: flatten storage config and only keep the new one
 	Storage   piecestore.OldConfig
@@ -77,6 +83,10 @@ type Config struct {
 
 	Vouchers vouchers.Config
 
+	Payouts payouts.Config
+
+	NodeStats nodestats.Config
+
 	Console consoleserver.Config
 
 	Version version.Config
@@ -109,6 +119,11 @@ type Peer struct {
 		Inspector    *kademlia.Inspector
 	}
 
+	Contact struct {
+		Chore    *contact.Chore
+		Endpoint *contact.Endpoint
+	}
+
 	Storage2 struct {
 		// TODO: lift things outside of it to organize better
 		Trust     *trust.Pool
@@ -123,7 +138,16 @@ type Peer struct {
 
 	Collector *collector.Service
 
-	NodeStats *nodestats.Service
+	NodeStats struct {
+		Service *nodestats.Service
+		Cache   *nodestats.Cache
+	}
+
+	Payouts *payouts.Service
+
+	GracefulExit struct {
+		Service *gracefulexit.Service
+	}
 
 	// Web server with web UI
 	Console struct {
@@ -263,10 +287,27 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 	}
 
 	{ // setup node stats service
-		peer.NodeStats = nodestats.NewService(
-			peer.Log.Named("nodestats"),
+		peer.NodeStats.Service = nodestats.NewService(
+			peer.Log.Named("nodestats:service"),
 			peer.Transport,
-			peer.Kademlia.Service)
+			peer.Storage2.Trust)
+
+		peer.NodeStats.Cache = nodestats.NewCache(
+			peer.Log.Named("nodestats:cache"),
+			config.NodeStats,
+			peer.DB.NodeStats(),
+			peer.NodeStats.Service,
+			peer.Storage2.Trust)
+	}
+
+	{ // setup graceful exit service
+		peer.GracefulExit.Service = gracefulexit.NewService(
+			peer.Log.Named("gracefulexit"),
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			peer.Storage2.Trust,
+			peer.Transport,
+		)
 	}
 
 	{ // setup vouchers
@@ -276,6 +317,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Storage2.Trust, interval, buffer)
 	}
 
+	{ // setup payouts
+		peer.Payouts = payouts.NewService(
+			peer.Log.Named("payouts"),
+			config.Payouts,
+			peer.DB.Payouts(),
+			peer.DB.Bandwidth(),
+			peer.DB.PieceInfo(),
+			peer.Storage2.Trust,
+		)
+	}
+
 	{ // setup storage node operator dashboard
 		peer.Console.Service, err = console.NewService(
 			peer.Log.Named("console:service"),
@@ -284,7 +336,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.Version,
-			peer.NodeStats,
+			peer.DB.NodeStats(),
+			peer.Storage2.Trust,
+			peer.Payouts,
 			config.Storage.AllocatedBandwidth,
 			config.Storage.AllocatedDiskSpace,
 			config.Kademlia.Operator.Wallet,
@@ -313,13 +367,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
+			peer.Payouts,
+			peer.GracefulExit.Service,
 			config.Storage,
 			peer.Console.Listener.Addr(),
 		)
 		pb.RegisterPieceStoreInspectorServer(peer.Server.PrivateGRPC(), peer.Storage2.Inspector)
 	}
 
-	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), peer.DB.UsedSerials(), config.Collector)
+	{ // setup contact service
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Kademlia.Service)
+		pb.RegisterContactServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+
+		peer.Contact.Chore = contact.NewChore(
+			peer.Log.Named("contact:chore"),
+			config.Contact,
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.Storage2.Trust,
+		)
+	}
+
+	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), peer.DB.UsedSerials(), peer.Storage2.Trust, config.Collector)
 
 	return peer, nil
 }
@@ -341,6 +410,10 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
 	})
 
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Contact.Chore.Run(ctx))
+	})
+
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Collector.Run(ctx))
 	})
@@ -353,6 +426,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Vouchers.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Payouts.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.NodeStats.Cache.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Service.Run(ctx))
+	})
 
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.DB.Bandwidth().Run(ctx))
@@ -387,6 +469,15 @@ func (peer *Peer) Close() error {
 
 	// close services in reverse initialization order
 
+	if peer.Contact.Chore != nil {
+		errlist.Add(peer.Contact.Chore.Close())
+	}
+	if peer.NodeStats.Cache != nil {
+		errlist.Add(peer.NodeStats.Cache.Close())
+	}
+	if peer.Payouts != nil {
+		errlist.Add(peer.Payouts.Close())
+	}
 	if peer.DB.Bandwidth() != nil {
 		errlist.Add(peer.DB.Bandwidth().Close())
 	}
@@ -63,12 +63,18 @@ type DB interface {
 	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 	// DeleteFailed marks piece deletion from disk failed
 	DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error
+	// Trash marks a piece as moved to the trash, trashed pieces don't use space
+	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) error
+	// RestoreTrash unmarks all trashed pieces of the satellite
+	RestoreTrash(ctx context.Context, satelliteID storj.NodeID) error
 	// SpaceUsed returns the in memory value for disk space used by all pieces
 	SpaceUsed(ctx context.Context) (int64, error)
 	// CalculatedSpaceUsed calculates disk space used by all pieces
 	CalculatedSpaceUsed(ctx context.Context) (int64, error)
 	// SpaceUsedBySatellite calculates disk space used by all pieces by satellite
 	SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error)
+	// ByteHoursBySatellite calculates the byte-hours of the pieces by satellite stored between from and to
+	ByteHoursBySatellite(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (float64, error)
 	// GetExpired gets orders that are expired and were created before some time
 	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
 }
@@ -130,6 +136,41 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// Trash moves the specified piece to the trash of the satellite.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.blobs.Trash(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	return Error.Wrap(err)
+}
+
+// RestoreTrash restores all pieces in the trash of the satellite.
+func (store *Store) RestoreTrash(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.blobs.RestoreTrash(ctx, satellite.Bytes())
+	return Error.Wrap(err)
+}
+
+// EmptyTrash permanently deletes pieces of the satellite that were trashed before trashedBefore.
+func (store *Store) EmptyTrash(ctx context.Context, satellite storj.NodeID, trashedBefore time.Time) (_ []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	deletedKeys, err := store.blobs.EmptyTrash(ctx, satellite.Bytes(), trashedBefore)
+
+	deletedIDs := make([]storj.PieceID, 0, len(deletedKeys))
+	for _, key := range deletedKeys {
+		pieceID, parseErr := storj.PieceIDFromBytes(key)
+		if parseErr != nil {
+			err = errs.Combine(err, parseErr)
+			continue
+		}
+		deletedIDs = append(deletedIDs, pieceID)
+	}
+
+	return deletedIDs, Error.Wrap(err)
+}
+
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
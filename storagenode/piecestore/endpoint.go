@@ -537,7 +537,7 @@ func (endpoint *Endpoint) SaveOrder(ctx context.Context, limit *pb.OrderLimit, o
 	}
 }
 
-// Retain keeps only piece ids specified in the request
+// Retain keeps only piece ids specified in the request, other pieces are moved to the trash
 func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (res *pb.RetainResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -558,7 +558,7 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 
 	const limit = 1000
 	offset := 0
-	numDeleted := 0
+	numTrashed := 0
 	hasMorePieces := true
 
 	for hasMorePieces {
@@ -569,30 +569,63 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 		if err != nil {
 			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
 		}
+		trashed := 0
 		for _, pieceID := range pieceIDs {
 			if !filter.Contains(pieceID) {
-				if err = endpoint.store.Delete(ctx, peer.ID, pieceID); err != nil {
-					endpoint.log.Error("failed to delete a piece", zap.Error(Error.Wrap(err)))
-					// continue because if we fail to delete from file system,
-					// we need to keep the pieceinfo so we can delete next time
+				// move the piece to the trash instead of deleting it, so it can be restored
+				// when the satellite sent an invalid filter; the piece info is kept until
+				// the trash is emptied, but isn't listed or counted as used space anymore
+				if err = endpoint.store.Trash(ctx, peer.ID, pieceID); err != nil {
+					endpoint.log.Error("failed to trash a piece", zap.Error(Error.Wrap(err)))
 					continue
 				}
-				if err = endpoint.pieceinfo.Delete(ctx, peer.ID, pieceID); err != nil {
-					endpoint.log.Error("failed to delete piece info", zap.Error(Error.Wrap(err)))
+				if err = endpoint.pieceinfo.Trash(ctx, peer.ID, pieceID, time.Now()); err != nil {
+					endpoint.log.Error("failed to mark a piece as trashed", zap.Error(Error.Wrap(err)))
+					continue
 				}
-				numDeleted++
+				trashed++
 			}
 		}
+		numTrashed += trashed
 		hasMorePieces = (len(pieceIDs) == limit)
-		offset += len(pieceIDs)
-		offset -= numDeleted
+		// the trashed pieces aren't listed anymore
+		offset += len(pieceIDs) - trashed
 		// We call Gosched() here because the GC process is expected to be long and we want to keep it at low priority,
 		// so other goroutines can continue serving requests.
 		runtime.Gosched()
 	}
+
+	mon.IntVal("retain_pieces_trashed").Observe(int64(numTrashed))
 	return &pb.RetainResponse{}, nil
 }
 
+// RestoreTrash restores all pieces in the trash of the requesting satellite
+func (endpoint *Endpoint) RestoreTrash(ctx context.Context, restoreReq *pb.RestoreTrashRequest) (res *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.New("restore trash called with untrusted ID").Error())
+	}
+
+	err = endpoint.store.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.pieceinfo.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &pb.RestoreTrashResponse{}, nil
+}
+
 // min finds the min of two values
 func min(a, b int64) int64 {
 	if a < b {
@@ -623,6 +623,35 @@ func TestRetain(t *testing.T) {
 		for _, id := range pieceIDs[numPiecesToKeep+numOldPieces:] {
 			require.Contains(t, satellite0Pieces, id, "piece should not have been deleted (recent piece)")
 		}
+
+		// the trashed pieces aren't counted as used space anymore
+		spaceUsed, err := pieceInfos.SpaceUsedBySatellite(ctx, satellite0.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(4*len(satellite0Pieces)), spaceUsed)
+
+		// retaining again doesn't trash the pieces a second time
+		_, err = endpoint.Retain(ctxSatellite0, &retainReq)
+		require.NoError(t, err)
+
+		retainedPieces, err := pieceInfos.GetPieceIDs(ctx, satellite0.ID, recentTime.Add(time.Duration(5)*time.Second), numPieces, 0)
+		require.NoError(t, err)
+		require.ElementsMatch(t, satellite0Pieces, retainedPieces)
+
+		spaceUsed, err = pieceInfos.SpaceUsedBySatellite(ctx, satellite0.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(4*len(satellite0Pieces)), spaceUsed)
+
+		// restoring the trash counts the pieces again
+		_, err = endpoint.RestoreTrash(ctxSatellite0, &pb.RestoreTrashRequest{})
+		require.NoError(t, err)
+
+		restoredPieces, err := pieceInfos.GetPieceIDs(ctx, satellite0.ID, recentTime.Add(time.Duration(5)*time.Second), numPieces, 0)
+		require.NoError(t, err)
+		require.Len(t, restoredPieces, numPieces)
+
+		spaceUsed, err = pieceInfos.SpaceUsedBySatellite(ctx, satellite0.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(4*numPieces), spaceUsed)
 	})
 }
 
@@ -377,6 +377,71 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					return nil
 				}),
 			},
+			{
+				Description: "Create payout_estimates table.",
+				Version:     15,
+				Action: migrate.SQL{
+					`CREATE TABLE payout_estimates (
+						satellite_id         BLOB      NOT NULL,
+						period               TIMESTAMP NOT NULL,
+						egress               BIGINT    NOT NULL,
+						repair_egress        BIGINT    NOT NULL,
+						audit_egress         BIGINT    NOT NULL,
+						at_rest              REAL      NOT NULL,
+						egress_payout        REAL      NOT NULL,
+						repair_egress_payout REAL      NOT NULL,
+						audit_egress_payout  REAL      NOT NULL,
+						storage_payout       REAL      NOT NULL,
+						total                REAL      NOT NULL,
+						updated_at           TIMESTAMP NOT NULL,
+						PRIMARY KEY ( satellite_id, period )
+					)`,
+				},
+			},
+			{
+				Description: "Create reputation and storage_usage tables for caching node stats.",
+				Version:     16,
+				Action: migrate.SQL{
+					`CREATE TABLE reputation (
+						satellite_id            BLOB      NOT NULL,
+						date                    TIMESTAMP NOT NULL,
+						uptime_total_count      INTEGER   NOT NULL,
+						uptime_success_count    INTEGER   NOT NULL,
+						uptime_reputation_alpha REAL      NOT NULL,
+						uptime_reputation_beta  REAL      NOT NULL,
+						uptime_reputation_score REAL      NOT NULL,
+						audit_total_count       INTEGER   NOT NULL,
+						audit_success_count     INTEGER   NOT NULL,
+						audit_reputation_alpha  REAL      NOT NULL,
+						audit_reputation_beta   REAL      NOT NULL,
+						audit_reputation_score  REAL      NOT NULL,
+						disqualified            TIMESTAMP,
+						disqualification_reason INTEGER   NOT NULL,
+						updated_at              TIMESTAMP NOT NULL,
+						PRIMARY KEY ( satellite_id, date )
+					)`,
+					`CREATE TABLE storage_usage (
+						satellite_id  BLOB      NOT NULL,
+						at_rest_total REAL      NOT NULL,
+						timestamp     TIMESTAMP NOT NULL,
+						PRIMARY KEY ( satellite_id, timestamp )
+					)`,
+				},
+			},
+			{
+				Description: "Add tracking of trashed pieces.",
+				Version:     17,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo_ ADD COLUMN trashed_at TIMESTAMP`,
+				},
+			},
+			{
+				Description: "Add suspension to the cached node stats.",
+				Version:     18,
+				Action: migrate.SQL{
+					`ALTER TABLE reputation ADD COLUMN suspended TIMESTAMP`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/date"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/nodestats"
+)
+
+type nodestatsdb struct{ *InfoDB }
+
+// NodeStats returns database for caching node stats
+func (db *DB) NodeStats() nodestats.DB { return db.info.NodeStats() }
+
+// NodeStats returns database for caching node stats
+func (db *InfoDB) NodeStats() nodestats.DB { return &nodestatsdb{db} }
+
+// StoreStats inserts or replaces the stats of the satellite for the day of stats.UpdatedAt
+func (db *nodestatsdb) StoreStats(ctx context.Context, stats nodestats.Stats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	day, _ := date.DayBoundary(stats.UpdatedAt.UTC())
+
+	var disqualified interface{}
+	if stats.Disqualified != nil {
+		disqualified = stats.Disqualified.UTC()
+	}
+
+	var suspended interface{}
+	if stats.Suspended != nil {
+		suspended = stats.Suspended.UTC()
+	}
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		INSERT OR REPLACE INTO reputation(
+			satellite_id,
+			date,
+			uptime_total_count,
+			uptime_success_count,
+			uptime_reputation_alpha,
+			uptime_reputation_beta,
+			uptime_reputation_score,
+			audit_total_count,
+			audit_success_count,
+			audit_reputation_alpha,
+			audit_reputation_beta,
+			audit_reputation_score,
+			disqualified,
+			disqualification_reason,
+			suspended,
+			updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`), stats.SatelliteID, day,
+		stats.UptimeCheck.TotalCount, stats.UptimeCheck.SuccessCount,
+		stats.UptimeCheck.ReputationAlpha, stats.UptimeCheck.ReputationBeta, stats.UptimeCheck.ReputationScore,
+		stats.AuditCheck.TotalCount, stats.AuditCheck.SuccessCount,
+		stats.AuditCheck.ReputationAlpha, stats.AuditCheck.ReputationBeta, stats.AuditCheck.ReputationScore,
+		disqualified, stats.DisqualificationReason, suspended, stats.UpdatedAt.UTC())
+
+	return ErrInfo.Wrap(err)
+}
+
+// GetStats returns the most recent stats of the satellite, nil when there are none
+func (db *nodestatsdb) GetStats(ctx context.Context, satelliteID storj.NodeID) (_ *nodestats.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	history, err := db.getStats(ctx, `
+		WHERE satellite_id = ?
+		ORDER BY date DESC
+		LIMIT 1`, satelliteID)
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+
+	return &history[0], nil
+}
+
+// GetStatsHistory returns daily stats of the satellite for provided time range,
+// sorted in ascending order
+func (db *nodestatsdb) GetStatsHistory(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []nodestats.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since, _ := date.DayBoundary(from.UTC())
+	_, before := date.DayBoundary(to.UTC())
+
+	return db.getStats(ctx, `
+		WHERE satellite_id = ? AND ? <= date AND date <= ?
+		ORDER BY date ASC`, satelliteID, since, before)
+}
+
+// getStats returns the stats matching the condition
+func (db *nodestatsdb) getStats(ctx context.Context, cond string, args ...interface{}) (_ []nodestats.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id,
+			uptime_total_count, uptime_success_count,
+			uptime_reputation_alpha, uptime_reputation_beta, uptime_reputation_score,
+			audit_total_count, audit_success_count,
+			audit_reputation_alpha, audit_reputation_beta, audit_reputation_score,
+			disqualified, disqualification_reason, suspended, updated_at
+		FROM reputation
+		`+cond), args...)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var history []nodestats.Stats
+	for rows.Next() {
+		var stats nodestats.Stats
+		err = rows.Scan(&stats.SatelliteID,
+			&stats.UptimeCheck.TotalCount, &stats.UptimeCheck.SuccessCount,
+			&stats.UptimeCheck.ReputationAlpha, &stats.UptimeCheck.ReputationBeta, &stats.UptimeCheck.ReputationScore,
+			&stats.AuditCheck.TotalCount, &stats.AuditCheck.SuccessCount,
+			&stats.AuditCheck.ReputationAlpha, &stats.AuditCheck.ReputationBeta, &stats.AuditCheck.ReputationScore,
+			&stats.Disqualified, &stats.DisqualificationReason, &stats.Suspended, &stats.UpdatedAt)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		history = append(history, stats)
+	}
+
+	return history, ErrInfo.Wrap(rows.Err())
+}
+
+// StoreDailyStorageUsage inserts or replaces daily storage usage stamps
+func (db *nodestatsdb) StoreDailyStorageUsage(ctx context.Context, stamps []nodestats.SpaceUsageStamp) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, stamp := range stamps {
+		_, err = db.db.ExecContext(ctx, db.Rebind(`
+			INSERT OR REPLACE INTO storage_usage(satellite_id, at_rest_total, timestamp)
+			VALUES (?, ?, ?)
+		`), stamp.SatelliteID, stamp.AtRestTotal, stamp.TimeStamp.UTC())
+		if err != nil {
+			return ErrInfo.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// GetDailyStorageUsage returns daily storage usage of the satellite for provided time range,
+// sorted in ascending order
+func (db *nodestatsdb) GetDailyStorageUsage(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []nodestats.SpaceUsageStamp, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since, _ := date.DayBoundary(from.UTC())
+	_, before := date.DayBoundary(to.UTC())
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, at_rest_total, timestamp
+		FROM storage_usage
+		WHERE satellite_id = ? AND ? <= timestamp AND timestamp <= ?
+		ORDER BY timestamp ASC
+	`), satelliteID, since, before)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var stamps []nodestats.SpaceUsageStamp
+	for rows.Next() {
+		var stamp nodestats.SpaceUsageStamp
+		if err = rows.Scan(&stamp.SatelliteID, &stamp.AtRestTotal, &stamp.TimeStamp); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		stamps = append(stamps, stamp)
+	}
+
+	return stamps, ErrInfo.Wrap(rows.Err())
+}
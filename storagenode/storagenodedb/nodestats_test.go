@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storagenode/nodestats"
+)
+
+func TestNodeStats_Trivial(t *testing.T) {
+	Run(t, func(t *testing.T, ctx context.Context, db *DB) {
+		satelliteID := testrand.NodeID()
+		now := time.Now()
+
+		{ // Ensure StoreStats works at all
+			err := db.NodeStats().StoreStats(ctx, nodestats.Stats{
+				SatelliteID:  satelliteID,
+				Disqualified: &now,
+				Suspended:    &now,
+				UpdatedAt:    now,
+			})
+			require.NoError(t, err)
+		}
+
+		{ // Ensure GetStats works at all
+			stats, err := db.NodeStats().GetStats(ctx, satelliteID)
+			require.NoError(t, err)
+			require.NotNil(t, stats)
+			require.NotNil(t, stats.Disqualified)
+			require.NotNil(t, stats.Suspended)
+		}
+
+		{ // Ensure GetStatsHistory works at all
+			_, err := db.NodeStats().GetStatsHistory(ctx, satelliteID, now, now)
+			require.NoError(t, err)
+		}
+
+		{ // Ensure StoreDailyStorageUsage works at all
+			err := db.NodeStats().StoreDailyStorageUsage(ctx, []nodestats.SpaceUsageStamp{
+				{SatelliteID: satelliteID, AtRestTotal: 1, TimeStamp: now},
+			})
+			require.NoError(t, err)
+		}
+
+		{ // Ensure GetDailyStorageUsage works at all
+			_, err := db.NodeStats().GetDailyStorageUsage(ctx, satelliteID, now, now)
+			require.NoError(t, err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/payouts"
+)
+
+type payoutsdb struct{ *InfoDB }
+
+// Payouts returns database for storing payout estimates
+func (db *DB) Payouts() payouts.DB { return db.info.Payouts() }
+
+// Payouts returns database for storing payout estimates
+func (db *InfoDB) Payouts() payouts.DB { return &payoutsdb{db} }
+
+// Store inserts or replaces the estimate for the satellite and period
+func (db *payoutsdb) Store(ctx context.Context, estimate payouts.Estimate) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		INSERT OR REPLACE INTO payout_estimates(
+			satellite_id,
+			period,
+			egress,
+			repair_egress,
+			audit_egress,
+			at_rest,
+			egress_payout,
+			repair_egress_payout,
+			audit_egress_payout,
+			storage_payout,
+			total,
+			updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`), estimate.SatelliteID, estimate.Period.UTC(),
+		estimate.Egress, estimate.RepairEgress, estimate.AuditEgress, estimate.AtRest,
+		estimate.EgressPayout, estimate.RepairEgressPayout, estimate.AuditEgressPayout, estimate.StoragePayout,
+		estimate.Total, estimate.UpdatedAt.UTC())
+
+	return ErrInfo.Wrap(err)
+}
+
+// GetAll returns all stored estimates, most recent period first
+func (db *payoutsdb) GetAll(ctx context.Context) (_ []payouts.Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.getEstimates(ctx, "")
+}
+
+// GetBySatellite returns all stored estimates for the satellite, most recent period first
+func (db *payoutsdb) GetBySatellite(ctx context.Context, satelliteID storj.NodeID) (_ []payouts.Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.getEstimates(ctx, "WHERE satellite_id = ?", satelliteID)
+}
+
+// getEstimates returns estimates matching the condition, most recent period first
+func (db *payoutsdb) getEstimates(ctx context.Context, cond string, args ...interface{}) (_ []payouts.Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, period,
+			egress, repair_egress, audit_egress, at_rest,
+			egress_payout, repair_egress_payout, audit_egress_payout, storage_payout,
+			total, updated_at
+		FROM payout_estimates
+		`+cond+`
+		ORDER BY period DESC, satellite_id ASC
+	`), args...)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var estimates []payouts.Estimate
+	for rows.Next() {
+		var estimate payouts.Estimate
+		err = rows.Scan(&estimate.SatelliteID, &estimate.Period,
+			&estimate.Egress, &estimate.RepairEgress, &estimate.AuditEgress, &estimate.AtRest,
+			&estimate.EgressPayout, &estimate.RepairEgressPayout, &estimate.AuditEgressPayout, &estimate.StoragePayout,
+			&estimate.Total, &estimate.UpdatedAt)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		estimates = append(estimates, estimate)
+	}
+
+	return estimates, ErrInfo.Wrap(rows.Err())
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storagenode/payouts"
+)
+
+func TestPayouts_Trivial(t *testing.T) {
+	Run(t, func(t *testing.T, ctx context.Context, db *DB) {
+		satelliteID := testrand.NodeID()
+
+		{ // Ensure Store works at all
+			err := db.Payouts().Store(ctx, payouts.Estimate{
+				SatelliteID: satelliteID,
+				Period:      payouts.PeriodOf(time.Now()),
+				UpdatedAt:   time.Now(),
+			})
+			require.NoError(t, err)
+		}
+
+		{ // Ensure GetAll works at all
+			_, err := db.Payouts().GetAll(ctx)
+			require.NoError(t, err)
+		}
+
+		{ // Ensure GetBySatellite works at all
+			_, err := db.Payouts().GetBySatellite(ctx, satelliteID)
+			require.NoError(t, err)
+		}
+	})
+}
@@ -74,6 +74,7 @@ func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID,
 		SELECT piece_id
 		FROM pieceinfo_
 		WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?)
+		  AND trashed_at IS NULL
 		ORDER BY piece_id
 		LIMIT ? OFFSET ?
 	`), satelliteID, createdBefore.UTC(), limit, offset)
@@ -101,16 +102,21 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 
 	var orderLimit []byte
 	var uplinkPieceHash []byte
+	var pieceExpiration *time.Time
 
 	err = db.db.QueryRowContext(ctx, db.Rebind(`
 		SELECT piece_size, piece_creation, piece_expiration, order_limit, uplink_piece_hash
 		FROM pieceinfo_
 		WHERE satellite_id = ? AND piece_id = ?
-	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &info.PieceExpiration, &orderLimit, &uplinkPieceHash)
+	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &pieceExpiration, &orderLimit, &uplinkPieceHash)
 	if err != nil {
 		return nil, ErrInfo.Wrap(err)
 	}
 
+	if pieceExpiration != nil {
+		info.PieceExpiration = *pieceExpiration
+	}
+
 	info.OrderLimit = &pb.OrderLimit{}
 	err = proto.Unmarshal(orderLimit, info.OrderLimit)
 	if err != nil {
@@ -130,11 +136,13 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 func (db *pieceinfo) Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// trashed pieces aren't counted as used space anymore
 	var pieceSize int64
 	err = db.db.QueryRowContext(ctx, db.Rebind(`
 		SELECT piece_size
 		FROM pieceinfo_
 		WHERE satellite_id = ? AND piece_id = ?
+		  AND trashed_at IS NULL
 	`), satelliteID, pieceID).Scan(&pieceSize)
 	// Ignore no rows found errors
 	if err != nil && err != sql.ErrNoRows {
@@ -169,6 +177,77 @@ func (db *pieceinfo) DeleteFailed(ctx context.Context, satelliteID storj.NodeID,
 	return ErrInfo.Wrap(err)
 }
 
+// Trash marks a piece as moved to the trash.
+func (db *pieceinfo) Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var pieceSize int64
+	err = db.db.QueryRowContext(ctx, db.Rebind(`
+		SELECT piece_size
+		FROM pieceinfo_
+		WHERE satellite_id = ? AND piece_id = ?
+		  AND trashed_at IS NULL
+	`), satelliteID, pieceID).Scan(&pieceSize)
+	if err != nil {
+		// the piece info is missing or the piece is already in the trash
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return ErrInfo.Wrap(err)
+	}
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo_
+		SET trashed_at = ?
+		WHERE satellite_id = ?
+		  AND piece_id = ?
+	`), trashedAt.UTC(), satelliteID, pieceID)
+
+	if err == nil {
+		db.loadSpaceUsed(ctx)
+		atomic.AddInt64(&db.usedSpace, -pieceSize)
+	}
+	return ErrInfo.Wrap(err)
+}
+
+// RestoreTrash unmarks all trashed pieces of the satellite.
+func (db *pieceinfo) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	var sum sql.NullInt64
+	err = tx.QueryRowContext(ctx, db.Rebind(`
+		SELECT SUM(piece_size)
+		FROM pieceinfo_
+		WHERE satellite_id = ?
+		  AND trashed_at IS NOT NULL
+	`), satelliteID).Scan(&sum)
+	if err != nil {
+		return ErrInfo.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	_, err = tx.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo_
+		SET trashed_at = NULL
+		WHERE satellite_id = ?
+		  AND trashed_at IS NOT NULL
+	`), satelliteID)
+	if err != nil {
+		return ErrInfo.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	err = tx.Commit()
+	if err == nil && sum.Valid {
+		db.loadSpaceUsed(ctx)
+		atomic.AddInt64(&db.usedSpace, sum.Int64)
+	}
+	return ErrInfo.Wrap(err)
+}
+
 // GetExpired gets pieceinformation identites that are expired.
 func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (infos []pieces.ExpiredInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -179,6 +258,7 @@ func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit
 		WHERE piece_expiration IS NOT NULL
 		AND piece_expiration < ?
 		AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?)
+		AND trashed_at IS NULL
 		ORDER BY satellite_id
 		LIMIT ?
 	`), expiredAt.UTC(), expiredAt.UTC(), limit)
@@ -220,6 +300,7 @@ func (db *pieceinfo) CalculatedSpaceUsed(ctx context.Context) (_ int64, err erro
 	err = db.db.QueryRowContext(ctx, db.Rebind(`
 		SELECT SUM(piece_size)
 		FROM pieceinfo_
+		WHERE trashed_at IS NULL
 	`)).Scan(&sum)
 
 	if err == sql.ErrNoRows || !sum.Valid {
@@ -237,6 +318,7 @@ func (db *pieceinfo) SpaceUsedBySatellite(ctx context.Context, satelliteID storj
 		SELECT SUM(piece_size)
 		FROM pieceinfo_
 		WHERE satellite_id = ?
+		  AND trashed_at IS NULL
 	`), satelliteID).Scan(&sum)
 
 	if err == sql.ErrNoRows || !sum.Valid {
@@ -244,3 +326,43 @@ func (db *pieceinfo) SpaceUsedBySatellite(ctx context.Context, satelliteID storj
 	}
 	return sum.Int64, err
 }
+
+// ByteHoursBySatellite calculates the byte-hours of the pieces by satellite,
+// counting every piece only from its creation until its expiration.
+func (db *pieceinfo) ByteHoursBySatellite(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT piece_size, piece_creation, piece_expiration
+		FROM pieceinfo_
+		WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?)
+		  AND trashed_at IS NULL
+	`), satelliteID, to.UTC())
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var byteHours float64
+	for rows.Next() {
+		var pieceSize int64
+		var pieceCreation time.Time
+		var pieceExpiration *time.Time
+		err = rows.Scan(&pieceSize, &pieceCreation, &pieceExpiration)
+		if err != nil {
+			return 0, ErrInfo.Wrap(err)
+		}
+
+		start, end := pieceCreation, to
+		if start.Before(from) {
+			start = from
+		}
+		if pieceExpiration != nil && pieceExpiration.Before(end) {
+			end = *pieceExpiration
+		}
+		if end.After(start) {
+			byteHours += float64(pieceSize) * end.Sub(start).Hours()
+		}
+	}
+	return byteHours, ErrInfo.Wrap(rows.Err())
+}
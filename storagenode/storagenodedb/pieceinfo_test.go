@@ -70,5 +70,10 @@ func TestPieceinfo_Trivial(t *testing.T) {
 			_, err := db.PieceInfo().SpaceUsedBySatellite(ctx, satelliteID)
 			require.NoError(t, err)
 		}
+
+		{ // Ensure ByteHoursBySatellite works at all
+			_, err := db.PieceInfo().ByteHoursBySatellite(ctx, satelliteID, time.Now().Add(-time.Hour), time.Now())
+			require.NoError(t, err)
+		}
 	})
 }
@@ -28,8 +28,8 @@ type EncryptionConfig struct {
 	EncryptionKey     string `help:"the root key for encrypting the data which will be stored in KeyFilePath" setup:"true"`
 	KeyFilepath       string `help:"the path to the file which contains the root key for encrypting the data"`
 	EncAccessFilepath string `help:"the path to a file containing a serialized encryption access"`
-	DataType          int    `help:"Type of encryption to use for content and metadata (2=AES-GCM, 3=SecretBox)" default:"2"`
-	PathType          int    `help:"Type of encryption to use for paths (1=Unencrypted, 2=AES-GCM, 3=SecretBox)" default:"2"`
+	DataType          int    `help:"Type of encryption to use for content and metadata (2=AES-GCM, 3=SecretBox, 4=XChaCha20-Poly1305)" default:"2"`
+	PathType          int    `help:"Type of encryption to use for paths (1=Unencrypted, 2=AES-GCM, 3=SecretBox, 4=XChaCha20-Poly1305)" default:"2"`
 }
 
 // ClientConfig is a configuration struct for the uplink that controls how
@@ -41,6 +41,12 @@ type ClientConfig struct {
 	SegmentSize    memory.Size   `help:"the size of a segment in bytes" default:"64MiB"`
 	RequestTimeout time.Duration `help:"timeout for request" default:"0h2m00s"`
 	DialTimeout    time.Duration `help:"timeout for dials" default:"0h2m00s"`
+
+	PrefetchSegments int         `help:"the number of segments downloaded concurrently ahead of the reader" default:"1"`
+	PrefetchMemory   memory.Size `help:"the maximum memory for buffering prefetched segments of a download" default:"256MiB"`
+
+	Compression          string      `help:"the algorithm for compressing uploaded data before encryption (none, gzip)" default:"none"`
+	CompressionBlockSize memory.Size `help:"the size of the blocks of data, which are compressed independently" default:"256KiB"`
 }
 
 // Config uplink configuration
@@ -80,6 +86,18 @@ func (c Config) GetEncryptionParameters() storj.EncryptionParameters {
 	}
 }
 
+// GetCompressionParameters returns the configured compression for new uploads
+func (c Config) GetCompressionParameters() (storj.CompressionParameters, error) {
+	algorithm, err := storj.ParseCompressionAlgorithm(c.Client.Compression)
+	if err != nil {
+		return storj.CompressionParameters{}, err
+	}
+	return storj.CompressionParameters{
+		Algorithm: algorithm,
+		BlockSize: c.Client.CompressionBlockSize.Int32(),
+	}, nil
+}
+
 // GetSegmentSize returns the segment size set in uplink config
 func (c Config) GetSegmentSize() memory.Size {
 	return c.Client.SegmentSize
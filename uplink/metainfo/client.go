@@ -41,6 +41,25 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// The key type is unexported to prevent collisions with context keys defined in
+// other packages.
+type key int
+
+// versionKey is the context key for the requested object version
+const versionKey key = 0
+
+// WithVersion creates a context for reading the given version of an object.
+// The latest version is read, when no version is set.
+func WithVersion(ctx context.Context, versionID string) context.Context {
+	return context.WithValue(ctx, versionKey, versionID)
+}
+
+// GetVersion returns the object version set with WithVersion
+func GetVersion(ctx context.Context) string {
+	versionID, _ := ctx.Value(versionKey).(string)
+	return versionID
+}
+
 // New used as a public function
 func New(client pb.MetainfoClient) *Client {
 	return &Client{
@@ -87,6 +106,9 @@ func (client *Client) CreateSegment(ctx context.Context, bucket string, path sto
 		Expiration:              expiration,
 	})
 	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, rootPieceID, piecePrivateKey, storj.ErrLimitExceeded.Wrap(err)
+		}
 		return nil, rootPieceID, piecePrivateKey, Error.Wrap(err)
 	}
 
@@ -105,6 +127,9 @@ func (client *Client) CommitSegment(ctx context.Context, bucket string, path sto
 		OriginalLimits: originalLimits,
 	})
 	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, storj.ErrLimitExceeded.Wrap(err)
+		}
 		return nil, Error.Wrap(err)
 	}
 
@@ -116,9 +141,10 @@ func (client *Client) SegmentInfo(ctx context.Context, bucket string, path storj
 	defer mon.Task()(&ctx)(&err)
 
 	response, err := client.client.SegmentInfoOld(ctx, &pb.SegmentInfoRequestOld{
-		Bucket:  []byte(bucket),
-		Path:    []byte(path),
-		Segment: segmentIndex,
+		Bucket:    []byte(bucket),
+		Path:      []byte(path),
+		Segment:   segmentIndex,
+		VersionId: GetVersion(ctx),
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -134,15 +160,37 @@ func (client *Client) SegmentInfo(ctx context.Context, bucket string, path storj
 func (client *Client) ReadSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return client.readSegment(ctx, bucket, path, segmentIndex, nil)
+}
+
+// ReadSegmentRange requests the order limits for reading the given range of a segment.
+// The order limits only allow downloading the erasure shares covering the range.
+func (client *Client) ReadSegmentRange(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, offset, length int64) (pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return client.readSegment(ctx, bucket, path, segmentIndex, &pb.SegmentRange{
+		Offset: offset,
+		Length: length,
+	})
+}
+
+func (client *Client) readSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, segmentRange *pb.SegmentRange) (pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	response, err := client.client.DownloadSegmentOld(ctx, &pb.SegmentDownloadRequestOld{
-		Bucket:  []byte(bucket),
-		Path:    []byte(path),
-		Segment: segmentIndex,
+		Bucket:    []byte(bucket),
+		Path:      []byte(path),
+		Segment:   segmentIndex,
+		VersionId: GetVersion(ctx),
+		Range:     segmentRange,
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil, nil, piecePrivateKey, storage.ErrKeyNotFound.Wrap(err)
 		}
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, nil, piecePrivateKey, storj.ErrLimitExceeded.Wrap(err)
+		}
 		return nil, nil, piecePrivateKey, Error.Wrap(err)
 	}
 
@@ -339,6 +387,131 @@ func convertProtoToBucket(pbBucket *pb.Bucket) storj.Bucket {
 			CipherSuite: storj.CipherSuite(defaultEP.CipherSuite),
 			BlockSize:   int32(defaultEP.BlockSize),
 		},
+		Versioning:       storj.BucketVersioning(pbBucket.GetVersioning()),
+		Lifecycle:        convertProtoToLifecycle(pbBucket.GetLifecycle()),
+		RedundancyPolicy: convertProtoToRedundancyPolicy(pbBucket.GetRedundancyPolicy()),
+		Placement:        convertProtoToPlacement(pbBucket.GetPlacement()),
+	}
+}
+
+func convertProtoToRedundancyPolicy(policy *pb.RedundancyScheme) *storj.RedundancyScheme {
+	if policy == nil {
+		return nil
+	}
+	return &storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      policy.GetErasureShareSize(),
+		RequiredShares: int16(policy.GetMinReq()),
+		RepairShares:   int16(policy.GetRepairThreshold()),
+		OptimalShares:  int16(policy.GetSuccessThreshold()),
+		TotalShares:    int16(policy.GetTotal()),
+	}
+}
+
+// SetBucketVersioning changes the versioning state of a bucket
+func (client *Client) SetBucketVersioning(ctx context.Context, bucketName string, versioning storj.BucketVersioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.SetBucketVersioning(ctx, &pb.BucketSetVersioningRequest{
+		Name:       []byte(bucketName),
+		Versioning: pb.BucketVersioning(versioning),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storj.ErrBucketNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket. The prefixes of
+// the rules must be encrypted.
+func (client *Client) SetBucketLifecycle(ctx context.Context, bucketName string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.SetBucketLifecycle(ctx, &pb.BucketSetLifecycleRequest{
+		Name:  []byte(bucketName),
+		Rules: convertLifecycleToProto(rules),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storj.ErrBucketNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+func convertLifecycleToProto(rules []storj.LifecycleRule) []*pb.LifecycleRule {
+	pbRules := make([]*pb.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		pbRules = append(pbRules, &pb.LifecycleRule{
+			Id:                             rule.ID,
+			EncryptedPrefix:                []byte(rule.Prefix),
+			Disabled:                       rule.Disabled,
+			ExpireAfterDays:                int32(rule.ExpireAfterDays),
+			AbortIncompleteUploadAfterDays: int32(rule.AbortIncompleteUploadAfterDays),
+		})
+	}
+	return pbRules
+}
+
+func convertProtoToLifecycle(pbRules []*pb.LifecycleRule) []storj.LifecycleRule {
+	if len(pbRules) == 0 {
+		return nil
+	}
+
+	rules := make([]storj.LifecycleRule, 0, len(pbRules))
+	for _, pbRule := range pbRules {
+		rules = append(rules, storj.LifecycleRule{
+			ID:                             pbRule.GetId(),
+			Prefix:                         storj.Path(pbRule.GetEncryptedPrefix()),
+			Disabled:                       pbRule.GetDisabled(),
+			ExpireAfterDays:                int(pbRule.GetExpireAfterDays()),
+			AbortIncompleteUploadAfterDays: int(pbRule.GetAbortIncompleteUploadAfterDays()),
+		})
+	}
+	return rules
+}
+
+// SetBucketPlacement replaces the placement constraints of a bucket. A nil
+// placement removes all constraints.
+func (client *Client) SetBucketPlacement(ctx context.Context, bucketName string, placement *storj.PlacementConstraints) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.SetBucketPlacement(ctx, &pb.BucketSetPlacementRequest{
+		Name:      []byte(bucketName),
+		Placement: convertPlacementToProto(placement),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storj.ErrBucketNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+func convertPlacementToProto(placement *storj.PlacementConstraints) *pb.PlacementConstraints {
+	if placement == nil {
+		return nil
+	}
+	return &pb.PlacementConstraints{
+		CountryCodes:         placement.CountryCodes,
+		ExcludedCountryCodes: placement.ExcludedCountryCodes,
+		DistinctAsn:          placement.DistinctASN,
+	}
+}
+
+func convertProtoToPlacement(placement *pb.PlacementConstraints) *storj.PlacementConstraints {
+	if placement == nil {
+		return nil
+	}
+	return &storj.PlacementConstraints{
+		CountryCodes:         placement.GetCountryCodes(),
+		ExcludedCountryCodes: placement.GetExcludedCountryCodes(),
+		DistinctASN:          placement.GetDistinctAsn(),
 	}
 }
 
@@ -411,6 +584,71 @@ func (client *Client) FinishDeleteObject(ctx context.Context, streamID storj.Str
 	return Error.Wrap(err)
 }
 
+// CopyObject copies an object to a new path, segments contain the metadata re-encrypted for the new path
+func (client *Client) CopyObject(ctx context.Context, bucket []byte, encryptedPath []byte, newBucket []byte, newEncryptedPath []byte, segments []*pb.SegmentMetadata) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.CopyObject(ctx, &pb.ObjectCopyRequest{
+		Bucket:           bucket,
+		EncryptedPath:    encryptedPath,
+		NewBucket:        newBucket,
+		NewEncryptedPath: newEncryptedPath,
+		Segments:         segments,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		if status.Code(err) == codes.ResourceExhausted {
+			return storj.ErrLimitExceeded.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// MoveObject moves an object to a new path, segments contain the metadata re-encrypted for the new path
+func (client *Client) MoveObject(ctx context.Context, bucket []byte, encryptedPath []byte, newBucket []byte, newEncryptedPath []byte, segments []*pb.SegmentMetadata) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.MoveObject(ctx, &pb.ObjectMoveRequest{
+		Bucket:           bucket,
+		EncryptedPath:    encryptedPath,
+		NewBucket:        newBucket,
+		NewEncryptedPath: newEncryptedPath,
+		Segments:         segments,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// RekeyObject replaces the metadata of all segments of the version of an object set with
+// WithVersion, segments contain the metadata re-encrypted under a new root key and
+// newEncryptedPath is the path encrypted with it
+func (client *Client) RekeyObject(ctx context.Context, bucket []byte, encryptedPath []byte, newEncryptedPath []byte, segments []*pb.SegmentMetadata) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.RekeyObject(ctx, &pb.ObjectRekeyRequest{
+		Bucket:           bucket,
+		EncryptedPath:    encryptedPath,
+		NewEncryptedPath: newEncryptedPath,
+		Segments:         segments,
+		VersionId:        GetVersion(ctx),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // ListObjects lists objects according to specific parameters
 func (client *Client) ListObjects(ctx context.Context, bucket []byte, encryptedPrefix []byte, encryptedCursor []byte, limit int32) (_ []storj.ObjectListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -441,3 +679,52 @@ func (client *Client) ListObjects(ctx context.Context, bucket []byte, encryptedP
 
 	return objects, response.More, Error.Wrap(err)
 }
+
+// ListObjectVersions lists all versions of the objects below a prefix, including the delete markers
+func (client *Client) ListObjectVersions(ctx context.Context, bucket []byte, encryptedPrefix []byte, encryptedCursor []byte, limit int32) (_ []*pb.ObjectVersion, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.ListObjectVersions(ctx, &pb.ObjectListVersionsRequest{
+		Bucket:          bucket,
+		EncryptedPrefix: encryptedPrefix,
+		EncryptedCursor: encryptedCursor,
+		Limit:           limit,
+	})
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	return response.Items, response.More, nil
+}
+
+// DeleteObjectVersion permanently deletes a version of an object or a delete marker
+func (client *Client) DeleteObjectVersion(ctx context.Context, bucket []byte, encryptedPath []byte, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.DeleteObjectVersion(ctx, &pb.ObjectDeleteVersionRequest{
+		Bucket:        bucket,
+		EncryptedPath: encryptedPath,
+		VersionId:     versionID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// PutDeleteMarker deletes an object in a bucket with versioning by adding a delete marker
+func (client *Client) PutDeleteMarker(ctx context.Context, bucket []byte, encryptedPath []byte) (versionID string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.PutDeleteMarker(ctx, &pb.ObjectPutDeleteMarkerRequest{
+		Bucket:        bucket,
+		EncryptedPath: encryptedPath,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return response.VersionId, nil
+}
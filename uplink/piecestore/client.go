@@ -77,6 +77,13 @@ func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) (err er
 	return Error.Wrap(err)
 }
 
+// RestoreTrash tells the piece store to restore all pieces it has moved to the trash.
+func (client *Client) RestoreTrash(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = client.client.RestoreTrash(ctx, &pb.RestoreTrashRequest{})
+	return Error.Wrap(err)
+}
+
 // Close closes the underlying connection.
 func (client *Client) Close() error {
 	return client.conn.Close()